@@ -46,7 +46,7 @@ type Predicate_Op int32
 const (
 	Predicate_UNKNOWN Predicate_Op = 0
 	// Operators on scalar values. Only applies to one of |int_value|,
-	// |long_value|, |string_value| or |timestamp_value|.
+	// |long_value|, |double_value|, |string_value| or |timestamp_value|.
 	Predicate_EQUALS              Predicate_Op = 1
 	Predicate_NOT_EQUALS          Predicate_Op = 2
 	Predicate_GREATER_THAN        Predicate_Op = 3
@@ -59,20 +59,35 @@ const (
 	// Checks if the value contains |string_value| as a substring match. Only
 	// applies to |string_value|.
 	Predicate_IS_SUBSTRING Predicate_Op = 9
+	// Checks if the value is not a member of a given array, which should be one
+	// of |int_values|, |long_values| or |string_values|.
+	Predicate_NOT_IN Predicate_Op = 10
+	// Checks if the value is not set. Takes no value.
+	Predicate_IS_NULL Predicate_Op = 11
+	// Checks if the value starts with |string_value|. Only applies to
+	// |string_value|.
+	Predicate_STARTS_WITH Predicate_Op = 12
+	// Checks if the value matches the POSIX extended regular expression
+	// |string_value|, case insensitively. Only applies to |string_value|.
+	Predicate_MATCHES_REGEX Predicate_Op = 13
 )
 
 // Enum value maps for Predicate_Op.
 var (
 	Predicate_Op_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "EQUALS",
-		2: "NOT_EQUALS",
-		3: "GREATER_THAN",
-		5: "GREATER_THAN_EQUALS",
-		6: "LESS_THAN",
-		7: "LESS_THAN_EQUALS",
-		8: "IN",
-		9: "IS_SUBSTRING",
+		0:  "UNKNOWN",
+		1:  "EQUALS",
+		2:  "NOT_EQUALS",
+		3:  "GREATER_THAN",
+		5:  "GREATER_THAN_EQUALS",
+		6:  "LESS_THAN",
+		7:  "LESS_THAN_EQUALS",
+		8:  "IN",
+		9:  "IS_SUBSTRING",
+		10: "NOT_IN",
+		11: "IS_NULL",
+		12: "STARTS_WITH",
+		13: "MATCHES_REGEX",
 	}
 	Predicate_Op_value = map[string]int32{
 		"UNKNOWN":             0,
@@ -84,6 +99,10 @@ var (
 		"LESS_THAN_EQUALS":    7,
 		"IN":                  8,
 		"IS_SUBSTRING":        9,
+		"NOT_IN":              10,
+		"IS_NULL":             11,
+		"STARTS_WITH":         12,
+		"MATCHES_REGEX":       13,
 	}
 )
 
@@ -114,6 +133,62 @@ func (Predicate_Op) EnumDescriptor() ([]byte, []int) {
 	return file_backend_api_filter_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type FilterGroup_Op int32
+
+const (
+	FilterGroup_UNKNOWN FilterGroup_Op = 0
+	// True if all the predicates and groups are true.
+	FilterGroup_AND FilterGroup_Op = 1
+	// True if any of the predicates and groups is true.
+	FilterGroup_OR FilterGroup_Op = 2
+	// True if not all the predicates and groups are true, i.e. the negation of
+	// AND.
+	FilterGroup_NOT FilterGroup_Op = 3
+)
+
+// Enum value maps for FilterGroup_Op.
+var (
+	FilterGroup_Op_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "AND",
+		2: "OR",
+		3: "NOT",
+	}
+	FilterGroup_Op_value = map[string]int32{
+		"UNKNOWN": 0,
+		"AND":     1,
+		"OR":      2,
+		"NOT":     3,
+	}
+)
+
+func (x FilterGroup_Op) Enum() *FilterGroup_Op {
+	p := new(FilterGroup_Op)
+	*p = x
+	return p
+}
+
+func (x FilterGroup_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterGroup_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_backend_api_filter_proto_enumTypes[1].Descriptor()
+}
+
+func (FilterGroup_Op) Type() protoreflect.EnumType {
+	return &file_backend_api_filter_proto_enumTypes[1]
+}
+
+func (x FilterGroup_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterGroup_Op.Descriptor instead.
+func (FilterGroup_Op) EnumDescriptor() ([]byte, []int) {
+	return file_backend_api_filter_proto_rawDescGZIP(), []int{5, 0}
+}
+
 // Predicate captures individual conditions that must be true for a resource
 // being filtered.
 type Predicate struct {
@@ -131,6 +206,7 @@ type Predicate struct {
 	//	*Predicate_IntValues
 	//	*Predicate_LongValues
 	//	*Predicate_StringValues
+	//	*Predicate_DoubleValue
 	Value isPredicate_Value `protobuf_oneof:"value"`
 }
 
@@ -236,6 +312,13 @@ func (x *Predicate) GetStringValues() *StringValues {
 	return nil
 }
 
+func (x *Predicate) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*Predicate_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
 type isPredicate_Value interface {
 	isPredicate_Value()
 }
@@ -271,6 +354,11 @@ type Predicate_StringValues struct {
 	StringValues *StringValues `protobuf:"bytes,9,opt,name=string_values,json=stringValues,proto3,oneof"`
 }
 
+type Predicate_DoubleValue struct {
+	// Double values are meant to be compared with the metrics of the runs.
+	DoubleValue float64 `protobuf:"fixed64,10,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
 func (*Predicate_IntValue) isPredicate_Value() {}
 
 func (*Predicate_LongValue) isPredicate_Value() {}
@@ -285,6 +373,8 @@ func (*Predicate_LongValues) isPredicate_Value() {}
 
 func (*Predicate_StringValues) isPredicate_Value() {}
 
+func (*Predicate_DoubleValue) isPredicate_Value() {}
+
 type IntValues struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -466,13 +556,53 @@ func (x *LongValues) GetValues() []int64 {
 //     }
 //   }
 // }
+//
+// 4) Filter runs that failed, or whose name does not start with 'tmp'
+//
+// filter {
+//   group {
+//     op: OR
+//     predicate {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Failed"
+//     }
+//     group {
+//       op: NOT
+//       predicate {
+//         key: "name"
+//         op: STARTS_WITH
+//         string_value: "tmp"
+//       }
+//     }
+//   }
+// }
+//
+// 5) Filter runs with an accuracy metric above 0.9 and a learning_rate
+// parameter of 0.01. Runs can be filtered on their metrics with the
+// "metric.<name>" keys and on their parameters, which are compared as strings,
+// with the "parameter.<name>" keys.
+//
+// filter {
+//   predicate {
+//     key: "metric.accuracy"
+//     op: GREATER_THAN
+//     double_value: 0.9
+//   }
+//   predicate {
+//     key: "parameter.learning_rate"
+//     op: EQUALS
+//     string_value: "0.01"
+//   }
+// }
 type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// All predicates are AND-ed when this filter is applied.
-	Predicates []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// All predicates and groups are AND-ed when this filter is applied.
+	Predicates []*Predicate   `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Groups     []*FilterGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
 func (x *Filter) Reset() {
@@ -514,6 +644,77 @@ func (x *Filter) GetPredicates() []*Predicate {
 	return nil
 }
 
+func (x *Filter) GetGroups() []*FilterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// FilterGroup combines predicates and nested groups with a logical operator.
+type FilterGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op         FilterGroup_Op `protobuf:"varint,1,opt,name=op,proto3,enum=api.FilterGroup_Op" json:"op,omitempty"`
+	Predicates []*Predicate   `protobuf:"bytes,2,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Groups     []*FilterGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *FilterGroup) Reset() {
+	*x = FilterGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_backend_api_filter_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterGroup) ProtoMessage() {}
+
+func (x *FilterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_backend_api_filter_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterGroup.ProtoReflect.Descriptor instead.
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return file_backend_api_filter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FilterGroup) GetOp() FilterGroup_Op {
+	if x != nil {
+		return x.Op
+	}
+	return FilterGroup_UNKNOWN
+}
+
+func (x *FilterGroup) GetPredicates() []*Predicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+func (x *FilterGroup) GetGroups() []*FilterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 var File_backend_api_filter_proto protoreflect.FileDescriptor
 
 var file_backend_api_filter_proto_rawDesc = []byte{
@@ -522,8 +723,8 @@ var file_backend_api_filter_proto_rawDesc = []byte{
 	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae,
-	0x04, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x02,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90,
+	0x05, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x02,
 	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
@@ -547,36 +748,57 @@ var file_backend_api_filter_proto_rawDesc = []byte{
 	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0c,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a,
-	0x02, 0x4f, 0x70, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
-	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
-	0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x45,
-	0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x48, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54,
-	0x48, 0x41, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x07, 0x12, 0x06, 0x0a, 0x02,
-	0x49, 0x4e, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54,
-	0x52, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x23, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0a,
-	0x4c, 0x6f, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x22, 0x38, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0a,
-	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x32, 0x3d, 0x0a, 0x12,
-	0x44, 0x75, 0x6d, 0x6d, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c,
-	0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0c,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xd4, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41,
+	0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x48, 0x41, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09,
+	0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4c,
+	0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10,
+	0x07, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x4e, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x5f,
+	0x53, 0x55, 0x42, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x53, 0x5f, 0x4e, 0x55,
+	0x4c, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x52, 0x54, 0x53, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53,
+	0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x0d, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x23, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x24,
+	0x0a, 0x0a, 0x4c, 0x6f, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2b, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e,
+	0x4f, 0x54, 0x10, 0x03, 0x32, 0x3d, 0x0a, 0x12, 0x44, 0x75, 0x6d, 0x6d, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x6f, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -591,31 +813,37 @@ func file_backend_api_filter_proto_rawDescGZIP() []byte {
 	return file_backend_api_filter_proto_rawDescData
 }
 
-var file_backend_api_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_backend_api_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_backend_api_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_backend_api_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_backend_api_filter_proto_goTypes = []interface{}{
 	(Predicate_Op)(0),             // 0: api.Predicate.Op
-	(*Predicate)(nil),             // 1: api.Predicate
-	(*IntValues)(nil),             // 2: api.IntValues
-	(*StringValues)(nil),          // 3: api.StringValues
-	(*LongValues)(nil),            // 4: api.LongValues
-	(*Filter)(nil),                // 5: api.Filter
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(FilterGroup_Op)(0),           // 1: api.FilterGroup.Op
+	(*Predicate)(nil),             // 2: api.Predicate
+	(*IntValues)(nil),             // 3: api.IntValues
+	(*StringValues)(nil),          // 4: api.StringValues
+	(*LongValues)(nil),            // 5: api.LongValues
+	(*Filter)(nil),                // 6: api.Filter
+	(*FilterGroup)(nil),           // 7: api.FilterGroup
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_backend_api_filter_proto_depIdxs = []int32{
-	0, // 0: api.Predicate.op:type_name -> api.Predicate.Op
-	6, // 1: api.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
-	2, // 2: api.Predicate.int_values:type_name -> api.IntValues
-	4, // 3: api.Predicate.long_values:type_name -> api.LongValues
-	3, // 4: api.Predicate.string_values:type_name -> api.StringValues
-	1, // 5: api.Filter.predicates:type_name -> api.Predicate
-	5, // 6: api.DummyFilterService.GetFilter:input_type -> api.Filter
-	5, // 7: api.DummyFilterService.GetFilter:output_type -> api.Filter
-	7, // [7:8] is the sub-list for method output_type
-	6, // [6:7] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: api.Predicate.op:type_name -> api.Predicate.Op
+	8,  // 1: api.Predicate.timestamp_value:type_name -> google.protobuf.Timestamp
+	3,  // 2: api.Predicate.int_values:type_name -> api.IntValues
+	5,  // 3: api.Predicate.long_values:type_name -> api.LongValues
+	4,  // 4: api.Predicate.string_values:type_name -> api.StringValues
+	2,  // 5: api.Filter.predicates:type_name -> api.Predicate
+	7,  // 6: api.Filter.groups:type_name -> api.FilterGroup
+	1,  // 7: api.FilterGroup.op:type_name -> api.FilterGroup.Op
+	2,  // 8: api.FilterGroup.predicates:type_name -> api.Predicate
+	7,  // 9: api.FilterGroup.groups:type_name -> api.FilterGroup
+	6,  // 10: api.DummyFilterService.GetFilter:input_type -> api.Filter
+	6,  // 11: api.DummyFilterService.GetFilter:output_type -> api.Filter
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_backend_api_filter_proto_init() }
@@ -684,6 +912,18 @@ func file_backend_api_filter_proto_init() {
 				return nil
 			}
 		}
+		file_backend_api_filter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_backend_api_filter_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Predicate_IntValue)(nil),
@@ -693,14 +933,15 @@ func file_backend_api_filter_proto_init() {
 		(*Predicate_IntValues)(nil),
 		(*Predicate_LongValues)(nil),
 		(*Predicate_StringValues)(nil),
+		(*Predicate_DoubleValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_backend_api_filter_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
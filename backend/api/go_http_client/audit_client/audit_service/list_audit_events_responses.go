@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	audit_model "github.com/kubeflow/pipelines/backend/api/go_http_client/audit_model"
+)
+
+// ListAuditEventsReader is a Reader for the ListAuditEvents structure.
+type ListAuditEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAuditEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListAuditEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewListAuditEventsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAuditEventsOK creates a ListAuditEventsOK with default headers values
+func NewListAuditEventsOK() *ListAuditEventsOK {
+	return &ListAuditEventsOK{}
+}
+
+/*ListAuditEventsOK handles this case with default header values.
+
+A successful response.
+*/
+type ListAuditEventsOK struct {
+	Payload *audit_model.APIListAuditEventsResponse
+}
+
+func (o *ListAuditEventsOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/audit_events][%d] listAuditEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAuditEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(audit_model.APIListAuditEventsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAuditEventsDefault creates a ListAuditEventsDefault with default headers values
+func NewListAuditEventsDefault(code int) *ListAuditEventsDefault {
+	return &ListAuditEventsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListAuditEventsDefault handles this case with default header values.
+
+ListAuditEventsDefault list audit events default
+*/
+type ListAuditEventsDefault struct {
+	_statusCode int
+
+	Payload *audit_model.APIStatus
+}
+
+// Code gets the status code for the list audit events default response
+func (o *ListAuditEventsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAuditEventsDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/audit_events][%d] ListAuditEvents default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAuditEventsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(audit_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
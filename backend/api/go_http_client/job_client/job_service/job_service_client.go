@@ -24,6 +24,35 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+BackfillJob creates the runs that the job would have triggered between the start and end time of the request the runs are created in the order of their scheduled time and respect the max concurrency of the job
+*/
+func (a *Client) BackfillJob(params *BackfillJobParams, authInfo runtime.ClientAuthInfoWriter) (*BackfillJobOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackfillJobParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BackfillJob",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/jobs/{id}/backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BackfillJobReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BackfillJobOK), nil
+
+}
+
 /*
 CreateJob creates a new job
 */
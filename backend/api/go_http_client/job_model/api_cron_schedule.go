@@ -28,6 +28,11 @@ type APICronSchedule struct {
 	// The start time of the cron job
 	// Format: date-time
 	StartTime strfmt.DateTime `json:"start_time,omitempty"`
+
+	// The IANA time zone in which the cron string is interpreted, such as
+	// "Europe/Stockholm". Defaults to the time zone of the scheduled workflow
+	// controller.
+	TimeZone string `json:"time_zone,omitempty"`
 }
 
 // Validate validates this api cron schedule
@@ -19,6 +19,9 @@ import (
 // swagger:model apiJob
 type APIJob struct {
 
+	// concurrency policy
+	ConcurrencyPolicy APIJobConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+
 	// Output. The time this job is created.
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
@@ -80,6 +83,10 @@ type APIJob struct {
 func (m *APIJob) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConcurrencyPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCreatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -110,6 +117,22 @@ func (m *APIJob) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIJob) validateConcurrencyPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConcurrencyPolicy) { // not required
+		return nil
+	}
+
+	if err := m.ConcurrencyPolicy.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("concurrency_policy")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *APIJob) validateCreatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CreatedAt) { // not required
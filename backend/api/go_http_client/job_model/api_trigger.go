@@ -16,17 +16,27 @@ import (
 // swagger:model apiTrigger
 type APITrigger struct {
 
+	// bucket object trigger
+	BucketObjectTrigger *APIBucketObjectTrigger `json:"bucket_object_trigger,omitempty"`
+
 	// cron schedule
 	CronSchedule *APICronSchedule `json:"cron_schedule,omitempty"`
 
 	// periodic schedule
 	PeriodicSchedule *APIPeriodicSchedule `json:"periodic_schedule,omitempty"`
+
+	// run dependency trigger
+	RunDependencyTrigger *APIRunDependencyTrigger `json:"run_dependency_trigger,omitempty"`
 }
 
 // Validate validates this api trigger
 func (m *APITrigger) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBucketObjectTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCronSchedule(formats); err != nil {
 		res = append(res, err)
 	}
@@ -35,12 +45,34 @@ func (m *APITrigger) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRunDependencyTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *APITrigger) validateBucketObjectTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BucketObjectTrigger) { // not required
+		return nil
+	}
+
+	if m.BucketObjectTrigger != nil {
+		if err := m.BucketObjectTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bucket_object_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *APITrigger) validateCronSchedule(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CronSchedule) { // not required
@@ -77,6 +109,24 @@ func (m *APITrigger) validatePeriodicSchedule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APITrigger) validateRunDependencyTrigger(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RunDependencyTrigger) { // not required
+		return nil
+	}
+
+	if m.RunDependencyTrigger != nil {
+		if err := m.RunDependencyTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("run_dependency_trigger")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *APITrigger) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -14,7 +14,7 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// APIRelationship api relationship
+// APIRelationship  - IMPORTED: The pipeline version is imported by a sub-pipeline of the run or job.
 // swagger:model apiRelationship
 type APIRelationship string
 
@@ -28,6 +28,9 @@ const (
 
 	// APIRelationshipCREATOR captures enum value "CREATOR"
 	APIRelationshipCREATOR APIRelationship = "CREATOR"
+
+	// APIRelationshipIMPORTED captures enum value "IMPORTED"
+	APIRelationshipIMPORTED APIRelationship = "IMPORTED"
 )
 
 // for schema
@@ -35,7 +38,7 @@ var apiRelationshipEnum []interface{}
 
 func init() {
 	var res []APIRelationship
-	if err := json.Unmarshal([]byte(`["UNKNOWN_RELATIONSHIP","OWNER","CREATOR"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["UNKNOWN_RELATIONSHIP","OWNER","CREATOR","IMPORTED"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
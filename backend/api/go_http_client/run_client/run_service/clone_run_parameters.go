@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// NewCloneRunParams creates a new CloneRunParams object
+// with the default values initialized.
+func NewCloneRunParams() *CloneRunParams {
+	var ()
+	return &CloneRunParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCloneRunParamsWithTimeout creates a new CloneRunParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCloneRunParamsWithTimeout(timeout time.Duration) *CloneRunParams {
+	var ()
+	return &CloneRunParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCloneRunParamsWithContext creates a new CloneRunParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCloneRunParamsWithContext(ctx context.Context) *CloneRunParams {
+	var ()
+	return &CloneRunParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCloneRunParamsWithHTTPClient creates a new CloneRunParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCloneRunParamsWithHTTPClient(client *http.Client) *CloneRunParams {
+	var ()
+	return &CloneRunParams{
+		HTTPClient: client,
+	}
+}
+
+/*CloneRunParams contains all the parameters to send to the API endpoint
+for the clone run operation typically these are written to a http.Request
+*/
+type CloneRunParams struct {
+
+	/*Body*/
+	Body *run_model.APICloneRunRequest
+	/*RunID
+	  The ID of the run to be cloned.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the clone run params
+func (o *CloneRunParams) WithTimeout(timeout time.Duration) *CloneRunParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the clone run params
+func (o *CloneRunParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the clone run params
+func (o *CloneRunParams) WithContext(ctx context.Context) *CloneRunParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the clone run params
+func (o *CloneRunParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the clone run params
+func (o *CloneRunParams) WithHTTPClient(client *http.Client) *CloneRunParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the clone run params
+func (o *CloneRunParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the clone run params
+func (o *CloneRunParams) WithBody(body *run_model.APICloneRunRequest) *CloneRunParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the clone run params
+func (o *CloneRunParams) SetBody(body *run_model.APICloneRunRequest) {
+	o.Body = body
+}
+
+// WithRunID adds the runID to the clone run params
+func (o *CloneRunParams) WithRunID(runID string) *CloneRunParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the clone run params
+func (o *CloneRunParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CloneRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// CloneRunReader is a Reader for the CloneRun structure.
+type CloneRunReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CloneRunReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCloneRunOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewCloneRunDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCloneRunOK creates a CloneRunOK with default headers values
+func NewCloneRunOK() *CloneRunOK {
+	return &CloneRunOK{}
+}
+
+/*CloneRunOK handles this case with default header values.
+
+A successful response.
+*/
+type CloneRunOK struct {
+	Payload *run_model.APIRunDetail
+}
+
+func (o *CloneRunOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/clone][%d] cloneRunOK  %+v", 200, o.Payload)
+}
+
+func (o *CloneRunOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIRunDetail)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCloneRunDefault creates a CloneRunDefault with default headers values
+func NewCloneRunDefault(code int) *CloneRunDefault {
+	return &CloneRunDefault{
+		_statusCode: code,
+	}
+}
+
+/*CloneRunDefault handles this case with default header values.
+
+CloneRunDefault clone run default
+*/
+type CloneRunDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the clone run default response
+func (o *CloneRunDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CloneRunDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/clone][%d] CloneRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CloneRunDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
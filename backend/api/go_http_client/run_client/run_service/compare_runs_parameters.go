@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCompareRunsParams creates a new CompareRunsParams object
+// with the default values initialized.
+func NewCompareRunsParams() *CompareRunsParams {
+	var ()
+	return &CompareRunsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCompareRunsParamsWithTimeout creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCompareRunsParamsWithTimeout(timeout time.Duration) *CompareRunsParams {
+	var ()
+	return &CompareRunsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCompareRunsParamsWithContext creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCompareRunsParamsWithContext(ctx context.Context) *CompareRunsParams {
+	var ()
+	return &CompareRunsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCompareRunsParamsWithHTTPClient creates a new CompareRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCompareRunsParamsWithHTTPClient(client *http.Client) *CompareRunsParams {
+	var ()
+	return &CompareRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*CompareRunsParams contains all the parameters to send to the API endpoint
+for the compare runs operation typically these are written to a http.Request
+*/
+type CompareRunsParams struct {
+
+	/*RunIds
+	  The IDs of the runs to compare. At least two and at most 10 runs are
+	required. The first run is the baseline the metric deltas are computed
+	against.
+
+	*/
+	RunIds []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the compare runs params
+func (o *CompareRunsParams) WithTimeout(timeout time.Duration) *CompareRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the compare runs params
+func (o *CompareRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the compare runs params
+func (o *CompareRunsParams) WithContext(ctx context.Context) *CompareRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the compare runs params
+func (o *CompareRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the compare runs params
+func (o *CompareRunsParams) WithHTTPClient(client *http.Client) *CompareRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the compare runs params
+func (o *CompareRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRunIds adds the runIds to the compare runs params
+func (o *CompareRunsParams) WithRunIds(runIds []string) *CompareRunsParams {
+	o.SetRunIds(runIds)
+	return o
+}
+
+// SetRunIds adds the runIds to the compare runs params
+func (o *CompareRunsParams) SetRunIds(runIds []string) {
+	o.RunIds = runIds
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CompareRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	valuesRunIds := o.RunIds
+
+	joinedRunIds := swag.JoinByFormat(valuesRunIds, "multi")
+	// query array param run_ids
+	if err := r.SetQueryParam("run_ids", joinedRunIds...); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
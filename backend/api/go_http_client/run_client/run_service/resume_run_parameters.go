@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewResumeRunParams creates a new ResumeRunParams object
+// with the default values initialized.
+func NewResumeRunParams() *ResumeRunParams {
+	var ()
+	return &ResumeRunParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewResumeRunParamsWithTimeout creates a new ResumeRunParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewResumeRunParamsWithTimeout(timeout time.Duration) *ResumeRunParams {
+	var ()
+	return &ResumeRunParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewResumeRunParamsWithContext creates a new ResumeRunParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewResumeRunParamsWithContext(ctx context.Context) *ResumeRunParams {
+	var ()
+	return &ResumeRunParams{
+
+		Context: ctx,
+	}
+}
+
+// NewResumeRunParamsWithHTTPClient creates a new ResumeRunParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewResumeRunParamsWithHTTPClient(client *http.Client) *ResumeRunParams {
+	var ()
+	return &ResumeRunParams{
+		HTTPClient: client,
+	}
+}
+
+/*ResumeRunParams contains all the parameters to send to the API endpoint
+for the resume run operation typically these are written to a http.Request
+*/
+type ResumeRunParams struct {
+
+	/*RunID
+	  The ID of the run to be resumed.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the resume run params
+func (o *ResumeRunParams) WithTimeout(timeout time.Duration) *ResumeRunParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the resume run params
+func (o *ResumeRunParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the resume run params
+func (o *ResumeRunParams) WithContext(ctx context.Context) *ResumeRunParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the resume run params
+func (o *ResumeRunParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the resume run params
+func (o *ResumeRunParams) WithHTTPClient(client *http.Client) *ResumeRunParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the resume run params
+func (o *ResumeRunParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRunID adds the runID to the resume run params
+func (o *ResumeRunParams) WithRunID(runID string) *ResumeRunParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the resume run params
+func (o *ResumeRunParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ResumeRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// ResumeRunReader is a Reader for the ResumeRun structure.
+type ResumeRunReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ResumeRunReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewResumeRunOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewResumeRunDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewResumeRunOK creates a ResumeRunOK with default headers values
+func NewResumeRunOK() *ResumeRunOK {
+	return &ResumeRunOK{}
+}
+
+/*ResumeRunOK handles this case with default header values.
+
+A successful response.
+*/
+type ResumeRunOK struct {
+	Payload interface{}
+}
+
+func (o *ResumeRunOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/resume][%d] resumeRunOK  %+v", 200, o.Payload)
+}
+
+func (o *ResumeRunOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResumeRunDefault creates a ResumeRunDefault with default headers values
+func NewResumeRunDefault(code int) *ResumeRunDefault {
+	return &ResumeRunDefault{
+		_statusCode: code,
+	}
+}
+
+/*ResumeRunDefault handles this case with default header values.
+
+ResumeRunDefault resume run default
+*/
+type ResumeRunDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the resume run default response
+func (o *ResumeRunDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ResumeRunDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/resume][%d] ResumeRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ResumeRunDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
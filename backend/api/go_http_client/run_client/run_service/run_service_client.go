@@ -53,6 +53,64 @@ func (a *Client) ArchiveRun(params *ArchiveRunParams, authInfo runtime.ClientAut
 
 }
 
+/*
+CloneRun creates a new run from the pipeline spec and resource references of an existing run
+*/
+func (a *Client) CloneRun(params *CloneRunParams, authInfo runtime.ClientAuthInfoWriter) (*CloneRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCloneRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CloneRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/clone",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CloneRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CloneRunOK), nil
+
+}
+
+/*
+CompareRuns compares the parameters metrics and pipeline specs of runs
+*/
+func (a *Client) CompareRuns(params *CompareRunsParams, authInfo runtime.ClientAuthInfoWriter) (*CompareRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCompareRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CompareRuns",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs:compare",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CompareRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CompareRunsOK), nil
+
+}
+
 /*
 CreateRun creates a new run
 */
@@ -227,6 +285,35 @@ func (a *Client) ReportRunMetrics(params *ReportRunMetricsParams, authInfo runti
 
 }
 
+/*
+ResumeRun resumes a suspended run
+*/
+func (a *Client) ResumeRun(params *ResumeRunParams, authInfo runtime.ClientAuthInfoWriter) (*ResumeRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResumeRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ResumeRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ResumeRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ResumeRunOK), nil
+
+}
+
 /*
 RetryRun res initiates a failed or terminated run
 */
@@ -256,6 +343,35 @@ func (a *Client) RetryRun(params *RetryRunParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+SuspendRun suspends a running run the steps that already started run to completion but no new step starts until the run is resumed
+*/
+func (a *Client) SuspendRun(params *SuspendRunParams, authInfo runtime.ClientAuthInfoWriter) (*SuspendRunOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSuspendRunParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SuspendRun",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/suspend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SuspendRunReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SuspendRunOK), nil
+
+}
+
 /*
 TerminateRun terminates an active run
 */
@@ -314,6 +430,35 @@ func (a *Client) UnarchiveRun(params *UnarchiveRunParams, authInfo runtime.Clien
 
 }
 
+/*
+WatchRuns watches the runs streams an event whenever a run that matches the request is created changes condition is archived unarchived or deleted over HTTP the events are sent as server sent events when the request has the accept text event stream header
+*/
+func (a *Client) WatchRuns(params *WatchRunsParams, authInfo runtime.ClientAuthInfoWriter) (*WatchRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWatchRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "WatchRuns",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs:watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &WatchRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WatchRunsOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
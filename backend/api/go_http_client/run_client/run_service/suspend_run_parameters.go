@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSuspendRunParams creates a new SuspendRunParams object
+// with the default values initialized.
+func NewSuspendRunParams() *SuspendRunParams {
+	var ()
+	return &SuspendRunParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSuspendRunParamsWithTimeout creates a new SuspendRunParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSuspendRunParamsWithTimeout(timeout time.Duration) *SuspendRunParams {
+	var ()
+	return &SuspendRunParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSuspendRunParamsWithContext creates a new SuspendRunParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSuspendRunParamsWithContext(ctx context.Context) *SuspendRunParams {
+	var ()
+	return &SuspendRunParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSuspendRunParamsWithHTTPClient creates a new SuspendRunParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSuspendRunParamsWithHTTPClient(client *http.Client) *SuspendRunParams {
+	var ()
+	return &SuspendRunParams{
+		HTTPClient: client,
+	}
+}
+
+/*SuspendRunParams contains all the parameters to send to the API endpoint
+for the suspend run operation typically these are written to a http.Request
+*/
+type SuspendRunParams struct {
+
+	/*RunID
+	  The ID of the run to be suspended.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the suspend run params
+func (o *SuspendRunParams) WithTimeout(timeout time.Duration) *SuspendRunParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the suspend run params
+func (o *SuspendRunParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the suspend run params
+func (o *SuspendRunParams) WithContext(ctx context.Context) *SuspendRunParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the suspend run params
+func (o *SuspendRunParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the suspend run params
+func (o *SuspendRunParams) WithHTTPClient(client *http.Client) *SuspendRunParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the suspend run params
+func (o *SuspendRunParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRunID adds the runID to the suspend run params
+func (o *SuspendRunParams) WithRunID(runID string) *SuspendRunParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the suspend run params
+func (o *SuspendRunParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SuspendRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// SuspendRunReader is a Reader for the SuspendRun structure.
+type SuspendRunReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SuspendRunReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewSuspendRunOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewSuspendRunDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSuspendRunOK creates a SuspendRunOK with default headers values
+func NewSuspendRunOK() *SuspendRunOK {
+	return &SuspendRunOK{}
+}
+
+/*SuspendRunOK handles this case with default header values.
+
+A successful response.
+*/
+type SuspendRunOK struct {
+	Payload interface{}
+}
+
+func (o *SuspendRunOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/suspend][%d] suspendRunOK  %+v", 200, o.Payload)
+}
+
+func (o *SuspendRunOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSuspendRunDefault creates a SuspendRunDefault with default headers values
+func NewSuspendRunDefault(code int) *SuspendRunDefault {
+	return &SuspendRunDefault{
+		_statusCode: code,
+	}
+}
+
+/*SuspendRunDefault handles this case with default header values.
+
+SuspendRunDefault suspend run default
+*/
+type SuspendRunDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the suspend run default response
+func (o *SuspendRunDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SuspendRunDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs/{run_id}/suspend][%d] SuspendRun default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SuspendRunDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
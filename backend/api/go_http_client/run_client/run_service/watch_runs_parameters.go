@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewWatchRunsParams creates a new WatchRunsParams object
+// with the default values initialized.
+func NewWatchRunsParams() *WatchRunsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &WatchRunsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWatchRunsParamsWithTimeout creates a new WatchRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewWatchRunsParamsWithTimeout(timeout time.Duration) *WatchRunsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &WatchRunsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewWatchRunsParamsWithContext creates a new WatchRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewWatchRunsParamsWithContext(ctx context.Context) *WatchRunsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &WatchRunsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewWatchRunsParamsWithHTTPClient creates a new WatchRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewWatchRunsParamsWithHTTPClient(client *http.Client) *WatchRunsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &WatchRunsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		HTTPClient:               client,
+	}
+}
+
+/*WatchRunsParams contains all the parameters to send to the API endpoint
+for the watch runs operation typically these are written to a http.Request
+*/
+type WatchRunsParams struct {
+
+	/*Filter
+	  A url-encoded, JSON-serialized Filter protocol buffer (see
+	[filter.proto](https://github.com/kubeflow/pipelines/blob/master/backend/api/filter.proto)).
+	Runs are matched against the filter after the change.
+
+	*/
+	Filter *string
+	/*ResourceReferenceKeyID
+	  The ID of the resource that referred to.
+
+	*/
+	ResourceReferenceKeyID *string
+	/*ResourceReferenceKeyType
+	  The type of the resource that referred to.
+
+	*/
+	ResourceReferenceKeyType *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the watch runs params
+func (o *WatchRunsParams) WithTimeout(timeout time.Duration) *WatchRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the watch runs params
+func (o *WatchRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the watch runs params
+func (o *WatchRunsParams) WithContext(ctx context.Context) *WatchRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the watch runs params
+func (o *WatchRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the watch runs params
+func (o *WatchRunsParams) WithHTTPClient(client *http.Client) *WatchRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the watch runs params
+func (o *WatchRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFilter adds the filter to the watch runs params
+func (o *WatchRunsParams) WithFilter(filter *string) *WatchRunsParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the watch runs params
+func (o *WatchRunsParams) SetFilter(filter *string) {
+	o.Filter = filter
+}
+
+// WithResourceReferenceKeyID adds the resourceReferenceKeyID to the watch runs params
+func (o *WatchRunsParams) WithResourceReferenceKeyID(resourceReferenceKeyID *string) *WatchRunsParams {
+	o.SetResourceReferenceKeyID(resourceReferenceKeyID)
+	return o
+}
+
+// SetResourceReferenceKeyID adds the resourceReferenceKeyId to the watch runs params
+func (o *WatchRunsParams) SetResourceReferenceKeyID(resourceReferenceKeyID *string) {
+	o.ResourceReferenceKeyID = resourceReferenceKeyID
+}
+
+// WithResourceReferenceKeyType adds the resourceReferenceKeyType to the watch runs params
+func (o *WatchRunsParams) WithResourceReferenceKeyType(resourceReferenceKeyType *string) *WatchRunsParams {
+	o.SetResourceReferenceKeyType(resourceReferenceKeyType)
+	return o
+}
+
+// SetResourceReferenceKeyType adds the resourceReferenceKeyType to the watch runs params
+func (o *WatchRunsParams) SetResourceReferenceKeyType(resourceReferenceKeyType *string) {
+	o.ResourceReferenceKeyType = resourceReferenceKeyType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WatchRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Filter != nil {
+
+		// query param filter
+		var qrFilter string
+		if o.Filter != nil {
+			qrFilter = *o.Filter
+		}
+		qFilter := qrFilter
+		if qFilter != "" {
+			if err := r.SetQueryParam("filter", qFilter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ResourceReferenceKeyID != nil {
+
+		// query param resource_reference_key.id
+		var qrResourceReferenceKeyID string
+		if o.ResourceReferenceKeyID != nil {
+			qrResourceReferenceKeyID = *o.ResourceReferenceKeyID
+		}
+		qResourceReferenceKeyID := qrResourceReferenceKeyID
+		if qResourceReferenceKeyID != "" {
+			if err := r.SetQueryParam("resource_reference_key.id", qResourceReferenceKeyID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ResourceReferenceKeyType != nil {
+
+		// query param resource_reference_key.type
+		var qrResourceReferenceKeyType string
+		if o.ResourceReferenceKeyType != nil {
+			qrResourceReferenceKeyType = *o.ResourceReferenceKeyType
+		}
+		qResourceReferenceKeyType := qrResourceReferenceKeyType
+		if qResourceReferenceKeyType != "" {
+			if err := r.SetQueryParam("resource_reference_key.type", qResourceReferenceKeyType); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
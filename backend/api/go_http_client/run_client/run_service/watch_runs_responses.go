@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// WatchRunsReader is a Reader for the WatchRuns structure.
+type WatchRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WatchRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewWatchRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewWatchRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewWatchRunsOK creates a WatchRunsOK with default headers values
+func NewWatchRunsOK() *WatchRunsOK {
+	return &WatchRunsOK{}
+}
+
+/*WatchRunsOK handles this case with default header values.
+
+A successful response.(streaming responses)
+*/
+type WatchRunsOK struct {
+	Payload *run_model.APIRunEventStreamResult
+}
+
+func (o *WatchRunsOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs:watch][%d] watchRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *WatchRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIRunEventStreamResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewWatchRunsDefault creates a WatchRunsDefault with default headers values
+func NewWatchRunsDefault(code int) *WatchRunsDefault {
+	return &WatchRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*WatchRunsDefault handles this case with default header values.
+
+WatchRunsDefault watch runs default
+*/
+type WatchRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the watch runs default response
+func (o *WatchRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *WatchRunsDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs:watch][%d] WatchRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *WatchRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APICompareRunsResponse api compare runs response
+// swagger:model apiCompareRunsResponse
+type APICompareRunsResponse struct {
+
+	// The metrics of the runs, sorted by node ID and name.
+	Metrics []*APIMetricComparison `json:"metrics"`
+
+	// The parameters of the runs, sorted by name.
+	Parameters []*APIParameterComparison `json:"parameters"`
+
+	// The fields of the pipeline specs whose values aren't the same in all the
+	// runs, sorted by path.
+	PipelineSpecDifferences []*APIPipelineSpecDifference `json:"pipeline_spec_differences"`
+
+	// The IDs of the compared runs. The values of each comparison are in this
+	// order.
+	RunIds []string `json:"run_ids"`
+}
+
+// Validate validates this api compare runs response
+func (m *APICompareRunsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMetrics(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateParameters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePipelineSpecDifferences(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APICompareRunsResponse) validateMetrics(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Metrics) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Metrics); i++ {
+		if swag.IsZero(m.Metrics[i]) { // not required
+			continue
+		}
+
+		if m.Metrics[i] != nil {
+			if err := m.Metrics[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("metrics" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APICompareRunsResponse) validateParameters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Parameters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Parameters); i++ {
+		if swag.IsZero(m.Parameters[i]) { // not required
+			continue
+		}
+
+		if m.Parameters[i] != nil {
+			if err := m.Parameters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("parameters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APICompareRunsResponse) validatePipelineSpecDifferences(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PipelineSpecDifferences) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PipelineSpecDifferences); i++ {
+		if swag.IsZero(m.PipelineSpecDifferences[i]) { // not required
+			continue
+		}
+
+		if m.PipelineSpecDifferences[i] != nil {
+			if err := m.PipelineSpecDifferences[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pipeline_spec_differences" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APICompareRunsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APICompareRunsResponse) UnmarshalBinary(b []byte) error {
+	var res APICompareRunsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
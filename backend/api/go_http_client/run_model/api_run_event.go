@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunEvent api run event
+// swagger:model apiRunEvent
+type APIRunEvent struct {
+
+	// Output. The time the change happened.
+	// Format: date-time
+	EventAt strfmt.DateTime `json:"event_at,omitempty"`
+
+	// The run after the change, or before it for a deleted run.
+	Run *APIRun `json:"run,omitempty"`
+
+	// type
+	Type APIRunEventType `json:"type,omitempty"`
+}
+
+// Validate validates this api run event
+func (m *APIRunEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEventAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRun(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIRunEvent) validateEventAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EventAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("event_at", "body", "date-time", m.EventAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIRunEvent) validateRun(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Run) { // not required
+		return nil
+	}
+
+	if m.Run != nil {
+		if err := m.Run.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIRunEvent) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRunEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRunEvent) UnmarshalBinary(b []byte) error {
+	var res APIRunEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
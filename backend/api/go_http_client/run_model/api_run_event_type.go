@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunEventType  - UPDATED: The run's status changed.
+// swagger:model apiRunEventType
+type APIRunEventType string
+
+const (
+
+	// APIRunEventTypeUNKNOWN captures enum value "UNKNOWN"
+	APIRunEventTypeUNKNOWN APIRunEventType = "UNKNOWN"
+
+	// APIRunEventTypeCREATED captures enum value "CREATED"
+	APIRunEventTypeCREATED APIRunEventType = "CREATED"
+
+	// APIRunEventTypeUPDATED captures enum value "UPDATED"
+	APIRunEventTypeUPDATED APIRunEventType = "UPDATED"
+
+	// APIRunEventTypeARCHIVED captures enum value "ARCHIVED"
+	APIRunEventTypeARCHIVED APIRunEventType = "ARCHIVED"
+
+	// APIRunEventTypeUNARCHIVED captures enum value "UNARCHIVED"
+	APIRunEventTypeUNARCHIVED APIRunEventType = "UNARCHIVED"
+
+	// APIRunEventTypeDELETED captures enum value "DELETED"
+	APIRunEventTypeDELETED APIRunEventType = "DELETED"
+)
+
+// for schema
+var apiRunEventTypeEnum []interface{}
+
+func init() {
+	var res []APIRunEventType
+	if err := json.Unmarshal([]byte(`["UNKNOWN","CREATED","UPDATED","ARCHIVED","UNARCHIVED","DELETED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiRunEventTypeEnum = append(apiRunEventTypeEnum, v)
+	}
+}
+
+func (m APIRunEventType) validateAPIRunEventTypeEnum(path, location string, value APIRunEventType) error {
+	if err := validate.Enum(path, location, value, apiRunEventTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api run event type
+func (m APIRunEventType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIRunEventTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -68,6 +68,13 @@ jq -s 'reduce .[] as $item ({}; . * $item) | .info.title = "Kubeflow Pipelines A
     backend/api/swagger/healthz.swagger.json \
     > "backend/api/swagger/kfp_api_single_file.swagger.json"
 # Generate go_http_client from swagger json.
+# go-swagger cannot resolve the x-stream-definitions of the streaming APIs,
+# so move them to the definitions with a StreamResult suffix.
+jq '(.. | objects | select(has("$ref")) | .["$ref"]) |= sub("^#/x-stream-definitions/(?<name>.*)$"; "#/definitions/\(.name)StreamResult")
+    | .definitions += ((."x-stream-definitions" // {}) | with_entries(.key += "StreamResult"))
+    | del(."x-stream-definitions")' \
+    backend/api/swagger/run.swagger.json \
+    > ${TMP_OUTPUT}/run.swagger.json
 swagger generate client \
     -f backend/api/swagger/job.swagger.json \
     -A job \
@@ -76,7 +83,7 @@ swagger generate client \
     -m job_model \
     -t backend/api/go_http_client
 swagger generate client \
-    -f backend/api/swagger/run.swagger.json \
+    -f ${TMP_OUTPUT}/run.swagger.json \
     -A run \
     --principal models.Principal \
     -c run_client \
@@ -117,6 +124,13 @@ swagger generate client \
     -c healthz_client \
     -m healthz_model \
     -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/audit.swagger.json \
+    -A audit \
+    --principal models.Principal \
+    -c audit_client \
+    -m audit_model \
+    -t backend/api/go_http_client
 # Hack to fix an issue with go-swagger
 # See https://github.com/go-swagger/go-swagger/issues/1381 for details.
 sed -i -- 's/MaxConcurrency int64 `json:"max_concurrency,omitempty"`/MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`/g' backend/api/go_http_client/job_model/api_job.go
@@ -17,6 +17,7 @@ package auth
 import (
 	"context"
 
+	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -27,19 +28,69 @@ type Authenticator interface {
 	GetUserIdentity(ctx context.Context) (string, error)
 }
 
+// UserInfo is the identity and the groups of an authenticated user.
+type UserInfo struct {
+	Name   string
+	Groups []string
+}
+
+// UserInfoAuthenticator is an Authenticator that also knows the groups of the
+// users, e.g. from the claims of their tokens.
+type UserInfoAuthenticator interface {
+	Authenticator
+	GetUserInfo(ctx context.Context) (*UserInfo, error)
+}
+
+// GetUserInfo returns the identity of the user of a request, and the groups of
+// the user if the authenticator knows them.
+func GetUserInfo(ctx context.Context, authenticator Authenticator) (*UserInfo, error) {
+	if userInfoAuthenticator, ok := authenticator.(UserInfoAuthenticator); ok {
+		return userInfoAuthenticator.GetUserInfo(ctx)
+	}
+	userIdentity, err := authenticator.GetUserIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UserInfo{Name: userIdentity}, nil
+}
+
 var IdentityHeaderMissingError = util.NewUnauthenticatedError(
 	errors.New("Request header error: there is no user identity header."),
 	"Request header error: there is no user identity header.",
 )
 
 func GetAuthenticators(tokenReviewClient client.TokenReviewInterface) []Authenticator {
-	return []Authenticator{
-		NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()),
-		NewTokenReviewAuthenticator(
+	var authenticators []Authenticator
+	if common.IsKubeflowUserIDHeaderEnabled() {
+		if common.GetOIDCIssuer() != "" {
+			glog.Warningf("Both %s and %s are set: the user identity header is trusted before OIDC tokens, make sure a proxy sets it for every request",
+				common.KubeflowUserIDHeaderEnabled, common.OIDCIssuer)
+		}
+		authenticators = append(authenticators, NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()))
+	}
+	// OIDC tokens come before service account tokens, they are verified
+	// without a request to Kubernetes.
+	if issuer := common.GetOIDCIssuer(); issuer != "" {
+		audiences := common.GetOIDCAudiences()
+		if len(audiences) == 0 {
+			glog.Fatalf("Please specify flag %s, OIDC authentication requires the audiences of tokens", common.OIDCAudiences)
+		}
+		authenticators = append(authenticators, NewOIDCAuthenticator(
 			common.AuthorizationBearerTokenHeader,
 			common.AuthorizationBearerTokenPrefix,
-			[]string{common.GetTokenReviewAudience()},
-			tokenReviewClient,
-		),
+			issuer,
+			common.GetOIDCJWKSURL(),
+			audiences,
+			common.GetOIDCUserClaim(),
+			common.GetOIDCGroupsClaim(),
+			nil,
+		))
 	}
+	authenticators = append(authenticators, NewTokenReviewAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		[]string{common.GetTokenReviewAudience()},
+		tokenReviewClient,
+	))
+	return authenticators
 }
@@ -0,0 +1,189 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// The signing algorithms of the tokens. Only asymmetric algorithms are
+// supported, the OIDC issuer publishes their public keys.
+var oidcSigningAlgorithms = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+}
+
+// OIDCAuthenticator authenticates the bearer JSON Web Tokens that an OIDC
+// issuer signed, e.g. the ID tokens of a corporate SSO. The user identity is
+// a claim of the token, such as email or sub.
+type OIDCAuthenticator struct {
+	// tokenHeader in which the authenticator expects to find the token
+	tokenHeader string
+	// tokenPrefix is the prefix encountered before the token
+	tokenPrefix string
+	// issuer that the iss claim must match
+	issuer string
+	// jwksURL is the URL of the key set of the issuer, empty to discover it
+	// from the OIDC discovery document of the issuer.
+	jwksURL string
+	// audiences of which the aud claim must contain one
+	audiences []string
+	// userClaim is the string claim of the user identity
+	userClaim string
+	// groupsClaim is the optional claim of the groups of the user, a string
+	// or a list of strings
+	groupsClaim string
+	// client fetches the discovery document and the key set of the issuer.
+	client *http.Client
+	now    func() time.Time
+
+	mutex sync.Mutex
+	// verifier verifies the tokens with the keys of the issuer. It is created
+	// on the first request rather than on startup, so that the API server
+	// starts even if the issuer is down.
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCAuthenticator creates an authenticator of the tokens of an OIDC
+// issuer. jwksURL is optional, the URL of the key set is discovered from the
+// OIDC discovery document of the issuer if it is empty.
+func NewOIDCAuthenticator(tokenHeader, tokenPrefix, issuer, jwksURL string, audiences []string, userClaim, groupsClaim string, client *http.Client) *OIDCAuthenticator {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &OIDCAuthenticator{
+		tokenHeader: tokenHeader,
+		tokenPrefix: tokenPrefix,
+		issuer:      issuer,
+		jwksURL:     jwksURL,
+		audiences:   audiences,
+		userClaim:   userClaim,
+		groupsClaim: groupsClaim,
+		client:      client,
+		now:         time.Now,
+	}
+}
+
+func (oa *OIDCAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
+	userInfo, err := oa.GetUserInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return userInfo.Name, nil
+}
+
+func (oa *OIDCAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, oa.tokenHeader, oa.tokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := oa.verify(ctx, token)
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(err, "Authentication failure: invalid OIDC token: %v", err)
+	}
+	userIdentity, ok := claims[oa.userClaim].(string)
+	if !ok || userIdentity == "" {
+		msg := "Authentication failure: the OIDC token has no string claim " + oa.userClaim
+		return nil, util.NewUnauthenticatedError(errors.New(msg), msg)
+	}
+	userInfo := &UserInfo{Name: userIdentity}
+	if oa.groupsClaim == "" {
+		return userInfo, nil
+	}
+	// Tokens without the groups claim are valid, the user has no groups.
+	switch groups := claims[oa.groupsClaim].(type) {
+	case string:
+		userInfo.Groups = []string{groups}
+	case []interface{}:
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				userInfo.Groups = append(userInfo.Groups, group)
+			}
+		}
+	}
+	return userInfo, nil
+}
+
+// verify verifies the signature, the issuer, the audience and the validity
+// period of a token, and returns its claims.
+func (oa *OIDCAuthenticator) verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	verifier, err := oa.getVerifier()
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !oa.hasAudience(idToken.Audience) {
+		return nil, errors.Errorf("none of the audiences %v in the token", oa.audiences)
+	}
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, "malformed claims")
+	}
+	return claims, nil
+}
+
+// getVerifier returns the verifier of the tokens. The key set of the issuer is
+// discovered from its discovery document, whose issuer must be the expected
+// one, unless the URL of the key set is configured. The discovery is tried
+// again on the next request if it fails.
+func (oa *OIDCAuthenticator) getVerifier() (*oidc.IDTokenVerifier, error) {
+	oa.mutex.Lock()
+	defer oa.mutex.Unlock()
+	if oa.verifier != nil {
+		return oa.verifier, nil
+	}
+	// The key set fetches the keys with this context for as long as the API
+	// server runs.
+	ctx := oidc.ClientContext(context.Background(), oa.client)
+	config := &oidc.Config{
+		// The audiences are verified after, a token needs to have one of
+		// them only.
+		SkipClientIDCheck:    true,
+		SupportedSigningAlgs: oidcSigningAlgorithms,
+		Now:                  func() time.Time { return oa.now() },
+	}
+	if oa.jwksURL != "" {
+		oa.verifier = oidc.NewVerifier(oa.issuer, oidc.NewRemoteKeySet(ctx, oa.jwksURL), config)
+		return oa.verifier, nil
+	}
+	provider, err := oidc.NewProvider(ctx, oa.issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the OIDC discovery document")
+	}
+	oa.verifier = provider.Verifier(config)
+	return oa.verifier, nil
+}
+
+func (oa *OIDCAuthenticator) hasAudience(audiences []string) bool {
+	for _, audience := range audiences {
+		for _, expected := range oa.audiences {
+			if audience == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
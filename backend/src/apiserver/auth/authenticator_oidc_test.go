@@ -0,0 +1,429 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const testAudience = "kfp-api"
+
+// fakeIssuer is an OIDC issuer that serves its discovery document and key set.
+type fakeIssuer struct {
+	server *httptest.Server
+
+	mutex sync.Mutex
+	// discoveredIssuer is the issuer of the discovery document, the URL of
+	// the server if empty.
+	discoveredIssuer string
+	keys             []map[string]string
+	requests         int
+	down             bool
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	issuer := &fakeIssuer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer.mutex.Lock()
+		defer issuer.mutex.Unlock()
+		if issuer.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		discoveredIssuer := issuer.discoveredIssuer
+		if discoveredIssuer == "" {
+			discoveredIssuer = issuer.server.URL
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   discoveredIssuer,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.mutex.Lock()
+		defer issuer.mutex.Unlock()
+		issuer.requests++
+		if issuer.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": issuer.keys})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *fakeIssuer) setKeys(keys ...map[string]string) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.keys = keys
+}
+
+func (i *fakeIssuer) setDown(down bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.down = down
+}
+
+func (i *fakeIssuer) keyRequests() int {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.requests
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA", "kid": kid, "use": "sig", "alg": "RS256",
+		"n": encodeBigInt(key.N), "e": encodeBigInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kty": "EC", "kid": kid, "crv": "P-256",
+		"x": encodeBigInt(key.X), "y": encodeBigInt(key.Y),
+	}
+}
+
+func encodeSegment(t *testing.T, value interface{}) string {
+	bytes, err := json.Marshal(value)
+	require.Nil(t, err)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+// signToken signs a token with a RSA key for RS256, or an EC key for ES256.
+func signToken(t *testing.T, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	alg := "RS256"
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+	signingInput := encodeSegment(t, map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.Nil(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.Nil(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func tokenContext(token string) context.Context {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + token})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func newTestOIDCAuthenticator(issuer *fakeIssuer, userClaim string, now time.Time) *OIDCAuthenticator {
+	authenticator := NewOIDCAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		issuer.server.URL,
+		"",
+		[]string{"other", testAudience},
+		userClaim,
+		common.DefaultOIDCGroupsClaim,
+		issuer.server.Client(),
+	)
+	authenticator.now = func() time.Time { return now }
+	return authenticator
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("rsa", rsaKey), ecJWK("ec", ecKey))
+	now := time.Unix(1600000000, 0)
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":   issuer.server.URL,
+			"aud":   []string{testAudience},
+			"sub":   "1234",
+			"email": "user@example.com",
+			"exp":   now.Add(time.Hour).Unix(),
+			"nbf":   now.Add(-time.Hour).Unix(),
+		}
+	}
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+	tests := []struct {
+		name      string
+		token     string
+		userClaim string
+		identity  string
+		errorMsg  string
+	}{
+		{name: "RS256", token: signToken(t, "rsa", rsaKey, validClaims()), identity: "user@example.com"},
+		{name: "ES256", token: signToken(t, "ec", ecKey, validClaims()), identity: "user@example.com"},
+		{name: "sub claim", token: signToken(t, "rsa", rsaKey, validClaims()), userClaim: "sub", identity: "1234"},
+		{name: "single audience", token: signToken(t, "rsa", rsaKey, withClaim("aud", testAudience)), identity: "user@example.com"},
+		{name: "expired", token: signToken(t, "rsa", rsaKey, withClaim("exp", now.Add(-time.Hour).Unix())), errorMsg: "token is expired"},
+		{name: "no expiry", token: signToken(t, "rsa", rsaKey, withClaim("exp", nil)), errorMsg: "token is expired"},
+		{name: "not valid yet", token: signToken(t, "rsa", rsaKey, withClaim("nbf", now.Add(time.Hour).Unix())), errorMsg: "before the nbf (not before) time"},
+		{name: "wrong issuer", token: signToken(t, "rsa", rsaKey, withClaim("iss", "https://other")), errorMsg: `expected "` + issuer.server.URL + `" got "https://other"`},
+		{name: "wrong audience", token: signToken(t, "rsa", rsaKey, withClaim("aud", "kfp-ui")), errorMsg: "none of the audiences"},
+		{name: "wrong key", token: signToken(t, "rsa", otherKey, validClaims()), errorMsg: "failed to verify id token signature"},
+		{name: "unknown key", token: signToken(t, "unknown", rsaKey, validClaims()), errorMsg: "failed to verify id token signature"},
+		{name: "key type mismatch", token: signToken(t, "ec", rsaKey, validClaims()), errorMsg: "failed to verify id token signature"},
+		{name: "algorithm of another key type", token: signToken(t, "rsa", ecKey, validClaims()), errorMsg: "failed to verify id token signature"},
+		{name: "no claim", token: signToken(t, "rsa", rsaKey, withClaim("email", nil)), errorMsg: "the OIDC token has no string claim email"},
+		{name: "list claim", token: signToken(t, "rsa", rsaKey, validClaims()), userClaim: "aud", errorMsg: "the OIDC token has no string claim aud"},
+		{name: "malformed", token: "not-a-token", errorMsg: "malformed jwt"},
+		{
+			name: "alg none",
+			token: encodeSegment(t, map[string]string{"alg": "none", "kid": "rsa"}) + "." +
+				encodeSegment(t, validClaims()) + ".",
+			errorMsg: `unsupported algorithm, expected ["RS256" "RS384" "RS512" "ES256" "ES384" "ES512" "PS256" "PS384" "PS512"] got "none"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userClaim := tt.userClaim
+			if userClaim == "" {
+				userClaim = common.DefaultOIDCUserClaim
+			}
+			authenticator := newTestOIDCAuthenticator(issuer, userClaim, now)
+			identity, err := authenticator.GetUserIdentity(tokenContext(tt.token))
+			if tt.errorMsg != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				assert.Equal(t, codes.Unauthenticated, err.(*util.UserError).ExternalStatusCode())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.identity, identity)
+		})
+	}
+}
+
+func TestOIDCAuthenticator_GetUserInfo(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("rsa", key))
+	now := time.Unix(1600000000, 0)
+	authenticator := newTestOIDCAuthenticator(issuer, common.DefaultOIDCUserClaim, now)
+	claims := map[string]interface{}{
+		"iss":   issuer.server.URL,
+		"aud":   testAudience,
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+
+	userInfo, err := authenticator.GetUserInfo(tokenContext(signToken(t, "rsa", key, claims)))
+	require.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "user@example.com"}, userInfo)
+
+	claims["groups"] = []interface{}{"admins", 1, "users"}
+	userInfo, err = authenticator.GetUserInfo(tokenContext(signToken(t, "rsa", key, claims)))
+	require.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "user@example.com", Groups: []string{"admins", "users"}}, userInfo)
+
+	claims["groups"] = "admins"
+	userInfo, err = authenticator.GetUserInfo(tokenContext(signToken(t, "rsa", key, claims)))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"admins"}, userInfo.Groups)
+}
+
+func TestOIDCAuthenticator_NoToken(t *testing.T) {
+	authenticator := newTestOIDCAuthenticator(newFakeIssuer(t), common.DefaultOIDCUserClaim, time.Now())
+	_, err := authenticator.GetUserIdentity(context.Background())
+	assert.Equal(t, IdentityHeaderMissingError, err)
+}
+
+func TestOIDCAuthenticator_KeyRotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("old", oldKey))
+	now := time.Unix(1600000000, 0)
+	authenticator := newTestOIDCAuthenticator(issuer, common.DefaultOIDCUserClaim, now)
+	claims := map[string]interface{}{
+		"iss":   issuer.server.URL,
+		"aud":   testAudience,
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+
+	_, err = authenticator.GetUserIdentity(tokenContext(signToken(t, "old", oldKey, claims)))
+	require.Nil(t, err)
+	_, err = authenticator.GetUserIdentity(tokenContext(signToken(t, "old", oldKey, claims)))
+	require.Nil(t, err)
+	assert.Equal(t, 1, issuer.keyRequests(), "the keys are cached")
+
+	// The issuer rotates its keys, the key set is fetched again for the new
+	// key.
+	issuer.setKeys(rsaJWK("new", newKey))
+	_, err = authenticator.GetUserIdentity(tokenContext(signToken(t, "new", newKey, claims)))
+	require.Nil(t, err)
+	assert.Equal(t, 2, issuer.keyRequests())
+}
+
+func TestOIDCAuthenticator_Discovery(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("rsa", key))
+	now := time.Unix(1600000000, 0)
+	authenticator := newTestOIDCAuthenticator(issuer, common.DefaultOIDCUserClaim, now)
+	token := signToken(t, "rsa", key, map[string]interface{}{
+		"iss":   issuer.server.URL,
+		"aud":   testAudience,
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	// The discovery is tried again after failures.
+	issuer.setDown(true)
+	_, err = authenticator.GetUserIdentity(tokenContext(token))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to get the OIDC discovery document")
+	issuer.setDown(false)
+	identity, err := authenticator.GetUserIdentity(tokenContext(token))
+	require.Nil(t, err)
+	assert.Equal(t, "user@example.com", identity)
+}
+
+func TestOIDCAuthenticator_DiscoveredIssuerMismatch(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("rsa", key))
+	// The discovery document is the one of another issuer.
+	issuer.discoveredIssuer = "https://other"
+	now := time.Unix(1600000000, 0)
+	authenticator := newTestOIDCAuthenticator(issuer, common.DefaultOIDCUserClaim, now)
+	token := signToken(t, "rsa", key, map[string]interface{}{
+		"iss":   issuer.server.URL,
+		"aud":   testAudience,
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	_, err = authenticator.GetUserIdentity(tokenContext(token))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "issuer did not match")
+	assert.Equal(t, 0, issuer.keyRequests())
+}
+
+func TestOIDCAuthenticator_JWKSURL(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	issuer := newFakeIssuer(t)
+	issuer.setKeys(rsaJWK("rsa", key))
+	// The key set isn't discovered, the discovery document doesn't matter.
+	issuer.discoveredIssuer = "https://other"
+	now := time.Unix(1600000000, 0)
+	authenticator := newTestOIDCAuthenticator(issuer, common.DefaultOIDCUserClaim, now)
+	authenticator.jwksURL = issuer.server.URL + "/keys"
+	token := signToken(t, "rsa", key, map[string]interface{}{
+		"iss":   issuer.server.URL,
+		"aud":   testAudience,
+		"email": "user@example.com",
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+
+	identity, err := authenticator.GetUserIdentity(tokenContext(token))
+	require.Nil(t, err)
+	assert.Equal(t, "user@example.com", identity)
+}
+
+func TestGetAuthenticators_OIDC(t *testing.T) {
+	viper.Set(common.OIDCIssuer, "https://issuer.example.com")
+	viper.Set(common.OIDCAudiences, "kfp-api, kfp-cli")
+	defer func() {
+		viper.Set(common.KubeflowUserIDHeaderEnabled, nil)
+		viper.Set(common.OIDCIssuer, nil)
+		viper.Set(common.OIDCAudiences, nil)
+	}()
+
+	authenticators := GetAuthenticators(client.NewFakeTokenReviewClient())
+	require.Len(t, authenticators, 2)
+	oidcAuthenticator, ok := authenticators[0].(*OIDCAuthenticator)
+	require.True(t, ok)
+	assert.Equal(t, []string{"kfp-api", "kfp-cli"}, oidcAuthenticator.audiences)
+	assert.Equal(t, common.DefaultOIDCUserClaim, oidcAuthenticator.userClaim)
+	_, ok = authenticators[1].(*TokenReviewAuthenticator)
+	assert.True(t, ok)
+}
+
+func TestGetAuthenticators_OIDCWithHeader(t *testing.T) {
+	viper.Set(common.KubeflowUserIDHeaderEnabled, "true")
+	viper.Set(common.OIDCIssuer, "https://issuer.example.com")
+	viper.Set(common.OIDCAudiences, "kfp-api")
+	defer func() {
+		viper.Set(common.KubeflowUserIDHeaderEnabled, nil)
+		viper.Set(common.OIDCIssuer, nil)
+		viper.Set(common.OIDCAudiences, nil)
+	}()
+
+	// The user identity header is trusted with OIDC only if enabled explicitly.
+	authenticators := GetAuthenticators(client.NewFakeTokenReviewClient())
+	require.Len(t, authenticators, 3)
+	_, ok := authenticators[0].(*HTTPHeaderAuthenticator)
+	assert.True(t, ok)
+	_, ok = authenticators[1].(*OIDCAuthenticator)
+	assert.True(t, ok)
+}
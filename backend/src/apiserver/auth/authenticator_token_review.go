@@ -45,16 +45,24 @@ func NewTokenReviewAuthenticator(tokenHeader, tokenPrefix string, audiences []st
 }
 
 func (tra *TokenReviewAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
-	token, err := singlePrefixedHeaderFromMetadata(ctx, tra.tokenHeader, tra.tokenPrefix)
+	userInfo, err := tra.GetUserInfo(ctx)
 	if err != nil {
 		return "", err
 	}
+	return userInfo.Name, nil
+}
+
+func (tra *TokenReviewAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, tra.tokenHeader, tra.tokenPrefix)
+	if err != nil {
+		return nil, err
+	}
 
 	userInfo, err := tra.doTokenReview(ctx, token)
 	if err != nil {
-		return "", util.Wrap(err, "Authentication failure")
+		return nil, util.Wrap(err, "Authentication failure")
 	}
-	return userInfo.Username, err
+	return &UserInfo{Name: userInfo.Username, Groups: userInfo.Groups}, nil
 }
 
 // ensureAudience makes sure all audience of the authenticator is found in the provided audience list
@@ -41,6 +41,22 @@ func TestTokenReviewAuthenticatorAuthenticated(t *testing.T) {
 	assert.Equal(t, "test", userIdentity)
 }
 
+func TestTokenReviewAuthenticatorGetUserInfo(t *testing.T) {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + "token"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	authenticator := NewTokenReviewAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		[]string{common.GetTokenReviewAudience()},
+		client.NewFakeTokenReviewClient(),
+	)
+
+	userInfo, err := GetUserInfo(ctx, authenticator)
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "test", Groups: []string{"test-group"}}, userInfo)
+}
+
 func TestTokenReviewAuthenticatorAuthenticatedWrongAudience(t *testing.T) {
 	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + "token"})
 	ctx := metadata.NewIncomingContext(context.Background(), md)
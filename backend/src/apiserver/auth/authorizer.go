@@ -0,0 +1,154 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// The maximum number of cached authorization decisions.
+const authorizationCacheMaxEntries = 10000
+
+// Authorizer decides whether a user can perform a verb on a resource.
+type Authorizer interface {
+	// Authorize returns nil if the user is authorized, a PermissionDenied
+	// util.UserError with the reason of the denial if the user is not, and
+	// another error if there is no decision.
+	Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error
+}
+
+// GetAuthorizer returns the authorizer of the API server. Requests are
+// authorized by the rules of the policy file if there is one, and by
+// Kubernetes SubjectAccessReviews otherwise. The decisions are cached if the
+// cache TTL is positive.
+func GetAuthorizer(subjectAccessReviewClient client.SubjectAccessReviewInterface) Authorizer {
+	var authorizer Authorizer
+	if policyFile := common.GetAuthorizationPolicyFile(); policyFile != "" {
+		policyAuthorizer, err := NewPolicyAuthorizerFromFile(policyFile)
+		if err != nil {
+			glog.Fatalf("Failed to load the authorization policy file %s. Error: %v", policyFile, err)
+		}
+		authorizer = policyAuthorizer
+	} else {
+		authorizer = NewSubjectAccessReviewAuthorizer(subjectAccessReviewClient)
+	}
+	if ttl := common.GetAuthorizationCacheTTL(); ttl > 0 {
+		authorizer = NewCachingAuthorizer(authorizer, ttl)
+	}
+	return authorizer
+}
+
+type resourceOwnerKey struct{}
+
+// WithResourceOwner returns a context with the owner of the resource of a
+// request, the user that created it, which the policy rules can refer to.
+func WithResourceOwner(ctx context.Context, owner string) context.Context {
+	return context.WithValue(ctx, resourceOwnerKey{}, owner)
+}
+
+// resourceOwnerFromContext returns the owner of the resource of a request, or
+// "" if it is unknown.
+func resourceOwnerFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	owner, _ := ctx.Value(resourceOwnerKey{}).(string)
+	return owner
+}
+
+// newPermissionDeniedError returns the error of a denied request.
+func newPermissionDeniedError(user *UserInfo, reason string, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	return util.NewPermissionDeniedError(
+		errors.New("Unauthorized access"),
+		"User '%s' is not authorized with reason: %s (request: %+v)",
+		user.Name,
+		reason,
+		resourceAttributes,
+	)
+}
+
+// CachingAuthorizer caches the decisions of an authorizer for a TTL. Errors
+// that are not decisions are not cached.
+type CachingAuthorizer struct {
+	authorizer Authorizer
+	ttl        time.Duration
+	now        func() time.Time
+
+	mutex     sync.Mutex
+	decisions map[string]cachedDecision
+}
+
+type cachedDecision struct {
+	// err is nil if the request is authorized.
+	err       error
+	expiresAt time.Time
+}
+
+func NewCachingAuthorizer(authorizer Authorizer, ttl time.Duration) *CachingAuthorizer {
+	return &CachingAuthorizer{
+		authorizer: authorizer,
+		ttl:        ttl,
+		now:        time.Now,
+		decisions:  make(map[string]cachedDecision),
+	}
+}
+
+func (ca *CachingAuthorizer) Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	key := decisionKey(ctx, user, resourceAttributes)
+	ca.mutex.Lock()
+	decision, ok := ca.decisions[key]
+	ca.mutex.Unlock()
+	if ok && ca.now().Before(decision.expiresAt) {
+		return decision.err
+	}
+
+	err := ca.authorizer.Authorize(ctx, user, resourceAttributes)
+	if err != nil && !util.IsUserErrorCodeMatch(err, codes.PermissionDenied) {
+		return err
+	}
+	ca.mutex.Lock()
+	defer ca.mutex.Unlock()
+	now := ca.now()
+	if len(ca.decisions) >= authorizationCacheMaxEntries {
+		for k, d := range ca.decisions {
+			if !now.Before(d.expiresAt) {
+				delete(ca.decisions, k)
+			}
+		}
+		if len(ca.decisions) >= authorizationCacheMaxEntries {
+			ca.decisions = make(map[string]cachedDecision)
+		}
+	}
+	ca.decisions[key] = cachedDecision{err: err, expiresAt: now.Add(ca.ttl)}
+	return err
+}
+
+func decisionKey(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) string {
+	groups := append([]string{}, user.Groups...)
+	sort.Strings(groups)
+	return fmt.Sprintf("%q %q %q %+v", user.Name, groups, resourceOwnerFromContext(ctx), *resourceAttributes)
+}
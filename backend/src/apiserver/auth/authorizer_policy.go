@@ -0,0 +1,169 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const (
+	PolicyEffectAllow = "Allow"
+	PolicyEffectDeny  = "Deny"
+)
+
+// Policy is an authorization policy file, e.g.
+//
+//	rules:
+//	- name: admins
+//	  effect: Allow
+//	  condition: '"kfp-admins" in groups'
+//	- name: delete-pipelines
+//	  effect: Deny
+//	  condition: 'request.resource == "pipelines" && request.verb == "delete" && request.owner != user'
+//	  reason: only the owners of pipelines can delete them and their versions
+//	- name: profile
+//	  effect: Allow
+//	  condition: 'request.namespace == user'
+//
+// The conditions are CEL expressions over the variables user, the name of the
+// user, groups, the list of the groups of the user, and request, a map of the
+// namespace, verb, resource, subresource, name and owner of the request.
+// resource is one of the RbacResourceType* constants and verb one of the
+// RbacResourceVerb* constants. owner is the user that created the pipeline of
+// the requests on pipelines and pipeline versions, and empty for the other
+// requests or if the pipeline has no recorded owner. namespace is a
+// reserved word of CEL, hence the map. The first rule whose condition is true
+// decides, requests that no rule matches are denied.
+type Policy struct {
+	Rules []PolicyRule `json:"rules"`
+}
+
+type PolicyRule struct {
+	Name string `json:"name"`
+	// Allow or Deny.
+	Effect    string `json:"effect"`
+	Condition string `json:"condition"`
+	// Reason of the denial of Deny rules, optional.
+	Reason string `json:"reason,omitempty"`
+}
+
+// PolicyAuthorizer authorizes requests with the rules of a policy, without
+// requests to Kubernetes.
+type PolicyAuthorizer struct {
+	rules []compiledPolicyRule
+}
+
+type compiledPolicyRule struct {
+	PolicyRule
+	program cel.Program
+}
+
+// NewPolicyAuthorizerFromFile creates a PolicyAuthorizer from a YAML or JSON
+// policy file.
+func NewPolicyAuthorizerFromFile(path string) (*PolicyAuthorizer, error) {
+	policyBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the policy file")
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict(policyBytes, &policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid policy file")
+	}
+	return NewPolicyAuthorizer(&policy)
+}
+
+// NewPolicyAuthorizer compiles the conditions of the rules of a policy.
+func NewPolicyAuthorizer(policy *Policy) (*PolicyAuthorizer, error) {
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewVar("user", decls.String),
+		decls.NewVar("groups", decls.NewListType(decls.String)),
+		decls.NewVar("request", decls.NewMapType(decls.String, decls.String)),
+	))
+	if err != nil {
+		return nil, err
+	}
+	if len(policy.Rules) == 0 {
+		return nil, errors.New("the policy has no rules")
+	}
+	rules := make([]compiledPolicyRule, 0, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("#%d", i)
+		}
+		if rule.Effect != PolicyEffectAllow && rule.Effect != PolicyEffectDeny {
+			return nil, errors.Errorf("rule %s: effect must be %s or %s, got %q", rule.Name, PolicyEffectAllow, PolicyEffectDeny, rule.Effect)
+		}
+		ast, issues := env.Compile(rule.Condition)
+		if issues != nil && issues.Err() != nil {
+			return nil, errors.Wrapf(issues.Err(), "rule %s: invalid condition", rule.Name)
+		}
+		if !proto.Equal(ast.ResultType(), decls.Bool) {
+			return nil, errors.Errorf("rule %s: the condition must be a bool, got %v", rule.Name, ast.ResultType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %s: invalid condition", rule.Name)
+		}
+		rules = append(rules, compiledPolicyRule{PolicyRule: rule, program: program})
+	}
+	return &PolicyAuthorizer{rules: rules}, nil
+}
+
+func (pa *PolicyAuthorizer) Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	groups := user.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	variables := map[string]interface{}{
+		"user":   user.Name,
+		"groups": groups,
+		"request": map[string]string{
+			"namespace":   resourceAttributes.Namespace,
+			"verb":        resourceAttributes.Verb,
+			"resource":    resourceAttributes.Resource,
+			"subresource": resourceAttributes.Subresource,
+			"name":        resourceAttributes.Name,
+			"owner":       resourceOwnerFromContext(ctx),
+		},
+	}
+	for _, rule := range pa.rules {
+		result, _, err := rule.program.Eval(variables)
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to evaluate the authorization policy rule %s", rule.Name)
+		}
+		if matched, ok := result.Value().(bool); !ok || !matched {
+			continue
+		}
+		if rule.Effect == PolicyEffectAllow {
+			return nil
+		}
+		reason := rule.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("denied by the authorization policy rule %s", rule.Name)
+		}
+		return newPermissionDeniedError(user, reason, resourceAttributes)
+	}
+	return newPermissionDeniedError(user, "no authorization policy rule allows the request", resourceAttributes)
+}
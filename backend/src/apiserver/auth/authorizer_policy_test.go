@@ -0,0 +1,149 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const testPolicy = `
+rules:
+- name: admins
+  effect: Allow
+  condition: '"admins" in groups'
+- name: delete-pipelines
+  effect: Deny
+  condition: request.resource == "pipelines" && request.verb == "delete" && request.owner != user
+  reason: only the owners of pipelines can delete them
+- name: archived-runs
+  effect: Deny
+  condition: request.resource == "runs" && request.subresource == "archived"
+- name: profile
+  effect: Allow
+  condition: request.namespace == user
+`
+
+func TestPolicyAuthorizer(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	require.Nil(t, ioutil.WriteFile(policyFile, []byte(testPolicy), 0644))
+	authorizer, err := NewPolicyAuthorizerFromFile(policyFile)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		user       *UserInfo
+		owner      string
+		attributes *authorizationv1.ResourceAttributes
+		reason     string
+	}{
+		{
+			name:       "admin",
+			user:       &UserInfo{Name: "alice", Groups: []string{"users", "admins"}},
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "bob", Verb: common.RbacResourceVerbDelete, Resource: common.RbacResourceTypePipelines},
+		},
+		{
+			name:       "own namespace",
+			user:       &UserInfo{Name: "bob"},
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "bob", Verb: common.RbacResourceVerbCreate, Resource: common.RbacResourceTypeRuns},
+		},
+		{
+			name:       "deny rule",
+			user:       &UserInfo{Name: "bob"},
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "bob", Verb: common.RbacResourceVerbDelete, Resource: common.RbacResourceTypePipelines},
+			reason:     "only the owners of pipelines can delete them",
+		},
+		{
+			name:       "owner",
+			user:       &UserInfo{Name: "bob"},
+			owner:      "bob",
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "bob", Verb: common.RbacResourceVerbDelete, Resource: common.RbacResourceTypePipelines},
+		},
+		{
+			name:       "deny rule without reason",
+			user:       &UserInfo{Name: "bob"},
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "bob", Verb: common.RbacResourceVerbGet, Resource: common.RbacResourceTypeRuns, Subresource: "archived"},
+			reason:     "denied by the authorization policy rule archived-runs",
+		},
+		{
+			name:       "no rule",
+			user:       &UserInfo{Name: "bob"},
+			attributes: &authorizationv1.ResourceAttributes{Namespace: "alice", Verb: common.RbacResourceVerbGet, Resource: common.RbacResourceTypeRuns},
+			reason:     "no authorization policy rule allows the request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizer.Authorize(WithResourceOwner(context.Background(), tt.owner), tt.user, tt.attributes)
+			if tt.reason == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+			assert.Contains(t, err.Error(), "User '"+tt.user.Name+"' is not authorized with reason: "+tt.reason)
+		})
+	}
+}
+
+func TestNewPolicyAuthorizer_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *Policy
+		errorMsg string
+	}{
+		{
+			name:     "no rules",
+			policy:   &Policy{},
+			errorMsg: "the policy has no rules",
+		},
+		{
+			name:     "effect",
+			policy:   &Policy{Rules: []PolicyRule{{Name: "r", Effect: "allow", Condition: "true"}}},
+			errorMsg: `rule r: effect must be Allow or Deny, got "allow"`,
+		},
+		{
+			name:     "syntax",
+			policy:   &Policy{Rules: []PolicyRule{{Effect: PolicyEffectAllow, Condition: "user =="}}},
+			errorMsg: "rule #0: invalid condition",
+		},
+		{
+			name:     "unknown variable",
+			policy:   &Policy{Rules: []PolicyRule{{Effect: PolicyEffectAllow, Condition: `owner == user`}}},
+			errorMsg: "undeclared reference to 'owner'",
+		},
+		{
+			name:     "not a bool",
+			policy:   &Policy{Rules: []PolicyRule{{Name: "r", Effect: PolicyEffectAllow, Condition: "user"}}},
+			errorMsg: "rule r: the condition must be a bool",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPolicyAuthorizer(tt.policy)
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
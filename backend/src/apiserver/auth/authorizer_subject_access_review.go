@@ -0,0 +1,60 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubjectAccessReviewAuthorizer authorizes requests with the Kubernetes RBAC
+// rules of the users, by creating SubjectAccessReviews.
+type SubjectAccessReviewAuthorizer struct {
+	client client.SubjectAccessReviewInterface
+}
+
+func NewSubjectAccessReviewAuthorizer(subjectAccessReviewClient client.SubjectAccessReviewInterface) *SubjectAccessReviewAuthorizer {
+	return &SubjectAccessReviewAuthorizer{client: subjectAccessReviewClient}
+}
+
+func (sara *SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, user *UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	result, err := sara.client.Create(
+		ctx,
+		&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: resourceAttributes,
+				User:               user.Name,
+				Groups:             user.Groups,
+			},
+		},
+		v1.CreateOptions{},
+	)
+	if err != nil {
+		return util.NewInternalServerError(
+			err,
+			"Failed to create SubjectAccessReview for user '%s' (request: %+v)",
+			user.Name,
+			resourceAttributes,
+		)
+	}
+	if !result.Status.Allowed {
+		return newPermissionDeniedError(user, result.Status.Reason, resourceAttributes)
+	}
+	return nil
+}
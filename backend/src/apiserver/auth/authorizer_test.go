@@ -0,0 +1,160 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// recordingSubjectAccessReviewClient records the reviews, and allows the
+// users in allowed.
+type recordingSubjectAccessReviewClient struct {
+	allowed map[string]bool
+	err     error
+	reviews []*authorizationv1.SubjectAccessReview
+}
+
+func (c *recordingSubjectAccessReviewClient) Create(ctx context.Context, sar *authorizationv1.SubjectAccessReview, opts v1.CreateOptions) (*authorizationv1.SubjectAccessReview, error) {
+	c.reviews = append(c.reviews, sar)
+	if c.err != nil {
+		return nil, c.err
+	}
+	allowed := c.allowed[sar.Spec.User]
+	reason := ""
+	if !allowed {
+		reason = "this is not allowed"
+	}
+	return &authorizationv1.SubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{
+		Allowed: allowed,
+		Reason:  reason,
+	}}, nil
+}
+
+func testResourceAttributes(verb string) *authorizationv1.ResourceAttributes {
+	return &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      verb,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	}
+}
+
+func TestSubjectAccessReviewAuthorizer(t *testing.T) {
+	sarClient := &recordingSubjectAccessReviewClient{allowed: map[string]bool{"alice": true}}
+	authorizer := NewSubjectAccessReviewAuthorizer(sarClient)
+
+	err := authorizer.Authorize(context.Background(), &UserInfo{Name: "alice", Groups: []string{"admins"}}, testResourceAttributes(common.RbacResourceVerbGet))
+	assert.Nil(t, err)
+	require.Len(t, sarClient.reviews, 1)
+	assert.Equal(t, "alice", sarClient.reviews[0].Spec.User)
+	assert.Equal(t, []string{"admins"}, sarClient.reviews[0].Spec.Groups)
+	assert.Equal(t, testResourceAttributes(common.RbacResourceVerbGet), sarClient.reviews[0].Spec.ResourceAttributes)
+
+	err = authorizer.Authorize(context.Background(), &UserInfo{Name: "bob"}, testResourceAttributes(common.RbacResourceVerbGet))
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	assert.Contains(t, err.Error(), "User 'bob' is not authorized with reason: this is not allowed")
+
+	sarClient.err = errors.New("connection refused")
+	err = authorizer.Authorize(context.Background(), &UserInfo{Name: "alice"}, testResourceAttributes(common.RbacResourceVerbGet))
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.Internal))
+}
+
+func TestCachingAuthorizer(t *testing.T) {
+	sarClient := &recordingSubjectAccessReviewClient{allowed: map[string]bool{"alice": true}}
+	authorizer := NewCachingAuthorizer(NewSubjectAccessReviewAuthorizer(sarClient), time.Minute)
+	now := time.Unix(1600000000, 0)
+	authorizer.now = func() time.Time { return now }
+	ctx := context.Background()
+	alice := &UserInfo{Name: "alice", Groups: []string{"b", "a"}}
+
+	assert.Nil(t, authorizer.Authorize(ctx, alice, testResourceAttributes(common.RbacResourceVerbGet)))
+	assert.Nil(t, authorizer.Authorize(ctx, &UserInfo{Name: "alice", Groups: []string{"a", "b"}}, testResourceAttributes(common.RbacResourceVerbGet)))
+	assert.Len(t, sarClient.reviews, 1, "the decision is cached")
+
+	// Decisions depend on the groups, on the attributes and on the owner.
+	assert.Nil(t, authorizer.Authorize(ctx, &UserInfo{Name: "alice"}, testResourceAttributes(common.RbacResourceVerbGet)))
+	assert.Nil(t, authorizer.Authorize(ctx, alice, testResourceAttributes(common.RbacResourceVerbDelete)))
+	assert.Nil(t, authorizer.Authorize(WithResourceOwner(ctx, "alice"), alice, testResourceAttributes(common.RbacResourceVerbDelete)))
+	assert.Len(t, sarClient.reviews, 4)
+
+	// Denials are cached.
+	bob := &UserInfo{Name: "bob"}
+	err := authorizer.Authorize(ctx, bob, testResourceAttributes(common.RbacResourceVerbGet))
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	err = authorizer.Authorize(ctx, bob, testResourceAttributes(common.RbacResourceVerbGet))
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	assert.Len(t, sarClient.reviews, 5)
+
+	// Decisions expire after the TTL.
+	now = now.Add(time.Minute)
+	sarClient.allowed["bob"] = true
+	assert.Nil(t, authorizer.Authorize(ctx, bob, testResourceAttributes(common.RbacResourceVerbGet)))
+	assert.Len(t, sarClient.reviews, 6)
+
+	// Errors that are not decisions are not cached.
+	sarClient.err = errors.New("connection refused")
+	carol := &UserInfo{Name: "carol"}
+	assert.NotNil(t, authorizer.Authorize(ctx, carol, testResourceAttributes(common.RbacResourceVerbGet)))
+	sarClient.err = nil
+	sarClient.allowed["carol"] = true
+	assert.Nil(t, authorizer.Authorize(ctx, carol, testResourceAttributes(common.RbacResourceVerbGet)))
+	assert.Len(t, sarClient.reviews, 8)
+}
+
+func TestGetAuthorizer(t *testing.T) {
+	defer func() {
+		viper.Set(common.AuthorizationPolicyFile, nil)
+		viper.Set(common.AuthorizationCacheTTL, nil)
+	}()
+
+	_, ok := GetAuthorizer(client.NewFakeSubjectAccessReviewClient()).(*SubjectAccessReviewAuthorizer)
+	assert.True(t, ok)
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	require.Nil(t, ioutil.WriteFile(policyFile, []byte(`
+rules:
+- name: all
+  effect: Allow
+  condition: "true"
+`), 0644))
+	viper.Set(common.AuthorizationPolicyFile, policyFile)
+	_, ok = GetAuthorizer(client.NewFakeSubjectAccessReviewClient()).(*PolicyAuthorizer)
+	assert.True(t, ok)
+
+	viper.Set(common.AuthorizationCacheTTL, "10s")
+	cachingAuthorizer, ok := GetAuthorizer(client.NewFakeSubjectAccessReviewClient()).(*CachingAuthorizer)
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Second, cachingAuthorizer.ttl)
+	_, ok = cachingAuthorizer.authorizer.(*PolicyAuthorizer)
+	assert.True(t, ok)
+}
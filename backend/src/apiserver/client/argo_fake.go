@@ -61,6 +61,15 @@ func (c *FakeArgoClient) IsTerminated(name string) (bool, error) {
 	return *activeDeadlineSeconds == 0, nil
 }
 
+func (c *FakeArgoClient) IsSuspended(name string) (bool, error) {
+	workflow, ok := c.workflowClientFake.workflows[name]
+	if !ok {
+		return false, errors.New("No workflow found with name: " + name)
+	}
+
+	return workflow.Spec.Suspend != nil && *workflow.Spec.Suspend, nil
+}
+
 type FakeArgoClientWithBadWorkflow struct {
 	workflowClientFake *FakeBadWorkflowClient
 }
@@ -16,6 +16,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/golang/glog"
@@ -55,6 +56,20 @@ func (c *FakeScheduledWorkflowClient) Delete(ctx context.Context, name string, o
 }
 
 func (c *FakeScheduledWorkflowClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.ScheduledWorkflow, err error) {
+	var patch struct {
+		Spec struct {
+			Backfill *v1beta1.Backfill `json:"backfill"`
+		} `json:"spec"`
+	}
+	// Simulate backfilling a scheduled workflow
+	if pt == types.MergePatchType && json.Unmarshal(data, &patch) == nil && patch.Spec.Backfill != nil {
+		scheduledWorkflow, ok := c.scheduledWorkflows[name]
+		if !ok {
+			return nil, k8errors.NewNotFound(k8schema.ParseGroupResource("scheduledworkflows.kubeflow.org"), name)
+		}
+		scheduledWorkflow.Spec.Backfill = patch.Spec.Backfill
+		return scheduledWorkflow, nil
+	}
 	return nil, nil
 }
 
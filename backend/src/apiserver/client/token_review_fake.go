@@ -29,7 +29,7 @@ type FakeTokenReviewClient struct {
 func (FakeTokenReviewClient) Create(context.Context, *authv1.TokenReview, v1.CreateOptions) (*authv1.TokenReview, error) {
 	return &authv1.TokenReview{Status: authv1.TokenReviewStatus{
 		Authenticated: true,
-		User:          authv1.UserInfo{Username: "test"},
+		User:          authv1.UserInfo{Username: "test", Groups: []string{"test-group"}},
 		Audiences:     []string{common.GetTokenReviewAudience()},
 		Error:         "",
 	}}, nil
@@ -109,10 +109,10 @@ func (c *FakeWorkflowClient) Patch(ctx context.Context, name string, pt types.Pa
 
 	if _, ok := dat["spec"]; ok {
 		spec := dat["spec"].(map[string]interface{})
-		activeDeadlineSeconds := spec["activeDeadlineSeconds"].(float64)
+		activeDeadlineSeconds, hasActiveDeadlineSeconds := spec["activeDeadlineSeconds"].(float64)
 
 		// Simulate terminating a workflow
-		if pt == types.MergePatchType && activeDeadlineSeconds == 0 {
+		if pt == types.MergePatchType && hasActiveDeadlineSeconds && activeDeadlineSeconds == 0 {
 			workflow, ok := c.workflows[name]
 			if ok {
 				newActiveDeadlineSeconds := int64(0)
@@ -120,6 +120,18 @@ func (c *FakeWorkflowClient) Patch(ctx context.Context, name string, pt types.Pa
 				return workflow, nil
 			}
 		}
+
+		// Simulate suspending or resuming a workflow
+		if suspend, ok := spec["suspend"]; pt == types.MergePatchType && ok {
+			workflow := c.workflows[name]
+			if suspend == nil {
+				workflow.Spec.Suspend = nil
+			} else {
+				newSuspend := suspend.(bool)
+				workflow.Spec.Suspend = &newSuspend
+			}
+			return workflow, nil
+		}
 	}
 
 	if _, ok := dat["metadata"]; ok {
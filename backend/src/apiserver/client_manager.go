@@ -28,9 +28,11 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
-	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/migration"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/lib/pq"
 	"github.com/minio/minio-go"
 )
 
@@ -69,6 +71,9 @@ type ClientManager struct {
 	jobStore                  storage.JobStoreInterface
 	runStore                  storage.RunStoreInterface
 	taskStore                 storage.TaskStoreInterface
+	auditEventStore           storage.AuditEventStoreInterface
+	auditEventSink            storage.AuditEventSinkInterface
+	runEventStore             storage.RunEventStoreInterface
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
@@ -82,12 +87,25 @@ type ClientManager struct {
 	time                      util.TimeInterface
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []auth.Authenticator
+	authorizer                auth.Authorizer
 }
 
 func (c *ClientManager) TaskStore() storage.TaskStoreInterface {
 	return c.taskStore
 }
 
+func (c *ClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return c.auditEventStore
+}
+
+func (c *ClientManager) AuditEventSink() storage.AuditEventSinkInterface {
+	return c.auditEventSink
+}
+
+func (c *ClientManager) RunEventStore() storage.RunEventStoreInterface {
+	return c.runEventStore
+}
+
 func (c *ClientManager) ExperimentStore() storage.ExperimentStoreInterface {
 	return c.experimentStore
 }
@@ -156,6 +174,10 @@ func (c *ClientManager) Authenticators() []auth.Authenticator {
 	return c.authenticators
 }
 
+func (c *ClientManager) Authorizer() auth.Authorizer {
+	return c.authorizer
+}
+
 func (c *ClientManager) init() {
 	glog.Info("Initializing client manager")
 	db := initDBClient(common.GetDurationConfig(initConnectionTimeout))
@@ -172,6 +194,15 @@ func (c *ClientManager) init() {
 	c.pipelineStore = storage.NewPipelineStore(db, c.time, c.uuid)
 	c.jobStore = storage.NewJobStore(db, c.time)
 	c.taskStore = storage.NewTaskStore(db, c.time, c.uuid)
+	c.auditEventStore = storage.NewAuditEventStore(db, c.time, c.uuid)
+	if auditLogFile := common.GetAuditLogFile(); auditLogFile != "" {
+		sink, err := storage.NewJSONLinesAuditEventFileSink(auditLogFile)
+		if err != nil {
+			glog.Fatalf("Failed to create the audit log sink. Error: %v", err)
+		}
+		c.auditEventSink = sink
+	}
+	c.runEventStore = storage.NewRunEventStore(db)
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
@@ -200,6 +231,7 @@ func (c *ClientManager) init() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.authenticators = auth.GetAuthenticators(c.tokenReviewClient)
+		c.authorizer = auth.GetAuthorizer(c.subjectAccessReviewClient)
 	}
 	glog.Infof("Client manager initialized successfully")
 }
@@ -209,127 +241,57 @@ func (c *ClientManager) Close() {
 }
 
 func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
+	db, dialect, driverName := openDBClient(initConnectionTimeout, false /* dryRun */)
+
+	migrator := newMigratorOrFatal(db, driverName)
+	if _, err := migrator.Up(false /* dryRun */); err != nil {
+		glog.Fatalf("Failed to migrate the database. Error: %v", err)
+	}
+
+	return storage.NewDB(db.DB(), dialect)
+}
+
+// openDBClient connects to the database configured by DBConfig.DriverName, and
+// creates it if it doesn't exist. With dryRun set, it fails instead of creating
+// the database, so that a dry run doesn't write to the database server.
+func openDBClient(initConnectionTimeout time.Duration, dryRun bool) (*gorm.DB, storage.SQLDialect, string) {
 	driverName := common.GetStringConfig("DBConfig.DriverName")
-	var arg string
+	var db *gorm.DB
+	var dialect storage.SQLDialect
+	var err error
 
 	switch driverName {
 	case "mysql":
-		arg = initMysql(driverName, initConnectionTimeout)
+		arg := initMysql(driverName, initConnectionTimeout, dryRun)
+		// db is safe for concurrent use by multiple goroutines
+		// and maintains its own pool of idle connections.
+		db, err = gorm.Open(driverName, arg)
+		dialect = storage.NewMySQLDialect()
+	case postgres.DriverName:
+		arg := initPostgres(initConnectionTimeout, dryRun)
+		var sqlDB *sql.DB
+		sqlDB, err = sql.Open(driverName, arg)
+		util.TerminateIfError(err)
+		db, err = gorm.Open(postgres.GormDialectName, sqlDB)
+		dialect = storage.NewPostgreSQLDialect()
 	default:
 		glog.Fatalf("Driver %v is not supported", driverName)
 	}
-
-	// db is safe for concurrent use by multiple goroutines
-	// and maintains its own pool of idle connections.
-	db, err := gorm.Open(driverName, arg)
 	util.TerminateIfError(err)
+	return db, dialect, driverName
+}
 
-	// If pipeline_versions table is introduced into DB for the first time,
-	// it needs initialization or data backfill.
-	var tableNames []string
-	var initializePipelineVersions = true
-	db.Raw(`show tables`).Pluck("Tables_in_mlpipeline", &tableNames)
-	for _, tableName := range tableNames {
-		if tableName == "pipeline_versions" {
-			initializePipelineVersions = false
-			break
-		}
-	}
-
-	// Create table
-	response := db.AutoMigrate(
-		&model.Experiment{},
-		&model.Job{},
-		&model.Pipeline{},
-		&model.PipelineVersion{},
-		&model.ResourceReference{},
-		&model.RunDetail{},
-		&model.RunMetric{},
-		&model.Task{},
-		&model.DBStatus{},
-		&model.DefaultExperiment{})
-
-	if response.Error != nil {
-		glog.Fatalf("Failed to initialize the databases.")
-	}
-
-	response = db.Model(&model.Experiment{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on experiment name. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.Pipeline{}).RemoveIndex("Name")
-	if response.Error != nil {
-		glog.Fatalf("Failed to drop unique key on pipeline name. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.ResourceReference{}).ModifyColumn("Payload", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update the resource reference payload type. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_createatinsec on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunDetail{}).AddIndex("experimentuuid_conditions_finishedatinsec", "ExperimentUUID", "Conditions", "FinishedAtInSec")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index experimentuuid_conditions_finishedatinsec on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.Pipeline{}).AddUniqueIndex("name_namespace_index", "Name", "Namespace")
-	if response.Error != nil {
-		glog.Fatalf("Failed to create index name_namespace_index on run_details. Error: %s", response.Error)
-	}
-
-	response = db.Model(&model.RunMetric{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
-	}
-	response = db.Model(&model.PipelineVersion{}).
-		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for PipelineId in pipeline_versions table. Error: %s", response.Error)
-	}
-	response = db.Model(&model.Task{}).
-		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
-	if response.Error != nil {
-		glog.Fatalf("Failed to create a foreign key for RunUUID in task table. Error: %s", response.Error)
-	}
-
-	// Data backfill for pipeline_versions if this is the first time for
-	// pipeline_versions to enter mlpipeline DB.
-	if initializePipelineVersions {
-		initPipelineVersionsFromPipelines(db)
-	}
-	err = backfillExperimentIDToRunTable(db)
-	if err != nil {
-		glog.Fatalf("Failed to backfill experiment UUID in run_details table: %s", err)
-	}
-
-	response = db.Model(&model.Pipeline{}).ModifyColumn("Description", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update pipeline description type. Error: %s", response.Error)
-	}
-
-	// If the old unique index idx_pipeline_version_uuid_name on pipeline_versions exists, remove it.
-	rows, err := db.Raw(`show index from pipeline_versions where Key_name='idx_pipeline_version_uuid_name'`).Rows()
+func newMigratorOrFatal(db *gorm.DB, driverName string) *migration.Migrator {
+	migrator, err := migration.NewMigrator(db, driverName, migration.Migrations(), util.NewRealTime())
 	if err != nil {
-		glog.Fatalf("Failed to query pipeline_version table's indices. Error: %s", err)
+		glog.Fatalf("Failed to load the database migrations. Error: %v", err)
 	}
-	if rows.Next() {
-		db.Exec(`drop index idx_pipeline_version_uuid_name on pipeline_versions`)
-	}
-	rows.Close()
-
-	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+	return migrator
 }
 
 // Initialize the connection string for connecting to Mysql database
 // Format would be something like root@tcp(ip:port)/dbname?charset=utf8&loc=Local&parseTime=True
-func initMysql(driverName string, initConnectionTimeout time.Duration) string {
+func initMysql(driverName string, initConnectionTimeout time.Duration, dryRun bool) string {
 	mysqlConfig := client.CreateMySQLConfig(
 		common.GetStringConfigWithDefault(mysqlUser, "root"),
 		common.GetStringConfigWithDefault(mysqlPassword, ""),
@@ -361,17 +323,7 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 
 	// Create database if not exist
 	dbName := common.GetStringConfig(mysqlDBName)
-	operation = func() error {
-		_, err = db.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName))
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-	b = backoff.NewExponentialBackOff()
-	b.MaxElapsedTime = initConnectionTimeout
-	err = backoff.Retry(operation, b)
-
+	err = createDatabaseIfNotExists(db, driverName, dbName, dryRun, initConnectionTimeout)
 	util.TerminateIfError(err)
 	mysqlConfig.DBName = dbName
 	// When updating, return rows matched instead of rows affected. This counts rows that are being
@@ -382,6 +334,74 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	return mysqlConfig.FormatDSN()
 }
 
+// Initialize the connection string for connecting to PostgreSQL database. The
+// same DBConfig keys as for MySQL are used, with PostgreSQL defaults.
+// Format would be something like host=postgres port=5432 user=root dbname=mlpipeline sslmode=disable
+func initPostgres(initConnectionTimeout time.Duration, dryRun bool) string {
+	user := common.GetStringConfigWithDefault(mysqlUser, "root")
+	password := common.GetStringConfigWithDefault(mysqlPassword, "")
+	host := common.GetStringConfigWithDefault(mysqlServiceHost, "postgres")
+	port := common.GetStringConfigWithDefault(mysqlServicePort, "5432")
+	extraParams := common.GetMapConfig(mysqlExtraParams)
+
+	// Connect to the default database to create the KFP database if needed.
+	var db *sql.DB
+	var err error
+	var operation = func() error {
+		db, err = sql.Open(postgres.DriverName,
+			postgres.CreateConnectionString(user, password, host, port, "postgres", extraParams))
+		if err != nil {
+			return err
+		}
+		return db.Ping()
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	backoff.RetryNotify(operation, b, func(e error, duration time.Duration) {
+		glog.Errorf("%v", e)
+	})
+
+	defer db.Close()
+	util.TerminateIfError(err)
+
+	// Create database if not exist
+	dbName := common.GetStringConfig(mysqlDBName)
+	err = createDatabaseIfNotExists(db, postgres.DriverName, dbName, dryRun, initConnectionTimeout)
+	util.TerminateIfError(err)
+	return postgres.CreateConnectionString(user, password, host, port, dbName, extraParams)
+}
+
+// createDatabaseIfNotExists creates the database dbName with db, a connection
+// to the database server, unless the database exists. With dryRun set, it
+// doesn't write to the server, and fails if the database doesn't exist.
+func createDatabaseIfNotExists(db *sql.DB, driverName string, dbName string, dryRun bool, initConnectionTimeout time.Duration) error {
+	existsQuery := "SELECT EXISTS (SELECT 1 FROM information_schema.SCHEMATA WHERE SCHEMA_NAME = ?)"
+	createStatement := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName)
+	if driverName == postgres.DriverName {
+		// Postgres doesn't support CREATE DATABASE IF NOT EXISTS.
+		existsQuery = "SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)"
+		createStatement = "CREATE DATABASE " + pq.QuoteIdentifier(dbName)
+	}
+
+	var exists bool
+	operation := func() error {
+		if err := db.QueryRow(existsQuery, dbName).Scan(&exists); err != nil || exists || dryRun {
+			return err
+		}
+		_, err := db.Exec(createStatement)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	if err := backoff.Retry(operation, b); err != nil {
+		return err
+	}
+	if !exists && dryRun {
+		return fmt.Errorf("database %v doesn't exist, and a dry run doesn't create it", dbName)
+	}
+	return nil
+}
+
 func initMinioClient(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
 	// Create minio client.
 	minioServiceHost := common.GetStringConfigWithDefault(
@@ -441,61 +461,3 @@ func newClientManager() ClientManager {
 
 	return clientManager
 }
-
-// Data migration in 2 steps to introduce pipeline_versions table. This
-// migration shall be called only once when pipeline_versions table is created
-// for the first time in DB.
-func initPipelineVersionsFromPipelines(db *gorm.DB) {
-	tx := db.Begin()
-
-	// Step 1: duplicate pipelines to pipeline versions.
-	// The pipeline versions created here are not through KFP pipeine version
-	// API, and are only for the legacy pipelines that are created
-	// before pipeline version API is introduced.
-	// For those legacy pipelines, who don't have versions before, we create one
-	// implicit version for each of them. Given a legacy pipeline, the implicit
-	// version created here is assigned an ID the same as the pipeline ID. This
-	// way we don't need to move the minio file of pipeline package around,
-	// since the minio file's path is based on the pipeline ID (and now on the
-	// implicit version ID too). Meanwhile, IDs are required to be unique inside
-	// the same resource type, so pipeline and pipeline version as two different
-	// resources useing the same ID is OK.
-	// On the other hand, pipeline and its pipeline versions created after
-	// pipeline version API is introduced will have different Ids; and the minio
-	// file will be put directly into the directories for pipeline versions.
-	tx.Exec(`INSERT INTO
-	pipeline_versions (UUID, Name, CreatedAtInSec, Parameters, Status, PipelineId)
-	SELECT UUID, Name, CreatedAtInSec, Parameters, Status, UUID FROM pipelines;`)
-
-	// Step 2: modifiy pipelines table after pipeline_versions are populated.
-	tx.Exec("update pipelines set DefaultVersionId=UUID;")
-
-	tx.Commit()
-}
-
-func backfillExperimentIDToRunTable(db *gorm.DB) (retError error) {
-	// check if there is any row in the run table has experiment ID being empty
-	rows, err := db.CommonDB().Query(`SELECT ExperimentUUID FROM run_details WHERE ExperimentUUID = '' LIMIT 1`)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	// no row in run_details table has empty ExperimentUUID
-	if !rows.Next() {
-		return nil
-	}
-
-	_, err = db.CommonDB().Exec(`
-		UPDATE
-			run_details, resource_references
-		SET
-			run_details.ExperimentUUID = resource_references.ReferenceUUID
-		WHERE
-			run_details.UUID = resource_references.ResourceUUID
-			AND resource_references.ResourceType = 'Run'
-			AND resource_references.ReferenceType = 'Experiment'
-			AND run_details.ExperimentUUID = ''
-	`)
-	return err
-}
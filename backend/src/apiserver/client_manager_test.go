@@ -0,0 +1,170 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+const fakeServerDriverName = "fake_database_server"
+
+func init() {
+	sql.Register(fakeServerDriverName, &fakeServerDriver{})
+}
+
+// fakeServer is a database server, which has the database if exists is set. It
+// records the statements that are executed on it.
+type fakeServer struct {
+	exists     bool
+	statements []string
+}
+
+// fakeServers are the servers of the fake driver, by data source name.
+var fakeServers = map[string]*fakeServer{}
+
+type fakeServerDriver struct{}
+
+func (d *fakeServerDriver) Open(name string) (driver.Conn, error) {
+	return &fakeServerConn{server: fakeServers[name]}, nil
+}
+
+type fakeServerConn struct {
+	server *fakeServer
+}
+
+func (c *fakeServerConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeServerStmt{server: c.server, query: query}, nil
+}
+
+func (c *fakeServerConn) Close() error {
+	return nil
+}
+
+func (c *fakeServerConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeServerStmt struct {
+	server *fakeServer
+	query  string
+}
+
+func (s *fakeServerStmt) Close() error {
+	return nil
+}
+
+func (s *fakeServerStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeServerStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.server.statements = append(s.server.statements, s.query)
+	return driver.RowsAffected(1), nil
+}
+
+// Query answers the query whether the database exists.
+func (s *fakeServerStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeServerRows{exists: s.server.exists}, nil
+}
+
+type fakeServerRows struct {
+	exists bool
+	done   bool
+}
+
+func (r *fakeServerRows) Columns() []string {
+	return []string{"exists"}
+}
+
+func (r *fakeServerRows) Close() error {
+	return nil
+}
+
+func (r *fakeServerRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.exists
+	return nil
+}
+
+func openFakeServer(t *testing.T, server *fakeServer) *sql.DB {
+	fakeServers[t.Name()] = server
+	db, err := sql.Open(fakeServerDriverName, t.Name())
+	assert.Nil(t, err)
+	return db
+}
+
+func TestCreateDatabaseIfNotExists(t *testing.T) {
+	server := &fakeServer{}
+	db := openFakeServer(t, server)
+	defer db.Close()
+
+	err := createDatabaseIfNotExists(db, "mysql", "mlpipeline", false, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CREATE DATABASE IF NOT EXISTS mlpipeline"}, server.statements)
+}
+
+func TestCreateDatabaseIfNotExists_Postgres(t *testing.T) {
+	server := &fakeServer{}
+	db := openFakeServer(t, server)
+	defer db.Close()
+
+	err := createDatabaseIfNotExists(db, postgres.DriverName, "ML-pipeline", false, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`CREATE DATABASE "ML-pipeline"`}, server.statements)
+}
+
+func TestCreateDatabaseIfNotExists_Exists(t *testing.T) {
+	server := &fakeServer{exists: true}
+	db := openFakeServer(t, server)
+	defer db.Close()
+
+	err := createDatabaseIfNotExists(db, postgres.DriverName, "mlpipeline", false, time.Second)
+	assert.Nil(t, err)
+	assert.Empty(t, server.statements)
+}
+
+func TestCreateDatabaseIfNotExists_DryRun(t *testing.T) {
+	server := &fakeServer{exists: true}
+	db := openFakeServer(t, server)
+	defer db.Close()
+
+	err := createDatabaseIfNotExists(db, "mysql", "mlpipeline", true, time.Second)
+	assert.Nil(t, err)
+	assert.Empty(t, server.statements)
+}
+
+func TestCreateDatabaseIfNotExists_DryRunMissingDatabase(t *testing.T) {
+	for _, driverName := range []string{"mysql", postgres.DriverName} {
+		server := &fakeServer{}
+		db := openFakeServer(t, server)
+
+		err := createDatabaseIfNotExists(db, driverName, "mlpipeline", true, time.Second)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "doesn't exist")
+		assert.Empty(t, server.statements)
+		db.Close()
+	}
+}
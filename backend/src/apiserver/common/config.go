@@ -15,7 +15,10 @@
 package common
 
 import (
+	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -30,10 +33,47 @@ const (
 	DefaultPipelineRunnerServiceAccountFlag string = "DEFAULTPIPELINERUNNERSERVICEACCOUNT"
 	KubeflowUserIDHeader                    string = "KUBEFLOW_USERID_HEADER"
 	KubeflowUserIDPrefix                    string = "KUBEFLOW_USERID_PREFIX"
+	KubeflowUserIDHeaderEnabled             string = "KUBEFLOW_USERID_HEADER_ENABLED"
+	OIDCIssuer                              string = "OIDC_ISSUER"
+	OIDCAudiences                           string = "OIDC_AUDIENCES"
+	OIDCUserClaim                           string = "OIDC_USER_CLAIM"
+	OIDCGroupsClaim                         string = "OIDC_GROUPS_CLAIM"
+	OIDCJWKSURL                             string = "OIDC_JWKS_URL"
+	AuthorizationPolicyFile                 string = "AUTHORIZATION_POLICY_FILE"
+	AuthorizationCacheTTL                   string = "AUTHORIZATION_CACHE_TTL"
+	AuditLogFile                            string = "AUDIT_LOG_FILE"
 	UpdatePipelineVersionByDefault          string = "AUTO_UPDATE_PIPELINE_DEFAULT_VERSION"
 	TokenReviewAudience                     string = "TOKEN_REVIEW_AUDIENCE"
+	V2DriverImage                           string = "V2_DRIVER_IMAGE"
+	V2LauncherImage                         string = "V2_LAUNCHER_IMAGE"
+	V2ImagePullPolicy                       string = "V2_IMAGE_PULL_POLICY"
+	V2DefaultPipelineRoot                   string = "V2_DEFAULT_PIPELINE_ROOT"
+	V2ImageMirrors                          string = "V2_IMAGE_MIRRORS"
+	V2ParameterCoercion                     string = "V2_PARAMETER_COERCION"
+	V2NamespaceConfigs                      string = "V2_NAMESPACE_CONFIGS"
 )
 
+// V2Config configures how v2 pipelines are compiled to workflows. Empty
+// fields fall back to the defaults of the v2 compiler.
+type V2Config struct {
+	DriverImage   string `json:"driverImage,omitempty"`
+	LauncherImage string `json:"launcherImage,omitempty"`
+	// Always, IfNotPresent or Never, applies to the driver and launcher images.
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+	// Default service account of runs that do not specify one. It can only
+	// be configured per namespace, DEFAULTPIPELINERUNNERSERVICEACCOUNT is the
+	// cluster-wide default.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// Default pipeline root of runs that do not specify one, the kfp-launcher
+	// config map of the namespace applies when empty.
+	DefaultPipelineRoot string `json:"defaultPipelineRoot,omitempty"`
+	// Image name prefixes and their mirrors, for air-gapped clusters.
+	ImageMirrors map[string]string `json:"imageMirrors,omitempty"`
+	// How input parameter values are converted to the parameter types, one
+	// of TO_STRING (default), NONE or PARSE.
+	ParameterCoercion string `json:"parameterCoercion,omitempty"`
+}
+
 func IsPipelineVersionUpdatedByDefault() bool {
 	return GetBoolConfigWithDefault(UpdatePipelineVersionByDefault, true)
 }
@@ -124,6 +164,132 @@ func GetKubeflowUserIDPrefix() string {
 	return GetStringConfigWithDefault(KubeflowUserIDPrefix, GoogleIAPUserIdentityPrefix)
 }
 
+// GetV2Config returns the v2 config of a namespace. The config of the namespace
+// in V2_NAMESPACE_CONFIGS overrides the cluster-wide config field by field,
+// its image mirrors are added to the cluster-wide image mirrors.
+func GetV2Config(namespace string) (*V2Config, error) {
+	config := &V2Config{
+		DriverImage:         GetStringConfigWithDefault(V2DriverImage, ""),
+		LauncherImage:       GetStringConfigWithDefault(V2LauncherImage, ""),
+		ImagePullPolicy:     GetStringConfigWithDefault(V2ImagePullPolicy, ""),
+		DefaultPipelineRoot: GetStringConfigWithDefault(V2DefaultPipelineRoot, ""),
+		ImageMirrors:        make(map[string]string),
+		ParameterCoercion:   GetStringConfigWithDefault(V2ParameterCoercion, ""),
+	}
+	if viper.IsSet(V2ImageMirrors) {
+		for prefix, mirror := range viper.GetStringMapString(V2ImageMirrors) {
+			config.ImageMirrors[prefix] = mirror
+		}
+	}
+	if namespace == "" || !viper.IsSet(V2NamespaceConfigs) {
+		return config, nil
+	}
+	// The namespace configs are a JSON object when they are set by an
+	// environment variable.
+	value := viper.Get(V2NamespaceConfigs)
+	configsJSON, ok := value.(string)
+	if !ok {
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", V2NamespaceConfigs, err)
+		}
+		configsJSON = string(bytes)
+	}
+	var namespaceConfigs map[string]*V2Config
+	if err := json.Unmarshal([]byte(configsJSON), &namespaceConfigs); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", V2NamespaceConfigs, err)
+	}
+	override, ok := namespaceConfigs[namespace]
+	if !ok || override == nil {
+		return config, nil
+	}
+	if override.DriverImage != "" {
+		config.DriverImage = override.DriverImage
+	}
+	if override.LauncherImage != "" {
+		config.LauncherImage = override.LauncherImage
+	}
+	if override.ImagePullPolicy != "" {
+		config.ImagePullPolicy = override.ImagePullPolicy
+	}
+	if override.ServiceAccount != "" {
+		config.ServiceAccount = override.ServiceAccount
+	}
+	if override.DefaultPipelineRoot != "" {
+		config.DefaultPipelineRoot = override.DefaultPipelineRoot
+	}
+	if override.ParameterCoercion != "" {
+		config.ParameterCoercion = override.ParameterCoercion
+	}
+	for prefix, mirror := range override.ImageMirrors {
+		config.ImageMirrors[prefix] = mirror
+	}
+	return config, nil
+}
+
 func GetTokenReviewAudience() string {
 	return GetStringConfigWithDefault(TokenReviewAudience, DefaultTokenReviewAudience)
 }
+
+// IsKubeflowUserIDHeaderEnabled returns whether the user identity header is
+// trusted. Disable it when no proxy in front of the API server sets the header.
+// It is disabled by default when users authenticate with OIDC tokens, since
+// anyone could then set the header: enable it explicitly only if a proxy sets
+// the header for every request.
+func IsKubeflowUserIDHeaderEnabled() bool {
+	return GetBoolConfigWithDefault(KubeflowUserIDHeaderEnabled, GetOIDCIssuer() == "")
+}
+
+// GetOIDCIssuer returns the issuer of the OIDC tokens that authenticate users,
+// empty if OIDC authentication is disabled.
+func GetOIDCIssuer() string {
+	return GetStringConfigWithDefault(OIDCIssuer, "")
+}
+
+// GetOIDCAudiences returns the comma-separated audiences of which OIDC tokens
+// must have one, usually the client ID of the API server at the issuer.
+func GetOIDCAudiences() []string {
+	var audiences []string
+	for _, audience := range strings.Split(GetStringConfigWithDefault(OIDCAudiences, ""), ",") {
+		if audience = strings.TrimSpace(audience); audience != "" {
+			audiences = append(audiences, audience)
+		}
+	}
+	return audiences
+}
+
+// GetOIDCUserClaim returns the claim of OIDC tokens that is the user identity.
+func GetOIDCUserClaim() string {
+	return GetStringConfigWithDefault(OIDCUserClaim, DefaultOIDCUserClaim)
+}
+
+// GetOIDCGroupsClaim returns the claim of OIDC tokens that has the groups of
+// the user, empty if the groups are not used.
+func GetOIDCGroupsClaim() string {
+	return GetStringConfigWithDefault(OIDCGroupsClaim, DefaultOIDCGroupsClaim)
+}
+
+// GetOIDCJWKSURL returns the URL of the key set of the OIDC issuer, empty to
+// discover it from the issuer.
+func GetOIDCJWKSURL() string {
+	return GetStringConfigWithDefault(OIDCJWKSURL, "")
+}
+
+// GetAuthorizationPolicyFile returns the path of the policy file that
+// authorizes requests, empty if requests are authorized with Kubernetes
+// SubjectAccessReviews.
+func GetAuthorizationPolicyFile() string {
+	return GetStringConfigWithDefault(AuthorizationPolicyFile, "")
+}
+
+// GetAuthorizationCacheTTL returns how long authorization decisions are
+// cached, zero if they are not cached.
+func GetAuthorizationCacheTTL() time.Duration {
+	return viper.GetDuration(AuthorizationCacheTTL)
+}
+
+// GetAuditLogFile returns the path of the file to which the audit events are
+// appended as JSON lines, empty if they are only stored in the database.
+func GetAuditLogFile() string {
+	return GetStringConfigWithDefault(AuditLogFile, "")
+}
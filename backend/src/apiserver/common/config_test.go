@@ -0,0 +1,105 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetV2Config(t *testing.T) {
+	viper.Set(V2DriverImage, "registry.local/kfp-driver:2.0.0")
+	viper.Set(V2ImagePullPolicy, "IfNotPresent")
+	viper.Set(V2DefaultPipelineRoot, "minio://mlpipeline/v2/artifacts")
+	viper.Set(V2ImageMirrors, map[string]interface{}{"gcr.io": "registry.local/gcr"})
+	viper.Set(V2NamespaceConfigs, map[string]interface{}{
+		"team-a": map[string]interface{}{
+			"launcherImage":       "registry.local/kfp-launcher:2.0.0",
+			"imagePullPolicy":     "Always",
+			"serviceAccount":      "team-a-runner",
+			"defaultPipelineRoot": "gs://team-a/artifacts",
+			"parameterCoercion":   "PARSE",
+			"imageMirrors":        map[string]interface{}{"docker.io": "registry.local/docker"},
+		},
+	})
+	defer func() {
+		for _, key := range []string{V2DriverImage, V2ImagePullPolicy, V2DefaultPipelineRoot, V2ImageMirrors, V2NamespaceConfigs} {
+			viper.Set(key, nil)
+		}
+	}()
+
+	tests := []struct {
+		namespace string
+		expected  *V2Config
+	}{
+		{
+			namespace: "",
+			expected: &V2Config{
+				DriverImage:         "registry.local/kfp-driver:2.0.0",
+				ImagePullPolicy:     "IfNotPresent",
+				DefaultPipelineRoot: "minio://mlpipeline/v2/artifacts",
+				ImageMirrors:        map[string]string{"gcr.io": "registry.local/gcr"},
+			},
+		},
+		{
+			namespace: "team-b",
+			expected: &V2Config{
+				DriverImage:         "registry.local/kfp-driver:2.0.0",
+				ImagePullPolicy:     "IfNotPresent",
+				DefaultPipelineRoot: "minio://mlpipeline/v2/artifacts",
+				ImageMirrors:        map[string]string{"gcr.io": "registry.local/gcr"},
+			},
+		},
+		{
+			namespace: "team-a",
+			expected: &V2Config{
+				DriverImage:         "registry.local/kfp-driver:2.0.0",
+				LauncherImage:       "registry.local/kfp-launcher:2.0.0",
+				ImagePullPolicy:     "Always",
+				ServiceAccount:      "team-a-runner",
+				DefaultPipelineRoot: "gs://team-a/artifacts",
+				ParameterCoercion:   "PARSE",
+				ImageMirrors: map[string]string{
+					"gcr.io":    "registry.local/gcr",
+					"docker.io": "registry.local/docker",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			config, err := GetV2Config(tt.namespace)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, config)
+		})
+	}
+}
+
+func TestGetV2Config_NamespaceConfigsFromEnv(t *testing.T) {
+	// Environment variables set the namespace configs as a JSON object.
+	viper.Set(V2NamespaceConfigs, `{"team-a": {"driverImage": "registry.local/kfp-driver:2.0.0"}}`)
+	defer viper.Set(V2NamespaceConfigs, nil)
+
+	config, err := GetV2Config("team-a")
+	assert.Nil(t, err)
+	assert.Equal(t, "registry.local/kfp-driver:2.0.0", config.DriverImage)
+
+	viper.Set(V2NamespaceConfigs, "not json")
+	_, err = GetV2Config("team-a")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid V2_NAMESPACE_CONFIGS")
+}
@@ -40,8 +40,10 @@ const (
 	RbacResourceTypeJobs           = "jobs"
 	RbacResourceTypeViewers        = "viewers"
 	RbacResourceTypeVisualizations = "visualizations"
+	RbacResourceTypeAuditEvents    = "auditevents"
 
 	RbacResourceVerbArchive   = "archive"
+	RbacResourceVerbBackfill  = "backfill"
 	RbacResourceVerbUpdate    = "update"
 	RbacResourceVerbCreate    = "create"
 	RbacResourceVerbDelete    = "delete"
@@ -51,12 +53,15 @@ const (
 	RbacResourceVerbList      = "list"
 	RbacResourceVerbRetry     = "retry"
 	RbacResourceVerbTerminate = "terminate"
+	RbacResourceVerbSuspend   = "suspend"
+	RbacResourceVerbResume    = "resume"
 	RbacResourceVerbUnarchive = "unarchive"
 )
 
 const (
-	Owner   model.Relationship = "Owner"
-	Creator model.Relationship = "Creator"
+	Owner    model.Relationship = "Owner"
+	Creator  model.Relationship = "Creator"
+	Imported model.Relationship = "Imported"
 )
 
 const (
@@ -68,6 +73,11 @@ const (
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+const (
+	DefaultOIDCUserClaim   string = "email"
+	DefaultOIDCGroupsClaim string = "groups"
+)
+
 func ToModelResourceType(apiType api.ResourceType) (model.ResourceType, error) {
 	switch apiType {
 	case api.ResourceType_EXPERIMENT:
@@ -78,6 +88,8 @@ func ToModelResourceType(apiType api.ResourceType) (model.ResourceType, error) {
 		return PipelineVersion, nil
 	case api.ResourceType_NAMESPACE:
 		return Namespace, nil
+	case api.ResourceType_RUN:
+		return Run, nil
 	default:
 		return "", util.NewInvalidInputError("Unsupported resource type: %s", api.ResourceType_name[int32(apiType)])
 	}
@@ -89,6 +101,8 @@ func ToModelRelationship(r api.Relationship) (model.Relationship, error) {
 		return Creator, nil
 	case api.Relationship_OWNER:
 		return Owner, nil
+	case api.Relationship_IMPORTED:
+		return Imported, nil
 	default:
 		return "", util.NewInvalidInputError("Unsupported resource relationship: %s", api.Relationship_name[int32(r)])
 	}
@@ -19,9 +19,13 @@ package filter
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 
@@ -40,11 +44,30 @@ type Filter struct {
 	lt  map[string][]interface{}
 	lte map[string][]interface{}
 
-	in map[string][]interface{}
+	in    map[string][]interface{}
+	notIn map[string][]interface{}
 
-	substring map[string][]interface{}
+	substring  map[string][]interface{}
+	startsWith map[string][]interface{}
+	regex      map[string][]interface{}
+
+	isNull map[string]bool
+
+	// groups are the nested groups of the filter. They are parsed from
+	// filterProto, also when the filter is unmarshaled.
+	groups []*group
 }
 
+// group is a parsed FilterGroup, whose predicates and nested groups are held
+// by filter.
+type group struct {
+	op     api.FilterGroup_Op
+	filter *Filter
+}
+
+// maxGroupDepth is the maximum nesting depth of the groups of a filter.
+const maxGroupDepth = 10
+
 // filterForMarshaling is a helper struct for marshaling Filter into JSON. This
 // is needed as we don't want to export the fields in Filter.
 type filterForMarshaling struct {
@@ -60,6 +83,13 @@ type filterForMarshaling struct {
 	IN map[string][]interface{}
 
 	SUBSTRING map[string][]interface{}
+
+	// The operators added later are omitted if unused, so that the page tokens
+	// of the other filters do not change.
+	NOTIN      map[string][]interface{} `json:",omitempty"`
+	STARTSWITH map[string][]interface{} `json:",omitempty"`
+	REGEX      map[string][]interface{} `json:",omitempty"`
+	ISNULL     map[string]bool          `json:",omitempty"`
 }
 
 // MarshalJSON implements JSON Marshaler for Filter.
@@ -78,10 +108,28 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		LT:          f.lt,
 		LTE:         f.lte,
 		IN:          f.in,
+		NOTIN:       f.notIn,
 		SUBSTRING:   f.substring,
+		STARTSWITH:  f.startsWith,
+		REGEX:       f.regex,
+		ISNULL:      f.isNull,
 	})
 }
 
+// Equivalent returns true if the Filters f and other are parsed from equal
+// Filter protos. Unlike reflect.DeepEqual, it ignores the internal state of the
+// protos and the types of the values decoded from JSON, so a Filter unmarshaled
+// from a page token is equivalent to the Filter of the same request.
+func (f *Filter) Equivalent(other *Filter) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.filterProto == nil || other.filterProto == nil {
+		return reflect.DeepEqual(f, other)
+	}
+	return proto.Equal(f.filterProto, other.filterProto)
+}
+
 // UnmarshalJSON implements JSON Unmarshaler for Filter.
 func (f *Filter) UnmarshalJSON(b []byte) error {
 	ffm := filterForMarshaling{}
@@ -105,11 +153,36 @@ func (f *Filter) UnmarshalJSON(b []byte) error {
 	f.in = ffm.IN
 	f.substring = ffm.SUBSTRING
 
-	return nil
+	// Page tokens created before the operators were added have no values for
+	// them.
+	f.notIn = ffm.NOTIN
+	if f.notIn == nil {
+		f.notIn = make(map[string][]interface{}, 0)
+	}
+	f.startsWith = ffm.STARTSWITH
+	if f.startsWith == nil {
+		f.startsWith = make(map[string][]interface{}, 0)
+	}
+	f.regex = ffm.REGEX
+	if f.regex == nil {
+		f.regex = make(map[string][]interface{}, 0)
+	}
+	f.isNull = ffm.ISNULL
+	if f.isNull == nil {
+		f.isNull = make(map[string]bool, 0)
+	}
+
+	f.groups, err = parseGroups(f.filterProto.Groups, 1)
+	return err
 }
 
 // New creates a new Filter from parsing the API filter protocol buffer.
 func New(filterProto *api.Filter) (*Filter, error) {
+	return newFilter(filterProto, 0)
+}
+
+// newFilter creates a Filter whose groups are nested depth levels deep.
+func newFilter(filterProto *api.Filter, depth int) (*Filter, error) {
 	f := &Filter{
 		filterProto: filterProto,
 		eq:          make(map[string][]interface{}, 0),
@@ -119,15 +192,50 @@ func New(filterProto *api.Filter) (*Filter, error) {
 		lt:          make(map[string][]interface{}, 0),
 		lte:         make(map[string][]interface{}, 0),
 		in:          make(map[string][]interface{}, 0),
+		notIn:       make(map[string][]interface{}, 0),
 		substring:   make(map[string][]interface{}, 0),
+		startsWith:  make(map[string][]interface{}, 0),
+		regex:       make(map[string][]interface{}, 0),
+		isNull:      make(map[string]bool, 0),
 	}
 
 	if err := f.parseFilterProto(); err != nil {
 		return nil, err
 	}
+	groups, err := parseGroups(filterProto.Groups, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	f.groups = groups
 	return f, nil
 }
 
+func parseGroups(groupProtos []*api.FilterGroup, depth int) ([]*group, error) {
+	if len(groupProtos) == 0 {
+		return nil, nil
+	}
+	if depth > maxGroupDepth {
+		return nil, util.NewInvalidInputError("filter groups cannot be nested more than %d levels deep", maxGroupDepth)
+	}
+	groups := make([]*group, 0, len(groupProtos))
+	for _, g := range groupProtos {
+		switch g.Op {
+		case api.FilterGroup_AND, api.FilterGroup_OR, api.FilterGroup_NOT:
+		default:
+			return nil, util.NewInvalidInputError("invalid filter group operation: %v", g.Op)
+		}
+		if len(g.Predicates) == 0 && len(g.Groups) == 0 {
+			return nil, util.NewInvalidInputError("filter group %v has no predicates or groups", g.Op)
+		}
+		f, err := newFilter(&api.Filter{Predicates: g.Predicates, Groups: g.Groups}, depth)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, &group{op: g.Op, filter: f})
+	}
+	return groups, nil
+}
+
 // NewWithKeyMap is like New, but takes an additional map and model name for mapping key names
 // in the protocol buffer to an appropriate name for use when querying the
 // model. For example, if the API name of a field is "name", the model name is "pipelines", and
@@ -139,67 +247,155 @@ func NewWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName
 	if modelName != "" {
 		modelNamePrefix = modelName + "."
 	}
-	
-	for _, pred := range filterProto.Predicates {
-		k, ok := keyMap[pred.Key]
+
+	return NewWithKeyFunc(filterProto, func(key string) (string, bool) {
+		k, ok := keyMap[key]
+		return modelNamePrefix + k, ok
+	})
+}
+
+// NewWithKeyFunc is like NewWithKeyMap, but maps the key names with mapKey,
+// which returns false for the keys that cannot be filtered on. It is used by
+// the models whose keys are not all known in advance, like the metrics of the
+// runs.
+func NewWithKeyFunc(filterProto *api.Filter, mapKey func(key string) (string, bool)) (*Filter, error) {
+	if err := mapKeys(filterProto.Predicates, filterProto.Groups, mapKey); err != nil {
+		return nil, err
+	}
+	return New(filterProto)
+}
+
+// mapKeys maps the keys of the predicates, including the ones of the nested
+// groups, for NewWithKeyFunc.
+func mapKeys(predicates []*api.Predicate, groups []*api.FilterGroup, mapKey func(key string) (string, bool)) error {
+	for _, pred := range predicates {
+		k, ok := mapKey(pred.Key)
 		if !ok {
-			return nil, util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
+			return util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
 		}
-		pred.Key = modelNamePrefix + k
+		pred.Key = k
 	}
-	return New(filterProto)
+	for _, g := range groups {
+		if err := mapKeys(g.Predicates, g.Groups, mapKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceKeys returns a copy of the Filter f whose keys, including the ones of
+// the nested groups, are replaced with replace(key) when it is applied to SQL
+// queries. It is used to filter on values that are not in the columns of the
+// table, e.g. with subqueries. The Filter proto of the copy, and hence its page
+// tokens, still has the original keys.
+func (f *Filter) ReplaceKeys(replace func(key string) string) *Filter {
+	if f == nil {
+		return nil
+	}
+	replaceValueKeys := func(m map[string][]interface{}) map[string][]interface{} {
+		replaced := make(map[string][]interface{}, len(m))
+		for k, v := range m {
+			replaced[replace(k)] = v
+		}
+		return replaced
+	}
+	isNull := make(map[string]bool, len(f.isNull))
+	for k, v := range f.isNull {
+		isNull[replace(k)] = v
+	}
+	var groups []*group
+	for _, g := range f.groups {
+		groups = append(groups, &group{op: g.op, filter: g.filter.ReplaceKeys(replace)})
+	}
+	return &Filter{
+		filterProto: f.filterProto,
+		eq:          replaceValueKeys(f.eq),
+		neq:         replaceValueKeys(f.neq),
+		gt:          replaceValueKeys(f.gt),
+		gte:         replaceValueKeys(f.gte),
+		lt:          replaceValueKeys(f.lt),
+		lte:         replaceValueKeys(f.lte),
+		in:          replaceValueKeys(f.in),
+		notIn:       replaceValueKeys(f.notIn),
+		substring:   replaceValueKeys(f.substring),
+		startsWith:  replaceValueKeys(f.startsWith),
+		regex:       replaceValueKeys(f.regex),
+		isNull:      isNull,
+		groups:      groups,
+	}
+}
+
+// Dialect builds the SQL conditions of the filters that vary in different SQL
+// dialects. It is implemented by the SQL dialects of the storage package.
+type Dialect interface {
+	// Like builds a condition that expr matches the LIKE pattern of a "?" bind
+	// variable, case insensitively like with the default collation of MySQL.
+	Like(expr string) string
+
+	// Regexp builds a condition that expr matches the POSIX extended regular
+	// expression of a "?" bind variable, case insensitively like Like.
+	Regexp(expr string) string
 }
 
 // AddToSelect builds a WHERE clause from the Filter f, adds it to the supplied
-// SelectBuilder object and returns it for use in SQL queries.
-func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
+// SelectBuilder object and returns it for use in SQL queries of the dialect.
+func (f *Filter) AddToSelect(sb squirrel.SelectBuilder, dialect Dialect) squirrel.SelectBuilder {
+	for _, c := range f.conditions(dialect) {
+		sb = sb.Where(c)
+	}
+	return sb
+}
+
+// conditions returns the SQL conditions of the predicates and the groups of the
+// Filter f, which are all true for the matching rows.
+func (f *Filter) conditions(dialect Dialect) []squirrel.Sqlizer {
+	var conditions []squirrel.Sqlizer
 	for k := range f.eq {
 		for _, v := range f.eq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conditions = append(conditions, squirrel.Eq{k: v})
 		}
 	}
 
 	for k := range f.neq {
 		for _, v := range f.neq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.NotEq(m))
+			conditions = append(conditions, squirrel.NotEq{k: v})
 		}
 	}
 
 	for k := range f.gt {
 		for _, v := range f.gt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Gt(m))
+			conditions = append(conditions, squirrel.Gt{k: v})
 		}
 	}
 
 	for k := range f.gte {
 		for _, v := range f.gte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.GtOrEq(m))
+			conditions = append(conditions, squirrel.GtOrEq{k: v})
 		}
 	}
 
 	for k := range f.lt {
 		for _, v := range f.lt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Lt(m))
+			conditions = append(conditions, squirrel.Lt{k: v})
 		}
 	}
 
 	for k := range f.lte {
 		for _, v := range f.lte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.LtOrEq(m))
+			conditions = append(conditions, squirrel.LtOrEq{k: v})
 		}
 	}
 
 	// In
 	for k := range f.in {
 		for _, v := range f.in[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conditions = append(conditions, squirrel.Eq{k: v})
+		}
+	}
+
+	for k := range f.notIn {
+		for _, v := range f.notIn[k] {
+			conditions = append(conditions, squirrel.NotEq{k: v})
 		}
 	}
 
@@ -207,21 +403,258 @@ func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
 		// Modify each string value v so it looks like %v% so we are doing a substring
 		// match with the LIKE operator.
 		for _, v := range f.substring[k] {
-			like := make(squirrel.Like)
-			like[k] = fmt.Sprintf("%%%s%%", v)
-			sb = sb.Where(like)
+			conditions = append(conditions, squirrel.Expr(dialect.Like(k), fmt.Sprintf("%%%s%%", v)))
 		}
 	}
 
-	return sb
+	for k := range f.startsWith {
+		// Escape the wildcards of LIKE in v, so that it is matched literally.
+		for _, v := range f.startsWith[k] {
+			conditions = append(conditions, squirrel.Expr(fmt.Sprintf("%s ESCAPE '%s'", dialect.Like(k), likeEscape), likeEscaper.Replace(v.(string))+"%"))
+		}
+	}
+
+	for k := range f.regex {
+		for _, v := range f.regex[k] {
+			conditions = append(conditions, squirrel.Expr(dialect.Regexp(k), v))
+		}
+	}
+
+	for k := range f.isNull {
+		conditions = append(conditions, squirrel.Eq{k: nil})
+	}
+
+	for _, g := range f.groups {
+		conditions = append(conditions, g.condition(dialect))
+	}
+	return conditions
+}
+
+// condition returns the SQL condition of the group g.
+func (g *group) condition(dialect Dialect) squirrel.Sqlizer {
+	conditions := g.filter.conditions(dialect)
+	switch g.op {
+	case api.FilterGroup_OR:
+		return squirrel.Or(conditions)
+	case api.FilterGroup_NOT:
+		return not{squirrel.And(conditions)}
+	default:
+		return squirrel.And(conditions)
+	}
+}
+
+// not negates a condition, as squirrel has no NOT.
+type not struct {
+	condition squirrel.Sqlizer
+}
+
+func (n not) ToSql() (string, []interface{}, error) {
+	sql, args, err := n.condition.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("NOT (%s)", sql), args, nil
+}
+
+// likeEscape is the escape character of the LIKE patterns of STARTS_WITH. It
+// is not a backslash, which MySQL and SQLite string literals treat differently.
+const likeEscape = "!"
+
+var likeEscaper = strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_")
+
+// Matches reports whether a resource satisfies the Filter f, the same way the
+// WHERE clause built by AddToSelect would, but without querying the database.
+// fieldValue returns the value of the resource for a predicate key, or nil if
+// the resource has no such field.
+func (f *Filter) Matches(fieldValue func(key string) interface{}) bool {
+	for _, matched := range f.matches(fieldValue) {
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns whether a resource satisfies each of the predicates and the
+// groups of the Filter f.
+func (f *Filter) matches(fieldValue func(key string) interface{}) []bool {
+	var results []bool
+	compareAll := func(m map[string][]interface{}, want func(c int) bool) {
+		for k, values := range m {
+			for _, v := range values {
+				c, ok := compareValues(fieldValue(k), v)
+				results = append(results, ok && want(c))
+			}
+		}
+	}
+
+	compareAll(f.eq, func(c int) bool { return c == 0 })
+	compareAll(f.neq, func(c int) bool { return c != 0 })
+	compareAll(f.gt, func(c int) bool { return c > 0 })
+	compareAll(f.gte, func(c int) bool { return c >= 0 })
+	compareAll(f.lt, func(c int) bool { return c < 0 })
+	compareAll(f.lte, func(c int) bool { return c <= 0 })
+
+	for k, values := range f.in {
+		for _, v := range values {
+			results = append(results, inValues(fieldValue(k), v))
+		}
+	}
+
+	for k, values := range f.notIn {
+		for _, v := range values {
+			// Like NOT IN in SQL, NULL values do not match.
+			value := fieldValue(k)
+			results = append(results, !isNull(value) && !inValues(value, v))
+		}
+	}
+
+	matchStrings := func(m map[string][]interface{}, match func(s string, v string) bool) {
+		for k, values := range m {
+			for _, v := range values {
+				s, ok := fieldValue(k).(string)
+				results = append(results, ok && match(s, v.(string)))
+			}
+		}
+	}
+	// Like LIKE in the dialects, the substrings and the prefixes are matched
+	// case insensitively.
+	matchStrings(f.substring, func(s string, v string) bool {
+		return strings.Contains(strings.ToLower(s), strings.ToLower(v))
+	})
+	matchStrings(f.startsWith, func(s string, v string) bool {
+		return strings.HasPrefix(strings.ToLower(s), strings.ToLower(v))
+	})
+	matchStrings(f.regex, func(s string, v string) bool {
+		re, err := compileRegex(v)
+		return err == nil && re.MatchString(s)
+	})
+
+	for k := range f.isNull {
+		results = append(results, isNull(fieldValue(k)))
+	}
+
+	for _, g := range f.groups {
+		results = append(results, g.matches(fieldValue))
+	}
+	return results
+}
+
+// matches reports whether a resource satisfies the group g.
+func (g *group) matches(fieldValue func(key string) interface{}) bool {
+	results := g.filter.matches(fieldValue)
+	allMatched, anyMatched := true, false
+	for _, matched := range results {
+		allMatched = allMatched && matched
+		anyMatched = anyMatched || matched
+	}
+	switch g.op {
+	case api.FilterGroup_OR:
+		return anyMatched
+	case api.FilterGroup_NOT:
+		return !allMatched
+	default:
+		return allMatched
+	}
+}
+
+// isNull reports whether a field value is nil, or a nil pointer.
+func isNull(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	r := reflect.ValueOf(v)
+	return r.Kind() == reflect.Ptr && r.IsNil()
+}
+
+// compareValues compares a field value with a predicate value. It returns false
+// if the values can't be compared, like a comparison with NULL in SQL.
+func compareValues(fieldValue interface{}, predicateValue interface{}) (int, bool) {
+	if a, ok := fieldValue.(string); ok {
+		b, ok := predicateValue.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	}
+	a, ok := toFloat64(fieldValue)
+	if !ok {
+		return 0, false
+	}
+	b, ok := toFloat64(predicateValue)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func inValues(fieldValue interface{}, predicateValues interface{}) bool {
+	var values []interface{}
+	switch t := predicateValues.(type) {
+	case []interface{}:
+		// The values of a Filter unmarshaled from a page token.
+		values = t
+	case []int32:
+		for _, v := range t {
+			values = append(values, v)
+		}
+	case []int64:
+		for _, v := range t {
+			values = append(values, v)
+		}
+	case []string:
+		for _, v := range t {
+			values = append(values, v)
+		}
+	}
+	for _, v := range values {
+		if c, ok := compareValues(fieldValue, v); ok && c == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch t := v.(type) {
+	case int:
+		return float64(t), true
+	case int32:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case float32:
+		return float64(t), true
+	case float64:
+		return t, true
+	default:
+		return 0, false
+	}
+}
+
+// compileRegex compiles the pattern of MATCHES_REGEX, which is a POSIX extended
+// regular expression, like the REGEXP of MySQL and the ~* of postgres, and is
+// matched case insensitively, like LIKE in the dialects.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if _, err := regexp.CompilePOSIX(pattern); err != nil {
+		return nil, err
+	}
+	return regexp.Compile("(?i)" + pattern)
 }
 
 func checkPredicate(p *api.Predicate) error {
 	switch p.Op {
-	case api.Predicate_IN:
+	case api.Predicate_IN, api.Predicate_NOT_IN:
 		switch t := p.Value.(type) {
-		case *api.Predicate_IntValue, *api.Predicate_LongValue, *api.Predicate_StringValue, *api.Predicate_TimestampValue:
-			return util.NewInvalidInputError("cannot use IN operator with scalar type %T", t)
+		case *api.Predicate_IntValue, *api.Predicate_LongValue, *api.Predicate_DoubleValue, *api.Predicate_StringValue, *api.Predicate_TimestampValue:
+			return util.NewInvalidInputError("cannot use %v operator with scalar type %T", p.Op, t)
 		}
 
 	case api.Predicate_EQUALS, api.Predicate_NOT_EQUALS, api.Predicate_GREATER_THAN, api.Predicate_GREATER_THAN_EQUALS, api.Predicate_LESS_THAN, api.Predicate_LESS_THAN_EQUALS:
@@ -230,7 +663,7 @@ func checkPredicate(p *api.Predicate) error {
 			return util.NewInvalidInputError("cannot use scalar operator %v on array type %T", p.Op, t)
 		}
 
-	case api.Predicate_IS_SUBSTRING:
+	case api.Predicate_IS_SUBSTRING, api.Predicate_STARTS_WITH:
 		switch t := p.Value.(type) {
 		case *api.Predicate_StringValue:
 			return nil
@@ -238,6 +671,19 @@ func checkPredicate(p *api.Predicate) error {
 			return util.NewInvalidInputError("cannot use non string value type %T with operator %v", p.Op, t)
 		}
 
+	case api.Predicate_MATCHES_REGEX:
+		if _, ok := p.Value.(*api.Predicate_StringValue); !ok {
+			return util.NewInvalidInputError("cannot use non string value type %T with operator %v", p.Value, p.Op)
+		}
+		if _, err := compileRegex(p.GetStringValue()); err != nil {
+			return util.NewInvalidInputError("invalid regular expression %q: %v", p.GetStringValue(), err)
+		}
+
+	case api.Predicate_IS_NULL:
+		if p.Value != nil {
+			return util.NewInvalidInputError("cannot use operator %v with a value, got %T", p.Op, p.Value)
+		}
+
 	default:
 		return util.NewInvalidInputError("invalid predicate operation: %v", p.Op)
 	}
@@ -251,6 +697,11 @@ func (f *Filter) parseFilterProto() error {
 			return err
 		}
 
+		if pred.Op == api.Predicate_IS_NULL {
+			f.isNull[pred.Key] = true
+			continue
+		}
+
 		var m map[string][]interface{}
 		switch pred.Op {
 		case api.Predicate_EQUALS:
@@ -267,8 +718,14 @@ func (f *Filter) parseFilterProto() error {
 			m = f.lte
 		case api.Predicate_IN:
 			m = f.in
+		case api.Predicate_NOT_IN:
+			m = f.notIn
 		case api.Predicate_IS_SUBSTRING:
 			m = f.substring
+		case api.Predicate_STARTS_WITH:
+			m = f.startsWith
+		case api.Predicate_MATCHES_REGEX:
+			m = f.regex
 		default:
 			return util.NewInvalidInputError("invalid predicate operation: %v", pred.Op)
 		}
@@ -287,6 +744,8 @@ func addPredicateValue(m map[string][]interface{}, p *api.Predicate) error {
 		m[p.Key] = append(m[p.Key], p.GetIntValue())
 	case *api.Predicate_LongValue:
 		m[p.Key] = append(m[p.Key], p.GetLongValue())
+	case *api.Predicate_DoubleValue:
+		m[p.Key] = append(m[p.Key], p.GetDoubleValue())
 	case *api.Predicate_StringValue:
 		m[p.Key] = append(m[p.Key], p.GetStringValue())
 	case *api.Predicate_TimestampValue:
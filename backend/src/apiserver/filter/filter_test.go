@@ -3,6 +3,7 @@ package filter
 import (
 	"encoding/json"
 	"google.golang.org/protobuf/testing/protocmp"
+	"strings"
 	"testing"
 
 	"github.com/Masterminds/squirrel"
@@ -14,9 +15,10 @@ import (
 
 func TestValidNewFilters(t *testing.T) {
 	opts := []cmp.Option{
-		cmp.AllowUnexported(Filter{}),
+		cmp.AllowUnexported(Filter{}, group{}),
 		cmp.FilterPath(func(p cmp.Path) bool {
-			return p.String() == "filterProto"
+			// The protos of the nested groups are ignored too.
+			return strings.HasSuffix(p.String(), "filterProto")
 		}, cmp.Ignore()),
 		cmpopts.EquateEmpty(),
 	}
@@ -72,6 +74,48 @@ func TestValidNewFilters(t *testing.T) {
 				key: "label" op: IS_SUBSTRING string_value: "label_substring" }`,
 			&Filter{substring: map[string][]interface{}{"label": {"label_substring"}}},
 		},
+		{
+			`predicates { key: "accuracy" op: GREATER_THAN double_value: 0.9 }`,
+			&Filter{gt: map[string][]interface{}{"accuracy": {0.9}}},
+		},
+		{
+			`predicates {
+				key: "label" op: NOT_IN
+				string_values { values: 'label1' values: 'label2' } }`,
+			&Filter{notIn: map[string][]interface{}{"label": {[]string{"label1", "label2"}}}},
+		},
+		{
+			`predicates { key: "label" op: IS_NULL }`,
+			&Filter{isNull: map[string]bool{"label": true}},
+		},
+		{
+			`predicates { key: "label" op: STARTS_WITH string_value: "label_" }`,
+			&Filter{startsWith: map[string][]interface{}{"label": {"label_"}}},
+		},
+		{
+			`predicates { key: "label" op: MATCHES_REGEX string_value: "^label[0-9]+$" }`,
+			&Filter{regex: map[string][]interface{}{"label": {"^label[0-9]+$"}}},
+		},
+		{
+			`predicates { key: "status" op: EQUALS string_value: "Running" }
+			 groups { op: OR
+				predicates { key: "count" op: GREATER_THAN int_value: 10 }
+				groups { op: NOT
+					predicates { key: "label" op: STARTS_WITH string_value: "tmp" } } }`,
+			&Filter{
+				eq: map[string][]interface{}{"status": {"Running"}},
+				groups: []*group{{
+					op: api.FilterGroup_OR,
+					filter: &Filter{
+						gt: map[string][]interface{}{"count": {int32(10)}},
+						groups: []*group{{
+							op:     api.FilterGroup_NOT,
+							filter: &Filter{startsWith: map[string][]interface{}{"label": {"tmp"}}},
+						}},
+					},
+				}},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -83,16 +127,17 @@ func TestValidNewFilters(t *testing.T) {
 
 		got, err := New(filterProto)
 		if !cmp.Equal(got, test.want, opts...) || err != nil {
-			t.Errorf("New(%+v) = %+v, %v\nWant %+v, nil", *filterProto, got, err, test.want)
+			t.Errorf("New(%v) = %+v, %v\nWant %+v, nil", proto.MarshalTextString(filterProto), got, err, test.want)
 		}
 	}
 }
 
 func TestValidNewFiltersWithKeyMap(t *testing.T) {
 	opts := []cmp.Option{
-		cmp.AllowUnexported(Filter{}),
+		cmp.AllowUnexported(Filter{}, group{}),
 		cmp.FilterPath(func(p cmp.Path) bool {
-			return p.String() == "filterProto"
+			// The protos of the nested groups are ignored too.
+			return strings.HasSuffix(p.String(), "filterProto")
 		}, cmp.Ignore()),
 		cmpopts.EquateEmpty(),
 	}
@@ -120,6 +165,21 @@ func TestValidNewFiltersWithKeyMap(t *testing.T) {
 				key: "name" op: IS_SUBSTRING string_value: "pipeline" }`,
 			&Filter{substring: map[string][]interface{}{"pipelines.Name": {"pipeline"}}},
 		},
+		{
+			`groups { op: OR
+				predicates { key: "name" op: STARTS_WITH string_value: "pipeline" }
+				groups { op: NOT predicates { key: "description" op: IS_NULL } } }`,
+			&Filter{groups: []*group{{
+				op: api.FilterGroup_OR,
+				filter: &Filter{
+					startsWith: map[string][]interface{}{"pipelines.Name": {"pipeline"}},
+					groups: []*group{{
+						op:     api.FilterGroup_NOT,
+						filter: &Filter{isNull: map[string]bool{"pipelines.Description": true}},
+					}},
+				},
+			}}},
+		},
 	}
 
 	for _, test := range tests {
@@ -138,11 +198,54 @@ func TestValidNewFiltersWithKeyMap(t *testing.T) {
 		modelName := "pipelines"
 		got, err := NewWithKeyMap(filterProto, keyMap, modelName)
 		if !cmp.Equal(got, test.want, opts...) || err != nil {
-			t.Errorf("New(%+v) = %+v, %v\nWant %+v, nil", *filterProto, got, err, test.want)
+			t.Errorf("New(%v) = %+v, %v\nWant %+v, nil", proto.MarshalTextString(filterProto), got, err, test.want)
 		}
 	}
 }
 
+func TestNewWithKeyFunc(t *testing.T) {
+	opts := []cmp.Option{
+		cmp.AllowUnexported(Filter{}, group{}),
+		cmp.FilterPath(func(p cmp.Path) bool {
+			return strings.HasSuffix(p.String(), "filterProto")
+		}, cmp.Ignore()),
+		cmpopts.EquateEmpty(),
+	}
+	mapKey := func(key string) (string, bool) {
+		if key == "name" {
+			return "Name", true
+		}
+		return key, strings.HasPrefix(key, "metric.")
+	}
+
+	filterProto := &api.Filter{}
+	protoStr := `predicates { key: "name" op: EQUALS string_value: "run" }
+		 groups { op: OR predicates { key: "metric.accuracy" op: GREATER_THAN double_value: 0.9 } }`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	got, err := NewWithKeyFunc(filterProto, mapKey)
+	want := &Filter{
+		eq: map[string][]interface{}{"Name": {"run"}},
+		groups: []*group{{
+			op:     api.FilterGroup_OR,
+			filter: &Filter{gt: map[string][]interface{}{"metric.accuracy": {0.9}}},
+		}},
+	}
+	if !cmp.Equal(got, want, opts...) || err != nil {
+		t.Errorf("NewWithKeyFunc(%v) = %+v, %v\nWant %+v, nil", proto.MarshalTextString(filterProto), got, err, want)
+	}
+
+	filterProto = &api.Filter{}
+	protoStr = `groups { op: OR predicates { key: "unknown" op: EQUALS string_value: "run" } }`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	if got, err := NewWithKeyFunc(filterProto, mapKey); err == nil {
+		t.Errorf("NewWithKeyFunc(%v) = %+v, <nil>\nWant non-nil error", proto.MarshalTextString(filterProto), got)
+	}
+}
+
 func TestInvalidFilters(t *testing.T) {
 	tests := []struct {
 		protoStr string
@@ -205,6 +308,47 @@ func TestInvalidFilters(t *testing.T) {
 			`predicates { key: "total" op: LESS_THAN
 				timestamp_value { seconds: -100000000000 }}`,
 		},
+		{
+			`predicates { key: "total" op: NOT_IN int_value: 10 }`,
+		},
+		{
+			`predicates { key: "total" op: IN double_value: 0.5 }`,
+		},
+		{
+			`predicates { key: "total" op: IS_SUBSTRING double_value: 0.5 }`,
+		},
+		{
+			`predicates { key: "total" op: IS_NULL int_value: 10 }`,
+		},
+		{
+			`predicates { key: "total" op: STARTS_WITH int_value: 10 }`,
+		},
+		{
+			`predicates { key: "label" op: MATCHES_REGEX string_value: "label(" }`,
+		},
+		// Not a POSIX extended regular expression
+		{
+			`predicates { key: "label" op: MATCHES_REGEX string_value: "label\\d" }`,
+		},
+		// Invalid group
+		{
+			`groups { predicates { key: "total" op: EQUALS int_value: 10 } }`,
+		},
+		// Empty group
+		{
+			`groups { op: OR }`,
+		},
+		// Invalid predicate in a nested group
+		{
+			`groups { op: OR groups { op: NOT predicates { key: "total" op: IN int_value: 10 } } }`,
+		},
+		// Groups nested too deep
+		{
+			`groups { op: NOT groups { op: NOT groups { op: NOT groups { op: NOT groups { op: NOT
+			 groups { op: NOT groups { op: NOT groups { op: NOT groups { op: NOT groups { op: NOT
+			 groups { op: NOT predicates { key: "total" op: EQUALS int_value: 10 }
+			 } } } } } } } } } } }`,
+		},
 	}
 
 	for _, test := range tests {
@@ -216,7 +360,7 @@ func TestInvalidFilters(t *testing.T) {
 
 		got, err := New(filterProto)
 		if err == nil {
-			t.Errorf("New(%+v) = %+v, <nil>\nWant non-nil error ", *filterProto, got)
+			t.Errorf("New(%v) = %+v, <nil>\nWant non-nil error ", proto.MarshalTextString(filterProto), got)
 		}
 	}
 }
@@ -287,6 +431,46 @@ func TestAddToSelect(t *testing.T) {
 			"SELECT mycolumn WHERE label LIKE ? AND label LIKE ?",
 			[]interface{}{"%label_substring1%", "%label_substring2%"},
 		},
+		{
+			`predicates { key: "accuracy" op: GREATER_THAN_EQUALS double_value: 0.9 }`,
+			"SELECT mycolumn WHERE accuracy >= ?",
+			[]interface{}{0.9},
+		},
+		{
+			`predicates { key: "total" op: NOT_IN int_values {values: 1 values: 2} }`,
+			"SELECT mycolumn WHERE total NOT IN (?,?)",
+			[]interface{}{int32(1), int32(2)},
+		},
+		{
+			`predicates { key: "label" op: IS_NULL }`,
+			"SELECT mycolumn WHERE label IS NULL",
+			nil,
+		},
+		{
+			`predicates { key: "label" op: STARTS_WITH string_value: "50%_off!" }`,
+			"SELECT mycolumn WHERE label LIKE ? ESCAPE '!'",
+			[]interface{}{"50!%!_off!!%"},
+		},
+		{
+			`predicates { key: "label" op: MATCHES_REGEX string_value: "^l[0-9]$" }`,
+			"SELECT mycolumn WHERE label REGEXP ?",
+			[]interface{}{"^l[0-9]$"},
+		},
+		{
+			`predicates { key: "status" op: EQUALS string_value: "Running" }
+			 groups { op: OR
+				predicates { key: "total" op: GREATER_THAN int_value: 10 }
+				groups { op: NOT predicates { key: "label" op: STARTS_WITH string_value: "tmp" } } }`,
+			"SELECT mycolumn WHERE status = ? AND (total > ? OR NOT ((label LIKE ? ESCAPE '!')))",
+			[]interface{}{"Running", int32(10), "tmp%"},
+		},
+		{
+			`groups { op: AND
+				predicates { key: "status" op: EQUALS string_value: "Running" }
+				predicates { key: "total" op: LESS_THAN int_value: 10 } }`,
+			"SELECT mycolumn WHERE (status = ? AND total < ?)",
+			[]interface{}{"Running", int32(10)},
+		},
 	}
 
 	for _, test := range tests {
@@ -298,18 +482,155 @@ func TestAddToSelect(t *testing.T) {
 
 		filter, err := New(filterProto)
 		if err != nil {
-			t.Errorf("New(%+v) = %+v, %v\nWant nil error", *filterProto, filter, err)
+			t.Errorf("New(%v) = %+v, %v\nWant nil error", proto.MarshalTextString(filterProto), filter, err)
 			continue
 		}
 
 		sb := squirrel.Select("mycolumn")
-		gotSQL, gotArgs, err := filter.AddToSelect(sb).ToSql()
+		gotSQL, gotArgs, err := filter.AddToSelect(sb, testDialect).ToSql()
 		if !cmp.Equal(gotSQL, test.wantSQL) || !cmp.Equal(gotArgs, test.wantArgs) || err != nil {
 			t.Errorf("Filter.AddToSelect(%+v).ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", filter, gotSQL, gotArgs, err, test.wantSQL, test.wantArgs)
 		}
 	}
 }
 
+// fakeDialect builds the conditions that vary in different SQL dialects with
+// the given LIKE and regular expression operators.
+type fakeDialect struct {
+	likeOperator   string
+	regexpOperator string
+}
+
+func (d fakeDialect) Like(expr string) string {
+	return expr + " " + d.likeOperator + " ?"
+}
+
+func (d fakeDialect) Regexp(expr string) string {
+	return expr + " " + d.regexpOperator + " ?"
+}
+
+// testDialect builds the conditions like MySQL.
+var testDialect = fakeDialect{likeOperator: "LIKE", regexpOperator: "REGEXP"}
+
+func TestAddToSelect_Dialect(t *testing.T) {
+	protoStr := `
+		predicates { key: "name" op: IS_SUBSTRING string_value: "Pipe" }
+		predicates { key: "description" op: STARTS_WITH string_value: "a_b" }
+		predicates { key: "label" op: MATCHES_REGEX string_value: "^l[0-9]$" }`
+	filterProto := &api.Filter{}
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	filter, err := New(filterProto)
+	if err != nil {
+		t.Fatalf("New(%v) = %+v, %v\nWant nil error", proto.MarshalTextString(filterProto), filter, err)
+	}
+
+	gotSQL, gotArgs, err := filter.AddToSelect(squirrel.Select("mycolumn"), fakeDialect{likeOperator: "ILIKE", regexpOperator: "~*"}).ToSql()
+	wantSQL := "SELECT mycolumn WHERE name ILIKE ? AND description ILIKE ? ESCAPE '!' AND label ~* ?"
+	wantArgs := []interface{}{"%Pipe%", "a!_b%", "^l[0-9]$"}
+	if gotSQL != wantSQL || !cmp.Equal(gotArgs, wantArgs) || err != nil {
+		t.Errorf("AddToSelect().ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", gotSQL, gotArgs, err, wantSQL, wantArgs)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	fields := map[string]interface{}{
+		"status":     "Running",
+		"created_at": int64(100),
+		"count":      int32(5),
+	}
+	fieldValue := func(key string) interface{} {
+		return fields[key]
+	}
+
+	tests := []struct {
+		protoStr string
+		want     bool
+	}{
+		{`predicates { key: "status" op: EQUALS string_value: "Running" }`, true},
+		{`predicates { key: "status" op: EQUALS string_value: "Stopped" }`, false},
+		{`predicates { key: "status" op: NOT_EQUALS string_value: "Stopped" }`, true},
+		{`predicates { key: "created_at" op: GREATER_THAN long_value: 99 }`, true},
+		{`predicates { key: "created_at" op: GREATER_THAN timestamp_value { seconds: 100 }}`, false},
+		{`predicates { key: "created_at" op: GREATER_THAN_EQUALS timestamp_value { seconds: 100 }}`, true},
+		{`predicates { key: "count" op: LESS_THAN int_value: 6 }`, true},
+		{`predicates { key: "count" op: LESS_THAN_EQUALS long_value: 4 }`, false},
+		{`predicates { key: "count" op: GREATER_THAN double_value: 4.5 }`, true},
+		{`predicates { key: "status" op: IN string_values { values: 'Failed' values: 'Running' } }`, true},
+		{`predicates { key: "count" op: IN int_values { values: 1 values: 2 } }`, false},
+		{`predicates { key: "status" op: IS_SUBSTRING string_value: "unn" }`, true},
+		{`predicates { key: "status" op: IS_SUBSTRING string_value: "top" }`, false},
+		{`predicates { key: "status" op: IS_SUBSTRING string_value: "UNN" }`, true},
+		{`predicates { key: "status" op: NOT_IN string_values { values: 'Failed' values: 'Running' } }`, false},
+		{`predicates { key: "count" op: NOT_IN int_values { values: 1 values: 2 } }`, true},
+		{`predicates { key: "missing" op: IS_NULL }`, true},
+		{`predicates { key: "status" op: IS_NULL }`, false},
+		{`predicates { key: "status" op: STARTS_WITH string_value: "Run" }`, true},
+		{`predicates { key: "status" op: STARTS_WITH string_value: "unn" }`, false},
+		{`predicates { key: "status" op: STARTS_WITH string_value: "run" }`, true},
+		{`predicates { key: "status" op: MATCHES_REGEX string_value: "^R.*g$" }`, true},
+		{`predicates { key: "status" op: MATCHES_REGEX string_value: "^S" }`, false},
+		{`predicates { key: "status" op: MATCHES_REGEX string_value: "^run" }`, true},
+		{
+			`groups { op: OR
+				predicates { key: "status" op: EQUALS string_value: "Stopped" }
+				predicates { key: "count" op: LESS_THAN int_value: 10 } }`,
+			true,
+		},
+		{
+			`groups { op: OR
+				predicates { key: "status" op: EQUALS string_value: "Stopped" }
+				predicates { key: "count" op: GREATER_THAN int_value: 10 } }`,
+			false,
+		},
+		{`groups { op: NOT predicates { key: "status" op: EQUALS string_value: "Running" } }`, false},
+		{
+			`predicates { key: "status" op: EQUALS string_value: "Running" }
+			 groups { op: NOT
+				predicates { key: "status" op: EQUALS string_value: "Running" }
+				predicates { key: "count" op: GREATER_THAN int_value: 10 } }`,
+			true,
+		},
+		{
+			`groups { op: AND
+				predicates { key: "status" op: EQUALS string_value: "Running" }
+				groups { op: OR
+					predicates { key: "missing" op: EQUALS string_value: "x" }
+					groups { op: NOT predicates { key: "created_at" op: LESS_THAN long_value: 50 } } } }`,
+			true,
+		},
+		{
+			`predicates { key: "status" op: EQUALS string_value: "Running" }
+			 predicates { key: "count" op: GREATER_THAN int_value: 10 }`,
+			false,
+		},
+		// Like NULL in SQL, a missing field doesn't match any predicate.
+		{`predicates { key: "missing" op: NOT_EQUALS string_value: "Running" }`, false},
+		// Values of different types don't match.
+		{`predicates { key: "status" op: EQUALS int_value: 1 }`, false},
+		{``, true},
+	}
+
+	for _, test := range tests {
+		filterProto := &api.Filter{}
+		if err := proto.UnmarshalText(test.protoStr, filterProto); err != nil {
+			t.Errorf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.protoStr, err)
+			continue
+		}
+
+		filter, err := New(filterProto)
+		if err != nil {
+			t.Errorf("New(%v) = %+v, %v\nWant nil error", proto.MarshalTextString(filterProto), filter, err)
+			continue
+		}
+
+		if got := filter.Matches(fieldValue); got != test.want {
+			t.Errorf("Filter.Matches() for %q = %v, want %v", test.protoStr, got, test.want)
+		}
+	}
+}
+
 func TestMarshalJSON(t *testing.T) {
 	f := &Filter{
 		filterProto: &api.Filter{
@@ -352,3 +673,69 @@ func TestUnmarshalJSON(t *testing.T) {
 		t.Errorf("json.Unmarshal(%+v):\nGot: %v, Error: %v\nWant:\n%+v, Error: nil\nDiff:%s\n", in, got, err, want, cmp.Diff(want, got, cmp.AllowUnexported(Filter{})))
 	}
 }
+
+func TestJSONRoundTripWithGroups(t *testing.T) {
+	protoStr := `predicates { key: "status" op: STARTS_WITH string_value: "Run" }
+		 groups { op: OR
+			predicates { key: "label" op: IS_NULL }
+			groups { op: NOT predicates { key: "label" op: MATCHES_REGEX string_value: "^tmp" } } }`
+	filterProto := &api.Filter{}
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	want, err := New(filterProto)
+	if err != nil {
+		t.Fatalf("New(%v) = %+v, %v\nWant nil error", proto.MarshalTextString(filterProto), want, err)
+	}
+
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) = %v\nWant nil error", want, err)
+	}
+	got := &Filter{}
+	err = json.Unmarshal(b, got)
+	if err != nil || !cmp.Equal(got, want, cmpopts.EquateEmpty(), protocmp.Transform(), cmp.AllowUnexported(Filter{}, group{})) {
+		t.Errorf("json.Unmarshal(%s):\nGot: %v, Error: %v\nWant:\n%+v, Error: nil\nDiff:%s\n", b, got, err, want,
+			cmp.Diff(want, got, cmpopts.EquateEmpty(), protocmp.Transform(), cmp.AllowUnexported(Filter{}, group{})))
+	}
+}
+
+func TestReplaceKeys(t *testing.T) {
+	protoStr := `predicates { key: "metric.accuracy" op: GREATER_THAN double_value: 0.9 }
+		 predicates { key: "status" op: EQUALS string_value: "Succeeded" }
+		 groups { op: OR
+			predicates { key: "parameter.rate" op: IS_NULL }
+			predicates { key: "parameter.rate" op: IN string_values { values: "0.1" values: "0.2" } } }`
+	filterProto := &api.Filter{}
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	filter, err := New(filterProto)
+	if err != nil {
+		t.Fatalf("New(%v) = %+v, %v\nWant nil error", proto.MarshalTextString(filterProto), filter, err)
+	}
+
+	replaced := filter.ReplaceKeys(func(key string) string {
+		if strings.Contains(key, ".") {
+			return "value_of(" + key + ")"
+		}
+		return key
+	})
+
+	gotSQL, gotArgs, err := replaced.AddToSelect(squirrel.Select("mycolumn"), testDialect).ToSql()
+	wantSQL := "SELECT mycolumn WHERE status = ? AND value_of(metric.accuracy) > ? AND (value_of(parameter.rate) IN (?,?) OR value_of(parameter.rate) IS NULL)"
+	wantArgs := []interface{}{"Succeeded", 0.9, "0.1", "0.2"}
+	if gotSQL != wantSQL || !cmp.Equal(gotArgs, wantArgs) || err != nil {
+		t.Errorf("ReplaceKeys().AddToSelect().ToSql() =\nGot: %+v, %v, %v\nWant: %+v, %+v, <nil>", gotSQL, gotArgs, err, wantSQL, wantArgs)
+	}
+
+	// The original filter and the page tokens keep the original keys.
+	gotSQL, _, err = filter.AddToSelect(squirrel.Select("mycolumn"), testDialect).ToSql()
+	wantSQL = "SELECT mycolumn WHERE status = ? AND metric.accuracy > ? AND (parameter.rate IN (?,?) OR parameter.rate IS NULL)"
+	if gotSQL != wantSQL || err != nil {
+		t.Errorf("AddToSelect().ToSql() =\nGot: %+v, %v\nWant: %+v, <nil>", gotSQL, err, wantSQL)
+	}
+	if !replaced.Equivalent(filter) {
+		t.Errorf("ReplaceKeys() = %+v, want a filter equivalent to %+v", replaced, filter)
+	}
+}
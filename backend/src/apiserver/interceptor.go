@@ -18,16 +18,35 @@ import (
 	"context"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"google.golang.org/grpc"
 )
 
-// apiServerInterceptor implements UnaryServerInterceptor that provides the common wrapping logic
-// to be executed before and after all API handler calls, e.g. Logging, error handling.
+// apiServerInterceptor returns a UnaryServerInterceptor that provides the common wrapping logic
+// to be executed before and after all API handler calls, e.g. Logging, auditing, error handling.
 // For more details, see https://github.com/grpc/grpc-go/blob/master/interceptor.go
-func apiServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+func apiServerInterceptor(resourceManager *resource.ResourceManager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		glog.Infof("%v handler starting", info.FullMethod)
+		resp, err = server.AuditUnaryCall(resourceManager, ctx, req, info.FullMethod, handler)
+		if err != nil {
+			util.LogError(util.Wrapf(err, "%s call failed", info.FullMethod))
+			// Convert error to gRPC errors
+			err = util.ToGRPCError(err)
+			return
+		}
+		glog.Infof("%v handler finished", info.FullMethod)
+		return
+	}
+}
+
+// apiServerStreamInterceptor implements StreamServerInterceptor with the same
+// wrapping logic as apiServerInterceptor, for the server streaming API handlers.
+func apiServerStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
 	glog.Infof("%v handler starting", info.FullMethod)
-	resp, err = handler(ctx, req)
+	err = handler(srv, ss)
 	if err != nil {
 		util.LogError(util.Wrapf(err, "%s call failed", info.FullMethod))
 		// Convert error to gRPC errors
@@ -103,7 +103,7 @@ type Options struct {
 func (o *Options) Matches(opts *Options) bool {
 	return o.SortByFieldName == opts.SortByFieldName && o.SortByFieldPrefix == opts.SortByFieldPrefix &&
 		o.IsDesc == opts.IsDesc &&
-		reflect.DeepEqual(o.Filter, opts.Filter)
+		o.Filter.Equivalent(opts.Filter)
 }
 
 // NewOptionsFromToken creates a new Options struct from the passed in token
@@ -164,7 +164,12 @@ func NewOptions(listable Listable, pageSize int, sortBy string, filterProto *api
 
 	// Filtering.
 	if filterProto != nil {
-		f, err := filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		var f *filter.Filter
+		if l, ok := listable.(FilterKeyMapper); ok {
+			f, err = filter.NewWithKeyFunc(filterProto, l.GetFilterField)
+		} else {
+			f, err = filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -216,10 +221,10 @@ func (o *Options) AddSortingToSelect(sqlBuilder sq.SelectBuilder) sq.SelectBuild
 
 // AddFilterToSelect adds WHERE clauses with the filtering criteria in the
 // Options o to the supplied SelectBuilder, and returns the new SelectBuilder
-// containing these.
-func (o *Options) AddFilterToSelect(sqlBuilder sq.SelectBuilder) sq.SelectBuilder {
+// containing these. The criteria are built for the SQL dialect of the query.
+func (o *Options) AddFilterToSelect(sqlBuilder sq.SelectBuilder, dialect filter.Dialect) sq.SelectBuilder {
 	if o.Filter != nil {
-		sqlBuilder = o.Filter.AddToSelect(sqlBuilder)
+		sqlBuilder = o.Filter.AddToSelect(sqlBuilder, dialect)
 	}
 
 	return sqlBuilder
@@ -321,6 +326,14 @@ type Listable interface {
 	GetFieldValue(name string) interface{}
 }
 
+// FilterKeyMapper can be implemented by the listables that can be filtered on
+// keys that are not in APIToModelFieldMap, like the metrics of the runs.
+type FilterKeyMapper interface {
+	// GetFilterField returns the field to filter on for the given key, or
+	// false if the listable cannot be filtered on the key.
+	GetFilterField(key string) (string, bool)
+}
+
 // NextPageToken returns a string that can be used to fetch the subsequent set
 // of results using the same listing options in o, starting with listable as the
 // first record.
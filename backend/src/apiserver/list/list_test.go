@@ -19,6 +19,19 @@ import (
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 )
 
+// fakeDialect builds the filter conditions like MySQL.
+type fakeDialect struct{}
+
+func (d fakeDialect) Like(expr string) string {
+	return expr + " LIKE ?"
+}
+
+func (d fakeDialect) Regexp(expr string) string {
+	return expr + " REGEXP ?"
+}
+
+var testDialect = fakeDialect{}
+
 type fakeMetric struct {
 	Name  string
 	Value float64
@@ -616,7 +629,7 @@ func TestAddPaginationAndFilterToSelect(t *testing.T) {
 
 	for _, test := range tests {
 		sql := sq.Select("*").From("MyTable")
-		gotSQL, gotArgs, err := test.in.AddFilterToSelect(test.in.AddPaginationToSelect(sql)).ToSql()
+		gotSQL, gotArgs, err := test.in.AddFilterToSelect(test.in.AddPaginationToSelect(sql), testDialect).ToSql()
 
 		if gotSQL != test.wantSQL || !reflect.DeepEqual(gotArgs, test.wantArgs) || err != nil {
 			t.Errorf("BuildListSQLQuery(%+v) =\nGot: %q, %v, %v\nWant: %q, %v, nil",
@@ -792,6 +805,63 @@ func TestMatches(t *testing.T) {
 	}
 }
 
+func TestNewOptionsFromToken_MatchesFilterWithGroups(t *testing.T) {
+	newProtoFilter := func() *api.Filter {
+		return &api.Filter{
+			Predicates: []*api.Predicate{
+				&api.Predicate{
+					Key:   "name",
+					Op:    api.Predicate_STARTS_WITH,
+					Value: &api.Predicate_StringValue{StringValue: "Some"},
+				},
+			},
+			Groups: []*api.FilterGroup{
+				&api.FilterGroup{
+					Op: api.FilterGroup_OR,
+					Predicates: []*api.Predicate{
+						&api.Predicate{Key: "name", Op: api.Predicate_IS_NULL},
+						&api.Predicate{
+							Key:   "id",
+							Op:    api.Predicate_MATCHES_REGEX,
+							Value: &api.Predicate_StringValue{StringValue: "^uuid"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	opts, err := NewOptions(&fakeListable{}, 1, "name", newProtoFilter())
+	if err != nil {
+		t.Fatalf("NewOptions() = _, %v\nWant nil error", err)
+	}
+	l := &fakeListable{PrimaryKey: "uuid123", FakeName: "SomeName", CreatedTimestamp: 1234}
+	nextPageToken, err := opts.NextPageToken(l)
+	if err != nil {
+		t.Fatalf("NextPageToken(%+v) = _, %v\nWant nil error", l, err)
+	}
+
+	got, err := NewOptionsFromToken(nextPageToken, 1)
+	if err != nil {
+		t.Fatalf("NewOptionsFromToken(%q) = _, %v\nWant nil error", nextPageToken, err)
+	}
+	// The options of the same request, e.g. of the next page, match the token.
+	want, err := NewOptions(&fakeListable{}, 1, "name", newProtoFilter())
+	if err != nil {
+		t.Fatalf("NewOptions() = _, %v\nWant nil error", err)
+	}
+	if !got.Matches(want) {
+		t.Errorf("NewOptionsFromToken(%q).Matches(%+v) = false, Want true", nextPageToken, want)
+	}
+
+	sql, args, err := got.AddFilterToSelect(sq.Select("*").From("MyTable"), testDialect).ToSql()
+	wantSQL := "SELECT * FROM MyTable WHERE FakeName LIKE ? ESCAPE '!' AND (PrimaryKey REGEXP ? OR FakeName IS NULL)"
+	wantArgs := []interface{}{"Some%", "^uuid"}
+	if sql != wantSQL || !cmp.Equal(args, wantArgs) || err != nil {
+		t.Errorf("AddFilterToSelect().ToSql() = %q, %v, %v\nWant %q, %v, nil", sql, args, err, wantSQL, wantArgs)
+	}
+}
+
 func TestFilterOnResourceReference(t *testing.T) {
 
 	type testIn struct {
@@ -986,7 +1056,7 @@ func TestAddStatusFilterToSelectWithRunModel(t *testing.T) {
 	listableOptions, err := NewOptions(listable, 10, "name", protoFilter)
 	assert.Nil(t, err)
 	sqlBuilder := sq.Select("*").From("run_details")
-	sql, args, err := listableOptions.AddFilterToSelect(sqlBuilder).ToSql()
+	sql, args, err := listableOptions.AddFilterToSelect(sqlBuilder, testDialect).ToSql()
 	assert.Nil(t, err)
 	assert.Contains(t, sql, "WHERE Conditions = ?") // filtering on status, aka Conditions in db
 	assert.Contains(t, args, "Succeeded")
@@ -1002,8 +1072,45 @@ func TestAddStatusFilterToSelectWithRunModel(t *testing.T) {
 	listableOptions, err = NewOptions(listable, 10, "name", notEqualProtoFilter)
 	assert.Nil(t, err)
 	sqlBuilder = sq.Select("*").From("run_details")
-	sql, args, err = listableOptions.AddFilterToSelect(sqlBuilder).ToSql()
+	sql, args, err = listableOptions.AddFilterToSelect(sqlBuilder, testDialect).ToSql()
 	assert.Nil(t, err)
 	assert.Contains(t, sql, "WHERE Conditions <> ?") // filtering on status, aka Conditions in db
 	assert.Contains(t, args, "somevalue")
 }
+
+func TestNewOptionsWithRunModel_MetricAndParameterKeys(t *testing.T) {
+	protoFilter := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "metric.accuracy",
+				Op:    api.Predicate_GREATER_THAN,
+				Value: &api.Predicate_DoubleValue{DoubleValue: 0.9},
+			},
+			{
+				Key:   "parameter.learning_rate",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "0.01"},
+			},
+		},
+	}
+	listableOptions, err := NewOptions(&model.Run{}, 10, "name", protoFilter)
+	assert.Nil(t, err)
+
+	// The keys are kept as is, the run store replaces them with the queries
+	// of the metrics and parameters.
+	sql, args, err := listableOptions.AddFilterToSelect(sq.Select("*").From("run_details"), testDialect).ToSql()
+	assert.Nil(t, err)
+	assert.Contains(t, sql, "metric.accuracy > ?")
+	assert.Contains(t, sql, "parameter.learning_rate = ?")
+	assert.ElementsMatch(t, []interface{}{0.9, "0.01"}, args)
+
+	for _, key := range []string{"metric.", "metric.acc'uracy", "parameter.a b", "unknown"} {
+		protoFilter := &api.Filter{
+			Predicates: []*api.Predicate{
+				{Key: key, Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "a"}},
+			},
+		}
+		_, err := NewOptions(&model.Run{}, 10, "name", protoFilter)
+		assert.NotNil(t, err, key)
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/migration"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
 	"github.com/spf13/viper"
@@ -51,6 +52,10 @@ var (
 	sampleConfigPath = flag.String("sampleconfig", "", "Path to samples")
 
 	collectMetricsFlag = flag.Bool("collectMetricsFlag", true, "Whether to collect Prometheus metrics in API server.")
+
+	migrateOnlyFlag   = flag.Bool("migrate-only", false, "Migrate the database and exit without starting the API server.")
+	migrateDownToFlag = flag.String("migrate-down-to", "", "Revert the database migrations applied after the given migration ID, or all of them for \""+migration.BaseVersion+"\", and exit.")
+	dryRunFlag        = flag.Bool("dry-run", false, "Print the database migrations that --migrate-only or --migrate-down-to would run, without running them, and exit.")
 )
 
 type RegisterHttpHandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
@@ -59,6 +64,10 @@ func main() {
 	flag.Parse()
 
 	initConfig()
+	if *migrateOnlyFlag || *migrateDownToFlag != "" || *dryRunFlag {
+		migrateDB()
+		return
+	}
 	clientManager := newClientManager()
 	resourceManager := resource.NewResourceManager(&clientManager)
 	err := loadSamples(resourceManager)
@@ -77,6 +86,37 @@ func main() {
 	clientManager.Close()
 }
 
+// migrateDB applies or reverts the database migrations as requested by the
+// migration flags.
+func migrateDB() {
+	db, _, driverName := openDBClient(common.GetDurationConfig(initConnectionTimeout), *dryRunFlag)
+	defer db.Close()
+	migrator := newMigratorOrFatal(db, driverName)
+
+	var migrations []*migration.Migration
+	var err error
+	action := "Applied"
+	if *migrateDownToFlag != "" {
+		action = "Reverted"
+		migrations, err = migrator.DownTo(*migrateDownToFlag, *dryRunFlag)
+	} else {
+		migrations, err = migrator.Up(*dryRunFlag)
+	}
+	if err != nil {
+		glog.Fatalf("Failed to migrate the database. Error: %v", err)
+	}
+	if *dryRunFlag {
+		action = "Dry run: would have " + strings.ToLower(action)
+	}
+	if len(migrations) == 0 {
+		glog.Infof("%v no migration.", action)
+	}
+	for _, m := range migrations {
+		glog.Infof("%v migration %v: %v", action, m.ID, m.Description)
+	}
+	glog.Flush()
+}
+
 // A custom http request header matcher to pass on the user identity
 // Reference: https://github.com/grpc-ecosystem/grpc-gateway/blob/master/docs/_docs/customizingyourgateway.md#mapping-from-http-request-headers-to-grpc-client-metadata
 func grpcCustomMatcher(key string) (string, bool) {
@@ -92,7 +132,10 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	if err != nil {
 		glog.Fatalf("Failed to start RPC server: %v", err)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(apiServerInterceptor(resourceManager)),
+		grpc.StreamInterceptor(apiServerStreamInterceptor),
+		grpc.MaxRecvMsgSize(math.MaxInt32))
 	api.RegisterPipelineServiceServer(s, server.NewPipelineServer(resourceManager, &server.PipelineServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterExperimentServiceServer(s, server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterRunServiceServer(s, server.NewRunServer(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag}))
@@ -107,6 +150,7 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 			common.GetStringConfig(visualizationServicePort),
 		))
 	api.RegisterAuthServiceServer(s, server.NewAuthServer(resourceManager))
+	api.RegisterAuditServiceServer(s, server.NewAuditServer(resourceManager))
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
@@ -124,7 +168,11 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	defer cancel()
 
 	// Create gRPC HTTP MUX and register services.
-	runtimeMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(grpcCustomMatcher))
+	runtimeMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(grpcCustomMatcher),
+		// Streaming APIs such as WatchRuns send server-sent events to the clients
+		// that accept them.
+		runtime.WithMarshalerOption(server.EventStreamContentType, server.NewEventStreamMarshaler()))
 	registerHttpHandlerFromEndpoint(api.RegisterPipelineServiceHandlerFromEndpoint, "PipelineService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterExperimentServiceHandlerFromEndpoint, "ExperimentService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterJobServiceHandlerFromEndpoint, "JobService", ctx, runtimeMux)
@@ -133,6 +181,7 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	registerHttpHandlerFromEndpoint(api.RegisterReportServiceHandlerFromEndpoint, "ReportService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterVisualizationServiceHandlerFromEndpoint, "Visualization", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterAuthServiceHandlerFromEndpoint, "AuthService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterAuditServiceHandlerFromEndpoint, "AuditService", ctx, runtimeMux)
 
 	// Create a top level mux to include both pipeline upload server and gRPC servers.
 	topMux := mux.NewRouter()
@@ -206,7 +255,7 @@ func loadSamples(resourceManager *resource.ResourceManager) error {
 		if configErr != nil {
 			return fmt.Errorf("Failed to decompress the file %s. Error: %v", config.Name, configErr)
 		}
-		_, configErr = resourceManager.CreatePipeline(config.Name, config.Description, "", pipelineFile)
+		_, configErr = resourceManager.CreatePipeline(config.Name, config.Description, "", "", pipelineFile)
 		if configErr != nil {
 			// Log the error but not fail. The API Server pod can restart and it could potentially cause name collision.
 			// In the future, we might consider loading samples during deployment, instead of when API server starts.
@@ -0,0 +1,514 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
+	"github.com/pkg/errors"
+)
+
+// Migrations returns the migrations of the API server database. Append new
+// migrations at the end, with the next sequence number, and never change a
+// migration that has been released.
+//
+// The first migrations reproduce the schema setup the API server used to run
+// on every start. A database created before migrations were introduced has
+// none of them recorded, so they are all written to be no-ops on such a
+// database when it is already up to date.
+func Migrations() []*Migration {
+	return []*Migration{
+		{
+			ID:          "0001_initial_schema",
+			Description: "Create the tables, indexes and foreign keys of the API server",
+			Up:          initialSchemaUp,
+			Down:        initialSchemaDown,
+		},
+		{
+			ID:          "0002_backfill_pipeline_versions",
+			Description: "Create a default version for the pipelines created before pipeline versions were introduced",
+			Up:          backfillPipelineVersionsUp,
+			Down:        noop,
+		},
+		{
+			ID:          "0003_backfill_run_experiment_uuid",
+			Description: "Fill in the ExperimentUUID column of the runs from their resource references",
+			Up:          backfillExperimentIDToRunTableUp,
+			Down:        noop,
+		},
+		{
+			ID:          "0004_add_job_cron_schedule_time_zone",
+			Description: "Add the CronScheduleTimeZone column to the jobs",
+			Up:          addJobCronScheduleTimeZoneUp,
+			Down:        addJobCronScheduleTimeZoneDown,
+		},
+		{
+			ID:          "0005_add_job_concurrency_policy",
+			Description: "Add the ConcurrencyPolicy column to the jobs",
+			Up:          addJobConcurrencyPolicyUp,
+			Down:        addJobConcurrencyPolicyDown,
+		},
+		{
+			ID:          "0006_add_job_bucket_object_trigger",
+			Description: "Add the bucket object trigger columns to the jobs",
+			Up:          addJobBucketObjectTriggerUp,
+			Down:        addJobBucketObjectTriggerDown,
+		},
+		{
+			ID:          "0007_add_job_run_dependency_trigger",
+			Description: "Add the run dependency trigger columns to the jobs",
+			Up:          addJobRunDependencyTriggerUp,
+			Down:        addJobRunDependencyTriggerDown,
+		},
+		{
+			ID:          "0008_add_resource_reference_uuid_to_primary_key",
+			Description: "Add the ReferenceUUID column to the primary key of the resource references, so that a resource can reference several pipeline versions",
+			Up:          addResourceReferenceUUIDToPrimaryKeyUp,
+			Down:        addResourceReferenceUUIDToPrimaryKeyDown,
+		},
+		{
+			ID:          "0009_create_audit_events",
+			Description: "Create the audit_events table of the audit log",
+			Up:          createAuditEventsUp,
+			Down:        createAuditEventsDown,
+		},
+		{
+			ID:          "0010_create_run_events",
+			Description: "Create the run_events table, which the API server replicas poll to send the run changes to their watchers",
+			Up:          createRunEventsUp,
+			Down:        createRunEventsDown,
+		},
+		{
+			ID:          "0011_add_pipeline_owner",
+			Description: "Add the Owner column to the pipelines",
+			Up:          addPipelineOwnerUp,
+			Down:        addPipelineOwnerDown,
+		},
+	}
+}
+
+// noop is the Down step of data backfills whose result is valid for the
+// previous schema as well.
+func noop(db *gorm.DB, driverName string) error {
+	return nil
+}
+
+// initialSchemaTables are the tables of the initial schema, in an order that
+// drops referencing tables before the tables they reference.
+var initialSchemaTables = []interface{}{
+	&initialTask{},
+	&initialRunMetric{},
+	&initialRunDetail{},
+	&initialResourceReference{},
+	&initialPipelineVersion{},
+	&initialPipeline{},
+	&initialJob{},
+	&initialExperiment{},
+	&initialDBStatus{},
+	&initialDefaultExperiment{},
+}
+
+func initialSchemaUp(db *gorm.DB, driverName string) error {
+	response := db.AutoMigrate(
+		&initialExperiment{},
+		&initialJob{},
+		&initialPipeline{},
+		&initialPipelineVersion{},
+		&initialResourceReference{},
+		&initialRunDetail{},
+		&initialRunMetric{},
+		&initialTask{},
+		&initialDBStatus{},
+		&initialDefaultExperiment{})
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create the tables")
+	}
+
+	// Older versions had unique keys on the experiment and pipeline names.
+	response = db.Model(&initialExperiment{}).RemoveIndex("Name")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop unique key on experiment name")
+	}
+	response = db.Model(&initialPipeline{}).RemoveIndex("Name")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop unique key on pipeline name")
+	}
+	// If the old unique index idx_pipeline_version_uuid_name on pipeline_versions exists, remove it.
+	if db.Dialect().HasIndex("pipeline_versions", "idx_pipeline_version_uuid_name") {
+		response = db.Model(&initialPipelineVersion{}).RemoveIndex("idx_pipeline_version_uuid_name")
+		if response.Error != nil {
+			return errors.Wrap(response.Error, "Failed to drop index idx_pipeline_version_uuid_name on pipeline_versions")
+		}
+	}
+
+	// In postgres, these columns are already created as text, which has no
+	// length limit.
+	if driverName == "mysql" {
+		response = db.Model(&initialResourceReference{}).ModifyColumn("Payload", "longtext not null")
+		if response.Error != nil {
+			return errors.Wrap(response.Error, "Failed to update the resource reference payload type")
+		}
+		response = db.Model(&initialPipeline{}).ModifyColumn("Description", "longtext not null")
+		if response.Error != nil {
+			return errors.Wrap(response.Error, "Failed to update pipeline description type")
+		}
+	}
+
+	response = db.Model(&initialRunDetail{}).AddIndex("experimentuuid_createatinsec", "ExperimentUUID", "CreatedAtInSec")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index experimentuuid_createatinsec on run_details")
+	}
+	response = db.Model(&initialRunDetail{}).AddIndex("experimentuuid_conditions_finishedatinsec", "ExperimentUUID", "Conditions", "FinishedAtInSec")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index experimentuuid_conditions_finishedatinsec on run_details")
+	}
+	response = db.Model(&initialPipeline{}).AddUniqueIndex("name_namespace_index", "Name", "Namespace")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index name_namespace_index on pipelines")
+	}
+
+	response = db.Model(&initialRunMetric{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create a foreign key for RunID in run_metrics table")
+	}
+	response = db.Model(&initialPipelineVersion{}).
+		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create a foreign key for PipelineId in pipeline_versions table")
+	}
+	response = db.Model(&initialTask{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create a foreign key for RunUUID in task table")
+	}
+	return nil
+}
+
+func initialSchemaDown(db *gorm.DB, driverName string) error {
+	if response := db.DropTableIfExists(initialSchemaTables...); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the tables")
+	}
+	return nil
+}
+
+// backfillPipelineVersionsUp creates a version for each pipeline created before
+// the pipeline_versions table was introduced. Those pipelines were created when
+// the table was empty, which is what identifies such a database.
+func backfillPipelineVersionsUp(db *gorm.DB, driverName string) error {
+	var versionCount int
+	if err := db.Table("pipeline_versions").Count(&versionCount).Error; err != nil {
+		return errors.Wrap(err, "Failed to count the pipeline versions")
+	}
+	if versionCount > 0 {
+		return nil
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errors.Wrap(tx.Error, "Failed to start a transaction to backfill the pipeline versions")
+	}
+
+	// Step 1: duplicate pipelines to pipeline versions.
+	// The pipeline versions created here are not through KFP pipeine version
+	// API, and are only for the legacy pipelines that are created
+	// before pipeline version API is introduced.
+	// For those legacy pipelines, who don't have versions before, we create one
+	// implicit version for each of them. Given a legacy pipeline, the implicit
+	// version created here is assigned an ID the same as the pipeline ID. This
+	// way we don't need to move the minio file of pipeline package around,
+	// since the minio file's path is based on the pipeline ID (and now on the
+	// implicit version ID too). Meanwhile, IDs are required to be unique inside
+	// the same resource type, so pipeline and pipeline version as two different
+	// resources useing the same ID is OK.
+	// On the other hand, pipeline and its pipeline versions created after
+	// pipeline version API is introduced will have different Ids; and the minio
+	// file will be put directly into the directories for pipeline versions.
+	if err := tx.Exec(`INSERT INTO
+	pipeline_versions (UUID, Name, CreatedAtInSec, Parameters, Status, PipelineId)
+	SELECT UUID, Name, CreatedAtInSec, Parameters, Status, UUID FROM pipelines;`).Error; err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, "Failed to create the pipeline versions")
+	}
+
+	// Step 2: modifiy pipelines table after pipeline_versions are populated.
+	if err := tx.Exec("update pipelines set DefaultVersionId=UUID;").Error; err != nil {
+		tx.Rollback()
+		return errors.Wrap(err, "Failed to set the default pipeline versions")
+	}
+
+	return tx.Commit().Error
+}
+
+func backfillExperimentIDToRunTableUp(db *gorm.DB, driverName string) (retError error) {
+	// check if there is any row in the run table has experiment ID being empty
+	rows, err := db.CommonDB().Query(`SELECT ExperimentUUID FROM run_details WHERE ExperimentUUID = '' LIMIT 1`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// no row in run_details table has empty ExperimentUUID
+	if !rows.Next() {
+		return nil
+	}
+
+	if driverName == postgres.DriverName {
+		// Postgres doesn't support updating multiple tables in one statement.
+		_, err = db.CommonDB().Exec(`
+		UPDATE
+			run_details
+		SET
+			ExperimentUUID = resource_references.ReferenceUUID
+		FROM
+			resource_references
+		WHERE
+			run_details.UUID = resource_references.ResourceUUID
+			AND resource_references.ResourceType = 'Run'
+			AND resource_references.ReferenceType = 'Experiment'
+			AND run_details.ExperimentUUID = ''
+	`)
+		return err
+	}
+
+	_, err = db.CommonDB().Exec(`
+		UPDATE
+			run_details, resource_references
+		SET
+			run_details.ExperimentUUID = resource_references.ReferenceUUID
+		WHERE
+			run_details.UUID = resource_references.ResourceUUID
+			AND resource_references.ResourceType = 'Run'
+			AND resource_references.ReferenceType = 'Experiment'
+			AND run_details.ExperimentUUID = ''
+	`)
+	return err
+}
+
+func addJobCronScheduleTimeZoneUp(db *gorm.DB, driverName string) error {
+	if db.Dialect().HasColumn("jobs", "CronScheduleTimeZone") {
+		return nil
+	}
+	dialect := db.Dialect()
+	response := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s varchar(255)",
+		dialect.Quote("jobs"), dialect.Quote("CronScheduleTimeZone")))
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to add the CronScheduleTimeZone column to jobs")
+	}
+	return nil
+}
+
+func addJobCronScheduleTimeZoneDown(db *gorm.DB, driverName string) error {
+	if response := db.Table("jobs").DropColumn("CronScheduleTimeZone"); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the CronScheduleTimeZone column of jobs")
+	}
+	return nil
+}
+
+func addJobConcurrencyPolicyUp(db *gorm.DB, driverName string) error {
+	if db.Dialect().HasColumn("jobs", "ConcurrencyPolicy") {
+		return nil
+	}
+	dialect := db.Dialect()
+	response := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s varchar(255) NOT NULL DEFAULT ''",
+		dialect.Quote("jobs"), dialect.Quote("ConcurrencyPolicy")))
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to add the ConcurrencyPolicy column to jobs")
+	}
+	return nil
+}
+
+func addJobConcurrencyPolicyDown(db *gorm.DB, driverName string) error {
+	if response := db.Table("jobs").DropColumn("ConcurrencyPolicy"); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the ConcurrencyPolicy column of jobs")
+	}
+	return nil
+}
+
+var jobBucketObjectTriggerColumns = []string{
+	"BucketObjectTriggerBucket", "BucketObjectTriggerPattern", "BucketObjectTriggerParameterName",
+}
+
+func addJobBucketObjectTriggerUp(db *gorm.DB, driverName string) error {
+	dialect := db.Dialect()
+	for _, column := range jobBucketObjectTriggerColumns {
+		if dialect.HasColumn("jobs", column) {
+			continue
+		}
+		response := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s varchar(255)",
+			dialect.Quote("jobs"), dialect.Quote(column)))
+		if response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to add the %s column to jobs", column)
+		}
+	}
+	return nil
+}
+
+func addJobBucketObjectTriggerDown(db *gorm.DB, driverName string) error {
+	for _, column := range jobBucketObjectTriggerColumns {
+		if response := db.Table("jobs").DropColumn(column); response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to drop the %s column of jobs", column)
+		}
+	}
+	return nil
+}
+
+var jobRunDependencyTriggerColumns = []string{
+	"RunDependencyTriggerUpstreamType", "RunDependencyTriggerUpstreamId", "RunDependencyTriggerParameterName",
+}
+
+func addJobRunDependencyTriggerUp(db *gorm.DB, driverName string) error {
+	dialect := db.Dialect()
+	for _, column := range jobRunDependencyTriggerColumns {
+		if dialect.HasColumn("jobs", column) {
+			continue
+		}
+		response := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s varchar(255)",
+			dialect.Quote("jobs"), dialect.Quote(column)))
+		if response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to add the %s column to jobs", column)
+		}
+	}
+	return nil
+}
+
+func addJobRunDependencyTriggerDown(db *gorm.DB, driverName string) error {
+	for _, column := range jobRunDependencyTriggerColumns {
+		if response := db.Table("jobs").DropColumn(column); response.Error != nil {
+			return errors.Wrapf(response.Error, "Failed to drop the %s column of jobs", column)
+		}
+	}
+	return nil
+}
+
+// setResourceReferencePrimaryKey replaces the primary key of the resource
+// references. SQLite cannot change the primary key of a table, it is only used
+// in tests, which create the tables of the storage from the models.
+func setResourceReferencePrimaryKey(db *gorm.DB, driverName string, columns ...string) error {
+	dialect := db.Dialect()
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, dialect.Quote(column))
+	}
+	var statement string
+	switch driverName {
+	case "mysql":
+		// The type columns are shortened for the primary key to fit in the
+		// maximum key length of InnoDB with 4-byte characters.
+		for _, column := range []string{"ResourceType", "ReferenceType"} {
+			response := db.Table("resource_references").ModifyColumn(column, "varchar(64) not null")
+			if response.Error != nil {
+				return errors.Wrapf(response.Error, "Failed to update the resource reference %s type", column)
+			}
+		}
+		statement = fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY, ADD PRIMARY KEY (%s)",
+			dialect.Quote("resource_references"), strings.Join(quoted, ", "))
+	case postgres.DriverName:
+		statement = fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s, ADD PRIMARY KEY (%s)",
+			dialect.Quote("resource_references"), dialect.Quote("resource_references_pkey"), strings.Join(quoted, ", "))
+	default:
+		return nil
+	}
+	if response := db.Exec(statement); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to update the primary key of resource_references")
+	}
+	return nil
+}
+
+func addResourceReferenceUUIDToPrimaryKeyUp(db *gorm.DB, driverName string) error {
+	return setResourceReferencePrimaryKey(db, driverName, "ResourceUUID", "ResourceType", "ReferenceUUID", "ReferenceType")
+}
+
+func addResourceReferenceUUIDToPrimaryKeyDown(db *gorm.DB, driverName string) error {
+	// Only the imported pipeline versions make a resource reference several
+	// resources of a type, they are dropped to fit in the previous key.
+	dialect := db.Dialect()
+	response := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", dialect.Quote("resource_references"), dialect.Quote("Relationship")),
+		common.Imported)
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to delete the imported pipeline version references")
+	}
+	return setResourceReferencePrimaryKey(db, driverName, "ResourceUUID", "ResourceType", "ReferenceType")
+}
+
+func createAuditEventsUp(db *gorm.DB, driverName string) error {
+	if response := db.AutoMigrate(&auditEvent{}); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create the audit_events table")
+	}
+	if driverName == "mysql" {
+		response := db.Model(&auditEvent{}).ModifyColumn("Error", "longtext not null")
+		if response.Error != nil {
+			return errors.Wrap(response.Error, "Failed to update the audit event error type")
+		}
+	}
+	response := db.Model(&auditEvent{}).AddIndex("namespace_createdatinsec", "Namespace", "CreatedAtInSec")
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create index namespace_createdatinsec on audit_events")
+	}
+	return nil
+}
+
+func createAuditEventsDown(db *gorm.DB, driverName string) error {
+	if response := db.DropTableIfExists(&auditEvent{}); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the audit_events table")
+	}
+	return nil
+}
+
+func createRunEventsUp(db *gorm.DB, driverName string) error {
+	if response := db.AutoMigrate(&runEvent{}); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to create the run_events table")
+	}
+	if driverName == "mysql" {
+		response := db.Model(&runEvent{}).ModifyColumn("Run", "longtext not null")
+		if response.Error != nil {
+			return errors.Wrap(response.Error, "Failed to update the run event run type")
+		}
+	}
+	return nil
+}
+
+func createRunEventsDown(db *gorm.DB, driverName string) error {
+	if response := db.DropTableIfExists(&runEvent{}); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the run_events table")
+	}
+	return nil
+}
+
+// addPipelineOwnerUp adds the Owner column to the pipelines. The existing
+// pipelines have no owner: who created them isn't known.
+func addPipelineOwnerUp(db *gorm.DB, driverName string) error {
+	if db.Dialect().HasColumn("pipelines", "Owner") {
+		return nil
+	}
+	dialect := db.Dialect()
+	response := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s varchar(255) NOT NULL DEFAULT ''",
+		dialect.Quote("pipelines"), dialect.Quote("Owner")))
+	if response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to add the Owner column to pipelines")
+	}
+	return nil
+}
+
+func addPipelineOwnerDown(db *gorm.DB, driverName string) error {
+	if response := db.Table("pipelines").DropColumn("Owner"); response.Error != nil {
+		return errors.Wrap(response.Error, "Failed to drop the Owner column of pipelines")
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration manages the versioned schema migrations of the API server
+// database.
+//
+// Every migration has an ID, and migrations are applied in ascending ID order.
+// The IDs of the applied migrations are recorded in the schema_migrations
+// table, so that each migration runs exactly once per database. Migrations are
+// not wrapped in a transaction, because MySQL commits DDL statements
+// implicitly. Instead, an Up step must be safe to run again after a partial
+// failure, e.g. by relying on gorm's AutoMigrate or on existence checks.
+package migration
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/jinzhu/gorm"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// BaseVersion is the target of DownTo that reverts every applied migration.
+const BaseVersion = "base"
+
+// Migration is a versioned and reversible change to the database.
+type Migration struct {
+	// ID identifies the migration. Migrations are applied in ascending ID
+	// order, so IDs are zero padded sequence numbers followed by a short name.
+	ID string
+	// Description says what the migration does. It is logged and recorded with
+	// the migration.
+	Description string
+	// Up applies the migration.
+	Up func(db *gorm.DB, driverName string) error
+	// Down reverts the migration.
+	Down func(db *gorm.DB, driverName string) error
+}
+
+// Migrator applies and reverts migrations, and records the applied ones.
+type Migrator struct {
+	db         *gorm.DB
+	driverName string
+	migrations []*Migration
+	time       util.TimeInterface
+}
+
+// NewMigrator creates a Migrator for the given migrations. It returns an error
+// if the migrations are incomplete or if two of them share an ID.
+func NewMigrator(db *gorm.DB, driverName string, migrations []*Migration, time util.TimeInterface) (*Migrator, error) {
+	sorted := make([]*Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	for i, m := range sorted {
+		if m.ID == "" || m.ID == BaseVersion {
+			return nil, util.NewInvalidInputError("Invalid migration ID %q.", m.ID)
+		}
+		if m.Up == nil || m.Down == nil {
+			return nil, util.NewInvalidInputError("Migration %v must have both an Up and a Down step.", m.ID)
+		}
+		if i > 0 && sorted[i-1].ID == m.ID {
+			return nil, util.NewInvalidInputError("Duplicate migration ID %v.", m.ID)
+		}
+	}
+	return &Migrator{
+		db:         db,
+		driverName: driverName,
+		migrations: sorted,
+		time:       time,
+	}, nil
+}
+
+// Applied returns the migrations recorded in the database, in ascending ID
+// order. It doesn't write to the database, a database without the
+// schema_migrations table has no migration applied.
+func (m *Migrator) Applied() ([]*model.SchemaMigration, error) {
+	if !m.db.HasTable(&model.SchemaMigration{}) {
+		return nil, nil
+	}
+	var applied []*model.SchemaMigration
+	if err := m.db.Order("ID").Find(&applied).Error; err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the applied migrations")
+	}
+	return applied, nil
+}
+
+// Pending returns the migrations that are not applied yet, in the order Up
+// would apply them.
+func (m *Migrator) Pending() ([]*Migration, error) {
+	applied, err := m.appliedIDs()
+	if err != nil {
+		return nil, err
+	}
+	var pending []*Migration
+	for _, migration := range m.migrations {
+		if !applied[migration.ID] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies all pending migrations and returns them. With dryRun set, it
+// only returns the migrations that would be applied, without writing to the
+// database.
+func (m *Migrator) Up(dryRun bool) ([]*Migration, error) {
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+	if dryRun || len(pending) == 0 {
+		return pending, nil
+	}
+	if err := m.createTable(); err != nil {
+		return nil, err
+	}
+	for _, migration := range pending {
+		glog.Infof("Applying migration %v: %v", migration.ID, migration.Description)
+		if err := migration.Up(m.db, m.driverName); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to apply migration %v", migration.ID)
+		}
+		record := &model.SchemaMigration{
+			ID:             migration.ID,
+			Description:    migration.Description,
+			AppliedAtInSec: m.time.Now().Unix(),
+		}
+		if err := m.db.Create(record).Error; err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to record migration %v", migration.ID)
+		}
+	}
+	return pending, nil
+}
+
+// DownTo reverts, newest first, the applied migrations that come after
+// targetID, and returns them. Use BaseVersion to revert every migration. With
+// dryRun set, it only returns the migrations that would be reverted, without
+// writing to the database.
+func (m *Migrator) DownTo(targetID string, dryRun bool) ([]*Migration, error) {
+	if targetID != BaseVersion && m.find(targetID) == nil {
+		return nil, util.NewInvalidInputError("Unknown migration %v.", targetID)
+	}
+	applied, err := m.appliedIDs()
+	if err != nil {
+		return nil, err
+	}
+	var reverted []*Migration
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.ID == targetID {
+			break
+		}
+		if applied[migration.ID] {
+			reverted = append(reverted, migration)
+		}
+	}
+	if dryRun {
+		return reverted, nil
+	}
+	for _, migration := range reverted {
+		glog.Infof("Reverting migration %v: %v", migration.ID, migration.Description)
+		if err := migration.Down(m.db, m.driverName); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to revert migration %v", migration.ID)
+		}
+		if err := m.db.Delete(&model.SchemaMigration{ID: migration.ID}).Error; err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to remove the record of migration %v", migration.ID)
+		}
+	}
+	return reverted, nil
+}
+
+// createTable creates the schema_migrations table that records the applied
+// migrations, unless it exists.
+func (m *Migrator) createTable() error {
+	if err := m.db.AutoMigrate(&model.SchemaMigration{}).Error; err != nil {
+		return util.NewInternalServerError(err, "Failed to create the schema_migrations table")
+	}
+	return nil
+}
+
+// appliedIDs returns the IDs of the applied migrations. It fails if the
+// database has a migration this binary doesn't know about, which happens when
+// an older API server runs against a database migrated by a newer one. The
+// newer API server has to revert its migrations first.
+func (m *Migrator) appliedIDs() (map[string]bool, error) {
+	applied, err := m.Applied()
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool)
+	for _, record := range applied {
+		if m.find(record.ID) == nil {
+			return nil, util.NewFailedPreconditionError(
+				fmt.Errorf("unknown migration %v", record.ID),
+				"The database has migration %v applied, which this API server doesn't know about. Revert it with the API server version that applied it.", record.ID)
+		}
+		ids[record.ID] = true
+	}
+	return ids, nil
+}
+
+func (m *Migrator) find(id string) *Migration {
+	for _, migration := range m.migrations {
+		if migration.ID == id {
+			return migration
+		}
+	}
+	return nil
+}
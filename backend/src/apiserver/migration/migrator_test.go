@@ -0,0 +1,402 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeTableA struct {
+	UUID string `gorm:"column:UUID; not null; primary_key"`
+}
+
+type fakeTableB struct {
+	UUID string `gorm:"column:UUID; not null; primary_key"`
+}
+
+func createTableMigration(id string, table interface{}) *Migration {
+	return &Migration{
+		ID:          id,
+		Description: "create " + id,
+		Up: func(db *gorm.DB, driverName string) error {
+			return db.AutoMigrate(table).Error
+		},
+		Down: func(db *gorm.DB, driverName string) error {
+			return db.DropTableIfExists(table).Error
+		},
+	}
+}
+
+func fakeMigrations() []*Migration {
+	// Out of order on purpose, the migrator sorts them by ID.
+	return []*Migration{
+		createTableMigration("0002_b", &fakeTableB{}),
+		createTableMigration("0001_a", &fakeTableA{}),
+	}
+}
+
+func newFakeGormDb(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.Nil(t, err)
+	// Every connection to an in-memory database has its own database.
+	db.DB().SetMaxOpenConns(1)
+	return db
+}
+
+func migrationIDs(migrations []*Migration) []string {
+	var ids []string
+	for _, m := range migrations {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func TestNewMigrator_InvalidMigrations(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+
+	_, err := NewMigrator(db, "sqlite3", []*Migration{
+		createTableMigration("0001_a", &fakeTableA{}),
+		createTableMigration("0001_a", &fakeTableB{}),
+	}, util.NewFakeTimeForEpoch())
+	assert.Contains(t, err.Error(), "Duplicate migration ID 0001_a")
+
+	_, err = NewMigrator(db, "sqlite3", []*Migration{{ID: "0001_a", Up: noop}}, util.NewFakeTimeForEpoch())
+	assert.Contains(t, err.Error(), "must have both an Up and a Down step")
+
+	_, err = NewMigrator(db, "sqlite3", []*Migration{{ID: BaseVersion, Up: noop, Down: noop}}, util.NewFakeTimeForEpoch())
+	assert.Contains(t, err.Error(), "Invalid migration ID")
+}
+
+func TestMigrator_Up(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+
+	applied, err := migrator.Up(false)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0001_a", "0002_b"}, migrationIDs(applied))
+	assert.True(t, db.HasTable(&fakeTableA{}))
+	assert.True(t, db.HasTable(&fakeTableB{}))
+
+	records, err := migrator.Applied()
+	require.Nil(t, err)
+	assert.Equal(t, []*model.SchemaMigration{
+		{ID: "0001_a", Description: "create 0001_a", AppliedAtInSec: 1},
+		{ID: "0002_b", Description: "create 0002_b", AppliedAtInSec: 2},
+	}, records)
+
+	// Applying again is a no-op.
+	applied, err = migrator.Up(false)
+	require.Nil(t, err)
+	assert.Empty(t, applied)
+}
+
+func TestMigrator_Up_DryRun(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+
+	pending, err := migrator.Up(true)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0001_a", "0002_b"}, migrationIDs(pending))
+	assert.False(t, db.HasTable(&fakeTableA{}))
+	// A dry run doesn't write anything, not even the schema_migrations table.
+	assert.False(t, db.HasTable(&model.SchemaMigration{}))
+	records, err := migrator.Applied()
+	require.Nil(t, err)
+	assert.Empty(t, records)
+}
+
+func TestMigrator_Up_OnlyPending(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations()[1:], util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = migrator.Up(false)
+	require.Nil(t, err)
+
+	migrator, err = NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	pending, err := migrator.Pending()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0002_b"}, migrationIDs(pending))
+}
+
+func TestMigrator_Up_Failure(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	failing := &Migration{
+		ID:   "0003_fail",
+		Up:   func(db *gorm.DB, driverName string) error { return fmt.Errorf("bad migration") },
+		Down: noop,
+	}
+	migrator, err := NewMigrator(db, "sqlite3", append(fakeMigrations(), failing), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+
+	_, err = migrator.Up(false)
+	assert.Contains(t, err.Error(), "Failed to apply migration 0003_fail")
+
+	// The migrations applied before the failure stay recorded.
+	pending, err := migrator.Pending()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0003_fail"}, migrationIDs(pending))
+}
+
+func TestMigrator_Up_UnknownAppliedMigration(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = migrator.Up(false)
+	require.Nil(t, err)
+
+	olderMigrator, err := NewMigrator(db, "sqlite3", fakeMigrations()[1:], util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = olderMigrator.Up(false)
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "0002_b")
+}
+
+func TestMigrator_DownTo(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = migrator.Up(false)
+	require.Nil(t, err)
+
+	reverted, err := migrator.DownTo("0001_a", false)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0002_b"}, migrationIDs(reverted))
+	assert.True(t, db.HasTable(&fakeTableA{}))
+	assert.False(t, db.HasTable(&fakeTableB{}))
+
+	reverted, err = migrator.DownTo(BaseVersion, false)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0001_a"}, migrationIDs(reverted))
+	assert.False(t, db.HasTable(&fakeTableA{}))
+	records, err := migrator.Applied()
+	require.Nil(t, err)
+	assert.Empty(t, records)
+}
+
+func TestMigrator_DownTo_DryRun(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = migrator.Up(false)
+	require.Nil(t, err)
+
+	reverted, err := migrator.DownTo(BaseVersion, true)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"0002_b", "0001_a"}, migrationIDs(reverted))
+	assert.True(t, db.HasTable(&fakeTableB{}))
+	records, err := migrator.Applied()
+	require.Nil(t, err)
+	assert.Len(t, records, 2)
+}
+
+func TestMigrator_DownTo_UnknownTarget(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrator, err := NewMigrator(db, "sqlite3", fakeMigrations(), util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+
+	_, err = migrator.DownTo("0009_missing", false)
+	assert.Contains(t, err.Error(), "Unknown migration 0009_missing")
+}
+
+func TestMigrations_Valid(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	_, err := NewMigrator(db, "mysql", Migrations(), util.NewFakeTimeForEpoch())
+	assert.Nil(t, err)
+}
+
+func TestMigrations_CreateTheModels(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	migrations := Migrations()
+	// SQLite cannot add foreign keys to a table, create the initial tables only.
+	require.Equal(t, "0001_initial_schema", migrations[0].ID)
+	migrations[0].Up = func(db *gorm.DB, driverName string) error {
+		return db.AutoMigrate(initialSchemaTables...).Error
+	}
+	migrator, err := NewMigrator(db, "sqlite3", migrations, util.NewFakeTimeForEpoch())
+	require.Nil(t, err)
+	_, err = migrator.Up(false)
+	require.Nil(t, err, "%+v", err)
+
+	// The released migrations create every column of the current models. A
+	// model change without a migration fails here.
+	for _, m := range []interface{}{
+		&model.Experiment{}, &model.Job{}, &model.Pipeline{}, &model.PipelineVersion{},
+		&model.ResourceReference{}, &model.RunDetail{}, &model.RunMetric{}, &model.Task{},
+		&model.DBStatus{}, &model.DefaultExperiment{}, &model.AuditEvent{}, &model.RunEvent{},
+	} {
+		scope := db.NewScope(m)
+		require.True(t, db.HasTable(m), scope.TableName())
+		for _, field := range scope.GetModelStruct().StructFields {
+			if field.IsNormal && !field.IsIgnored {
+				assert.True(t, db.Dialect().HasColumn(scope.TableName(), field.DBName),
+					"%s.%s", scope.TableName(), field.DBName)
+			}
+		}
+	}
+}
+
+// jobWithoutTimeZone is the jobs table before 0004_add_job_cron_schedule_time_zone.
+type jobWithoutTimeZone struct {
+	UUID string `gorm:"column:UUID; not null; primary_key"`
+}
+
+func (jobWithoutTimeZone) TableName() string {
+	return "jobs"
+}
+
+func TestAddJobCronScheduleTimeZoneUp(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&jobWithoutTimeZone{}).Error)
+	require.False(t, db.Dialect().HasColumn("jobs", "CronScheduleTimeZone"))
+
+	require.Nil(t, addJobCronScheduleTimeZoneUp(db, "sqlite3"))
+	assert.True(t, db.Dialect().HasColumn("jobs", "CronScheduleTimeZone"))
+
+	// Applying again is a no-op.
+	assert.Nil(t, addJobCronScheduleTimeZoneUp(db, "sqlite3"))
+}
+
+func TestAddJobConcurrencyPolicyUp(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&jobWithoutTimeZone{}).Error)
+	require.Nil(t, db.Exec("INSERT INTO jobs (UUID) VALUES ('job1')").Error)
+
+	require.Nil(t, addJobConcurrencyPolicyUp(db, "sqlite3"))
+	assert.True(t, db.Dialect().HasColumn("jobs", "ConcurrencyPolicy"))
+	var policy string
+	require.Nil(t, db.Raw("SELECT ConcurrencyPolicy FROM jobs WHERE UUID = 'job1'").Row().Scan(&policy))
+	assert.Equal(t, "", policy)
+
+	// Applying again is a no-op.
+	assert.Nil(t, addJobConcurrencyPolicyUp(db, "sqlite3"))
+}
+
+func TestAddPipelineOwnerUp(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&initialPipeline{}).Error)
+	require.Nil(t, db.Exec("INSERT INTO pipelines (UUID, CreatedAtInSec, Name, Description, Parameters, Status) VALUES ('p1', 1, 'p1', '', '', 'READY')").Error)
+
+	require.Nil(t, addPipelineOwnerUp(db, "sqlite3"))
+	assert.True(t, db.Dialect().HasColumn("pipelines", "Owner"))
+	// The existing pipelines have no owner.
+	var owner string
+	require.Nil(t, db.Raw("SELECT Owner FROM pipelines WHERE UUID = 'p1'").Row().Scan(&owner))
+	assert.Equal(t, "", owner)
+
+	// Applying again is a no-op.
+	assert.Nil(t, addPipelineOwnerUp(db, "sqlite3"))
+}
+
+func TestAddJobBucketObjectTriggerUp(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&jobWithoutTimeZone{}).Error)
+
+	require.Nil(t, addJobBucketObjectTriggerUp(db, "sqlite3"))
+	for _, column := range jobBucketObjectTriggerColumns {
+		assert.True(t, db.Dialect().HasColumn("jobs", column))
+	}
+
+	// Applying again is a no-op.
+	assert.Nil(t, addJobBucketObjectTriggerUp(db, "sqlite3"))
+}
+
+func TestAddJobRunDependencyTriggerUp(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&jobWithoutTimeZone{}).Error)
+
+	require.Nil(t, addJobRunDependencyTriggerUp(db, "sqlite3"))
+	for _, column := range jobRunDependencyTriggerColumns {
+		assert.True(t, db.Dialect().HasColumn("jobs", column))
+	}
+
+	// Applying again is a no-op.
+	assert.Nil(t, addJobRunDependencyTriggerUp(db, "sqlite3"))
+}
+
+func TestAddResourceReferenceUUIDToPrimaryKeyDown(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+	require.Nil(t, db.AutoMigrate(&model.ResourceReference{}).Error)
+	for _, ref := range []*model.ResourceReference{
+		{ResourceUUID: "r1", ResourceType: common.Run, ReferenceUUID: "v1", ReferenceType: common.PipelineVersion, Relationship: common.Creator},
+		{ResourceUUID: "r1", ResourceType: common.Run, ReferenceUUID: "v2", ReferenceType: common.PipelineVersion, Relationship: common.Imported},
+		{ResourceUUID: "r1", ResourceType: common.Run, ReferenceUUID: "v3", ReferenceType: common.PipelineVersion, Relationship: common.Imported},
+	} {
+		require.Nil(t, db.Create(ref).Error)
+	}
+
+	require.Nil(t, addResourceReferenceUUIDToPrimaryKeyDown(db, "sqlite3"))
+	var refs []*model.ResourceReference
+	require.Nil(t, db.Find(&refs).Error)
+	require.Len(t, refs, 1)
+	assert.Equal(t, "v1", refs[0].ReferenceUUID)
+	assert.Nil(t, addResourceReferenceUUIDToPrimaryKeyUp(db, "sqlite3"))
+}
+
+func TestCreateAuditEvents(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+
+	require.Nil(t, createAuditEventsUp(db, "sqlite3"))
+	assert.True(t, db.HasTable(&model.AuditEvent{}))
+	assert.True(t, db.Dialect().HasIndex("audit_events", "namespace_createdatinsec"))
+	// Applying again is a no-op.
+	require.Nil(t, createAuditEventsUp(db, "sqlite3"))
+
+	require.Nil(t, createAuditEventsDown(db, "sqlite3"))
+	assert.False(t, db.HasTable(&model.AuditEvent{}))
+}
+
+func TestCreateRunEvents(t *testing.T) {
+	db := newFakeGormDb(t)
+	defer db.Close()
+
+	require.Nil(t, createRunEventsUp(db, "sqlite3"))
+	assert.True(t, db.HasTable(&model.RunEvent{}))
+	// Applying again is a no-op.
+	require.Nil(t, createRunEventsUp(db, "sqlite3"))
+
+	require.Nil(t, createRunEventsDown(db, "sqlite3"))
+	assert.False(t, db.HasTable(&model.RunEvent{}))
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+// The tables created by the migrations, as they were when the migrations were
+// released. The migrations create the tables from these copies instead of the
+// models of the API server, so that a released migration doesn't change when
+// a model changes. Never change them: a change of a model needs a new
+// migration, like 0004_add_job_cron_schedule_time_zone.
+
+// The tables of 0001_initial_schema. The embedded structs of the models are
+// flattened, in the same order.
+
+type initialExperiment struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	Name           string `gorm:"column:Name; not null; unique_index:idx_name_namespace"`
+	Description    string `gorm:"column:Description; not null"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+	Namespace      string `gorm:"column:Namespace; not null; unique_index:idx_name_namespace"`
+	StorageState   string `gorm:"column:StorageState; not null;"`
+}
+
+func (initialExperiment) TableName() string {
+	return "experiments"
+}
+
+type initialJob struct {
+	UUID                           string  `gorm:"column:UUID; not null; primary_key"`
+	DisplayName                    string  `gorm:"column:DisplayName; not null;"`
+	Name                           string  `gorm:"column:Name; not null;"`
+	Namespace                      string  `gorm:"column:Namespace; not null;"`
+	ServiceAccount                 string  `gorm:"column:ServiceAccount; not null;"`
+	Description                    string  `gorm:"column:Description; not null"`
+	MaxConcurrency                 int64   `gorm:"column:MaxConcurrency;not null"`
+	NoCatchup                      bool    `gorm:"column:NoCatchup; not null"`
+	CreatedAtInSec                 int64   `gorm:"column:CreatedAtInSec; not null"`
+	UpdatedAtInSec                 int64   `gorm:"column:UpdatedAtInSec; not null"`
+	Enabled                        bool    `gorm:"column:Enabled; not null"`
+	CronScheduleStartTimeInSec     *int64  `gorm:"column:CronScheduleStartTimeInSec;"`
+	CronScheduleEndTimeInSec       *int64  `gorm:"column:CronScheduleEndTimeInSec;"`
+	Cron                           *string `gorm:"column:Schedule;"`
+	PeriodicScheduleStartTimeInSec *int64  `gorm:"column:PeriodicScheduleStartTimeInSec;"`
+	PeriodicScheduleEndTimeInSec   *int64  `gorm:"column:PeriodicScheduleEndTimeInSec;"`
+	IntervalSecond                 *int64  `gorm:"column:IntervalSecond;"`
+	PipelineId                     string  `gorm:"column:PipelineId; not null"`
+	PipelineName                   string  `gorm:"column:PipelineName; not null"`
+	PipelineSpecManifest           string  `gorm:"column:PipelineSpecManifest; size:65535"`
+	WorkflowSpecManifest           string  `gorm:"column:WorkflowSpecManifest; not null; size:65535"`
+	Parameters                     string  `gorm:"column:Parameters; size:65535"`
+	Conditions                     string  `gorm:"column:Conditions; not null"`
+}
+
+func (initialJob) TableName() string {
+	return "jobs"
+}
+
+type initialPipeline struct {
+	UUID             string `gorm:"column:UUID; not null; primary_key"`
+	CreatedAtInSec   int64  `gorm:"column:CreatedAtInSec; not null"`
+	Name             string `gorm:"column:Name; not null"`
+	Description      string `gorm:"column:Description; not null; size:65535"`
+	Parameters       string `gorm:"column:Parameters; not null; size:65535"`
+	Status           string `gorm:"column:Status; not null"`
+	DefaultVersionId string `gorm:"column:DefaultVersionId;"`
+	Namespace        string `gorm:"column:Namespace; size:63; default:''"`
+}
+
+func (initialPipeline) TableName() string {
+	return "pipelines"
+}
+
+type initialPipelineVersion struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index"`
+	Name           string `gorm:"column:Name; not null; unique_index:idx_pipelineid_name"`
+	Parameters     string `gorm:"column:Parameters; not null; size:65535"`
+	PipelineId     string `gorm:"column:PipelineId; not null;index; unique_index:idx_pipelineid_name"`
+	Status         string `gorm:"column:Status; not null"`
+	CodeSourceUrl  string `gorm:"column:CodeSourceUrl;"`
+	Description    string `gorm:"column:Description; not null; size:65535"`
+}
+
+func (initialPipelineVersion) TableName() string {
+	return "pipeline_versions"
+}
+
+type initialResourceReference struct {
+	ResourceUUID  string `gorm:"column:ResourceUUID; not null; primary_key"`
+	ResourceType  string `gorm:"column:ResourceType; not null; primary_key; index:referencefilter"`
+	ReferenceUUID string `gorm:"column:ReferenceUUID; not null; index:referencefilter"`
+	ReferenceName string `gorm:"column:ReferenceName; not null; "`
+	ReferenceType string `gorm:"column:ReferenceType; not null; primary_key; index:referencefilter"`
+	Relationship  string `gorm:"column:Relationship; not null; "`
+	Payload       string `gorm:"column:Payload; not null; size:65535 "`
+}
+
+func (initialResourceReference) TableName() string {
+	return "resource_references"
+}
+
+type initialRunDetail struct {
+	UUID                    string `gorm:"column:UUID; not null; primary_key"`
+	ExperimentUUID          string `gorm:"column:ExperimentUUID; not null;"`
+	DisplayName             string `gorm:"column:DisplayName; not null;"`
+	Name                    string `gorm:"column:Name; not null;"`
+	StorageState            string `gorm:"column:StorageState; not null;"`
+	Namespace               string `gorm:"column:Namespace; not null;"`
+	ServiceAccount          string `gorm:"column:ServiceAccount; not null;"`
+	Description             string `gorm:"column:Description; not null;"`
+	CreatedAtInSec          int64  `gorm:"column:CreatedAtInSec; not null;"`
+	ScheduledAtInSec        int64  `gorm:"column:ScheduledAtInSec; default:0;"`
+	FinishedAtInSec         int64  `gorm:"column:FinishedAtInSec; default:0;"`
+	Conditions              string `gorm:"column:Conditions; not null"`
+	PipelineId              string `gorm:"column:PipelineId; not null"`
+	PipelineName            string `gorm:"column:PipelineName; not null"`
+	PipelineSpecManifest    string `gorm:"column:PipelineSpecManifest; size:65535"`
+	WorkflowSpecManifest    string `gorm:"column:WorkflowSpecManifest; not null; size:65535"`
+	Parameters              string `gorm:"column:Parameters; size:65535"`
+	PipelineRuntimeManifest string `gorm:"column:PipelineRuntimeManifest; not null; size:65535"`
+	WorkflowRuntimeManifest string `gorm:"column:WorkflowRuntimeManifest; not null; size:65535"`
+}
+
+func (initialRunDetail) TableName() string {
+	return "run_details"
+}
+
+type initialRunMetric struct {
+	RunUUID     string  `gorm:"column:RunUUID; not null;primary_key"`
+	NodeID      string  `gorm:"column:NodeID; not null; primary_key"`
+	Name        string  `gorm:"column:Name; not null;primary_key"`
+	NumberValue float64 `gorm:"column:NumberValue"`
+	Format      string  `gorm:"column:Format"`
+	Payload     string  `gorm:"column:Payload; not null; size:65535"`
+}
+
+func (initialRunMetric) TableName() string {
+	return "run_metrics"
+}
+
+type initialTask struct {
+	UUID              string `gorm:"column:UUID; not null; primary_key"`
+	Namespace         string `gorm:"column:Namespace; not null;"`
+	PipelineName      string `gorm:"column:PipelineName; not null;"`
+	RunUUID           string `gorm:"column:RunUUID; not null;"`
+	MLMDExecutionID   string `gorm:"column:MLMDExecutionID; not null;"`
+	CreatedTimestamp  int64  `gorm:"column:CreatedTimestamp; not null"`
+	FinishedTimestamp int64  `gorm:"column:FinishedTimestamp"`
+	Fingerprint       string `gorm:"column:Fingerprint; not null;"`
+}
+
+func (initialTask) TableName() string {
+	return "tasks"
+}
+
+type initialDBStatus struct {
+	HaveSamplesLoaded bool `gorm:"column:HaveSamplesLoaded; not null"`
+}
+
+func (initialDBStatus) TableName() string {
+	return "db_statuses"
+}
+
+type initialDefaultExperiment struct {
+	DefaultExperimentId string `gorm:"column:DefaultExperimentId; not null"`
+}
+
+func (initialDefaultExperiment) TableName() string {
+	return "default_experiments"
+}
+
+// The table of 0009_create_audit_events.
+type auditEvent struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+	UserIdentity   string `gorm:"column:UserIdentity; not null"`
+	Method         string `gorm:"column:Method; not null"`
+	ResourceType   string `gorm:"column:ResourceType; not null"`
+	ResourceUUID   string `gorm:"column:ResourceUUID; not null"`
+	Namespace      string `gorm:"column:Namespace; not null"`
+	Outcome        string `gorm:"column:Outcome; not null"`
+	Error          string `gorm:"column:Error; not null; size:65535"`
+}
+
+func (auditEvent) TableName() string {
+	return "audit_events"
+}
+
+// The table of 0010_create_run_events.
+type runEvent struct {
+	Seq          int64  `gorm:"column:Seq; primary_key; AUTO_INCREMENT"`
+	Type         string `gorm:"column:Type; not null"`
+	RunUUID      string `gorm:"column:RunUUID; not null"`
+	EventAtInSec int64  `gorm:"column:EventAtInSec; not null; index"`
+	Run          string `gorm:"column:Run; not null; size:65535"`
+}
+
+func (runEvent) TableName() string {
+	return "run_events"
+}
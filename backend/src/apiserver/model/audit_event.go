@@ -0,0 +1,104 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// AuditEvent records a call of the API that changed or tried to change a
+// resource.
+type AuditEvent struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+	// The user identity, empty if the user is not known.
+	UserIdentity string `gorm:"column:UserIdentity; not null"`
+	// The full name of the RPC method, or the HTTP method and path of the
+	// HTTP handlers.
+	Method       string `gorm:"column:Method; not null"`
+	ResourceType string `gorm:"column:ResourceType; not null"`
+	ResourceUUID string `gorm:"column:ResourceUUID; not null"`
+	Namespace    string `gorm:"column:Namespace; not null"`
+	// OK, or the gRPC status code of the error of failed calls.
+	Outcome string `gorm:"column:Outcome; not null"`
+	/* Set size to 65535 so it will be stored as longtext. https://dev.mysql.com/doc/refman/8.0/en/column-count-limit.html */
+	Error string `gorm:"column:Error; not null; size:65535"`
+}
+
+// PrimaryKeyColumnName returns the primary key for model AuditEvent.
+func (e *AuditEvent) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model AuditEvent.
+func (e *AuditEvent) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var auditEventAPIToModelFieldMap = map[string]string{
+	"id":            "UUID",
+	"created_at":    "CreatedAtInSec",
+	"user":          "UserIdentity",
+	"method":        "Method",
+	"resource_type": "ResourceType",
+	"resource_id":   "ResourceUUID",
+	"namespace":     "Namespace",
+	"outcome":       "Outcome",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// AuditEvent.
+func (e *AuditEvent) APIToModelFieldMap() map[string]string {
+	return auditEventAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix
+func (e *AuditEvent) GetModelName() string {
+	return "audit_events"
+}
+
+func (e *AuditEvent) GetSortByFieldPrefix(name string) string {
+	return "audit_events."
+}
+
+func (e *AuditEvent) GetKeyFieldPrefix() string {
+	return "audit_events."
+}
+
+func (e *AuditEvent) GetField(name string) (string, bool) {
+	if field, ok := auditEventAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (e *AuditEvent) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return e.UUID
+	case "CreatedAtInSec":
+		return e.CreatedAtInSec
+	case "UserIdentity":
+		return e.UserIdentity
+	case "Method":
+		return e.Method
+	case "ResourceType":
+		return e.ResourceType
+	case "ResourceUUID":
+		return e.ResourceUUID
+	case "Namespace":
+		return e.Namespace
+	case "Outcome":
+		return e.Outcome
+	default:
+		return nil
+	}
+}
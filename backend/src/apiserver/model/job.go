@@ -17,14 +17,16 @@ package model
 import "fmt"
 
 type Job struct {
-	UUID               string `gorm:"column:UUID; not null; primary_key"`
-	DisplayName        string `gorm:"column:DisplayName; not null;"` /* The name that user provides. Can contain special characters*/
-	Name               string `gorm:"column:Name; not null;"`        /* The name of the K8s resource. Follow regex '[a-z0-9]([-a-z0-9]*[a-z0-9])?'*/
-	Namespace          string `gorm:"column:Namespace; not null;"`
-	ServiceAccount     string `gorm:"column:ServiceAccount; not null;"`
-	Description        string `gorm:"column:Description; not null"`
-	MaxConcurrency     int64  `gorm:"column:MaxConcurrency;not null"`
-	NoCatchup          bool   `gorm:"column:NoCatchup; not null"`
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	DisplayName    string `gorm:"column:DisplayName; not null;"` /* The name that user provides. Can contain special characters*/
+	Name           string `gorm:"column:Name; not null;"`        /* The name of the K8s resource. Follow regex '[a-z0-9]([-a-z0-9]*[a-z0-9])?'*/
+	Namespace      string `gorm:"column:Namespace; not null;"`
+	ServiceAccount string `gorm:"column:ServiceAccount; not null;"`
+	Description    string `gorm:"column:Description; not null"`
+	MaxConcurrency int64  `gorm:"column:MaxConcurrency;not null"`
+	NoCatchup      bool   `gorm:"column:NoCatchup; not null"`
+	/* The concurrency policy of the scheduled workflow. Empty for the default Allow policy.*/
+	ConcurrencyPolicy  string `gorm:"column:ConcurrencyPolicy; not null; default:''"`
 	CreatedAtInSec     int64  `gorm:"column:CreatedAtInSec; not null"` /* The time this record is stored in DB*/
 	UpdatedAtInSec     int64  `gorm:"column:UpdatedAtInSec; not null"`
 	Enabled            bool   `gorm:"column:Enabled; not null"`
@@ -40,6 +42,10 @@ type Trigger struct {
 	CronSchedule
 	// Create workflows periodically.
 	PeriodicSchedule
+	// Create a workflow for each new object of a bucket.
+	BucketObjectTrigger
+	// Create a workflow each time a run of an upstream job or pipeline version succeeds.
+	RunDependencyTrigger
 }
 
 type CronSchedule struct {
@@ -54,6 +60,10 @@ type CronSchedule struct {
 	// Cron string describing when a workflow should be created within the
 	// time interval defined by StartTime and EndTime.
 	Cron *string `gorm:"column:Schedule;"`
+
+	// IANA time zone in which the cron string is interpreted.
+	// If no time zone is specified, the time zone of the controller is used.
+	CronScheduleTimeZone *string `gorm:"column:CronScheduleTimeZone;"`
 }
 
 type PeriodicSchedule struct {
@@ -70,6 +80,29 @@ type PeriodicSchedule struct {
 	IntervalSecond *int64 `gorm:"column:IntervalSecond;"`
 }
 
+type BucketObjectTrigger struct {
+	// URL of the bucket whose new objects create a workflow.
+	BucketObjectTriggerBucket *string `gorm:"column:BucketObjectTriggerBucket;"`
+
+	// Pattern that the keys of the objects must match.
+	// If no pattern is specified, all the objects of the bucket match.
+	BucketObjectTriggerPattern *string `gorm:"column:BucketObjectTriggerPattern;"`
+
+	// Name of the parameter set to the URI of the object.
+	BucketObjectTriggerParameterName *string `gorm:"column:BucketObjectTriggerParameterName;"`
+}
+
+type RunDependencyTrigger struct {
+	// Type of the upstream resource, either Job or PipelineVersion.
+	RunDependencyTriggerUpstreamType *string `gorm:"column:RunDependencyTriggerUpstreamType;"`
+
+	// ID of the upstream job or pipeline version whose succeeded runs create a workflow.
+	RunDependencyTriggerUpstreamId *string `gorm:"column:RunDependencyTriggerUpstreamId;"`
+
+	// Name of the parameter set to the ID of the upstream run.
+	RunDependencyTriggerParameterName *string `gorm:"column:RunDependencyTriggerParameterName;"`
+}
+
 func (j Job) GetValueOfPrimaryKey() string {
 	return fmt.Sprint(j.UUID)
 }
@@ -42,6 +42,10 @@ type Pipeline struct {
 	DefaultVersionId string           `gorm:"column:DefaultVersionId;"`
 	DefaultVersion   *PipelineVersion `gorm:"-"`
 	Namespace        string           `gorm:"column:Namespace; size:63; default:''"`
+	// Name of the user that created the pipeline in multi-user mode. It is
+	// empty for the pipelines created in single-user mode, in no namespace, or
+	// before the owners were recorded.
+	Owner string `gorm:"column:Owner; not null; default:''"`
 }
 
 func (p Pipeline) GetValueOfPrimaryKey() string {
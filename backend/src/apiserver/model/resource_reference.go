@@ -26,16 +26,16 @@ type ResourceReference struct {
 	ResourceUUID string `gorm:"column:ResourceUUID; not null; primary_key"`
 
 	// The type of the resource object
-	ResourceType ResourceType `gorm:"column:ResourceType; not null; primary_key; index:referencefilter"`
+	ResourceType ResourceType `gorm:"column:ResourceType; not null; size:64; primary_key; index:referencefilter"`
 
 	// The ID of the resource that been referenced to.
-	ReferenceUUID string `gorm:"column:ReferenceUUID; not null; index:referencefilter"`
+	ReferenceUUID string `gorm:"column:ReferenceUUID; not null; primary_key; index:referencefilter"`
 
 	// The name of the resource that been referenced to.
 	ReferenceName string `gorm:"column:ReferenceName; not null; "`
 
 	// The type of the resource that been referenced to.
-	ReferenceType ResourceType `gorm:"column:ReferenceType; not null; primary_key; index:referencefilter"`
+	ReferenceType ResourceType `gorm:"column:ReferenceType; not null; size:64; primary_key; index:referencefilter"`
 
 	// The relationship between the resource object and the resource that been referenced to.
 	Relationship Relationship `gorm:"column:Relationship; not null; "`
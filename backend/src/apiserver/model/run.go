@@ -15,13 +15,27 @@
 package model
 
 import (
+	"encoding/json"
+	"regexp"
 	"strings"
 )
 
 const (
 	RunTerminatingConditions string = "Terminating"
+	RunSuspendedConditions   string = "Suspended"
 )
 
+// The prefixes of the filter keys of the metrics and the parameters of the
+// runs, e.g. metric.accuracy or parameter.learning_rate.
+const (
+	RunMetricFilterKeyPrefix    = "metric."
+	RunParameterFilterKeyPrefix = "parameter."
+)
+
+// The names of the metrics and the parameters that the runs can be filtered on.
+// They are safe to use in SQL queries.
+var runFilterNamePattern = regexp.MustCompile(`^[a-zA-Z_][-_a-zA-Z0-9]*$`)
+
 type Run struct {
 	UUID               string `gorm:"column:UUID; not null; primary_key"`
 	ExperimentUUID     string `gorm:"column:ExperimentUUID; not null;"`
@@ -110,6 +124,31 @@ func (r *Run) GetField(name string) (string, bool) {
 	return "", false
 }
 
+// GetFilterField returns the field to filter on for the given key, which is
+// either a field in runAPIToModelFieldMap or the key of a metric or a
+// parameter.
+func (r *Run) GetFilterField(key string) (string, bool) {
+	if field, ok := runAPIToModelFieldMap[key]; ok {
+		return field, true
+	}
+	if _, _, ok := ParseRunFilterKey(key); ok {
+		return key, true
+	}
+	return "", false
+}
+
+// ParseRunFilterKey returns the prefix and the name of the filter key of a
+// metric or a parameter, or false if key is not one.
+func ParseRunFilterKey(key string) (string, string, bool) {
+	for _, prefix := range []string{RunMetricFilterKeyPrefix, RunParameterFilterKeyPrefix} {
+		if strings.HasPrefix(key, prefix) {
+			name := strings.TrimPrefix(key, prefix)
+			return prefix, name, runFilterNamePattern.MatchString(name)
+		}
+	}
+	return "", "", false
+}
+
 func (r *Run) GetFieldValue(name string) interface{} {
 	// "name" could be a field in Run type or a name inside an array typed field
 	// in Run type
@@ -130,6 +169,12 @@ func (r *Run) GetFieldValue(name string) interface{} {
 	case "Conditions":
 		return r.Conditions
 	}
+	if prefix, n, ok := ParseRunFilterKey(name); ok {
+		if prefix == RunMetricFilterKeyPrefix {
+			return r.metricFilterValue(n)
+		}
+		return r.parameterFilterValue(n)
+	}
 	// Second, try to find the match of "name" inside an array typed field
 	for _, metric := range r.Metrics {
 		if metric.Name == name {
@@ -139,6 +184,40 @@ func (r *Run) GetFieldValue(name string) interface{} {
 	return nil
 }
 
+// metricFilterValue returns the value of a metric that the filters compare, the
+// largest one if several nodes reported the metric, like in the SQL queries.
+func (r *Run) metricFilterValue(name string) interface{} {
+	var value interface{}
+	for _, metric := range r.Metrics {
+		if metric.Name == name && (value == nil || metric.NumberValue > value.(float64)) {
+			value = metric.NumberValue
+		}
+	}
+	return value
+}
+
+// parameterFilterValue returns the value of a parameter that the filters
+// compare, the string of the first parameter with the name in the serialized
+// parameters, like in the SQL queries.
+func (r *Run) parameterFilterValue(name string) interface{} {
+	var params []struct {
+		Name  string  `json:"name"`
+		Value *string `json:"value"`
+	}
+	if r.Parameters == "" || json.Unmarshal([]byte(r.Parameters), &params) != nil {
+		return nil
+	}
+	for _, param := range params {
+		if param.Name == name {
+			if param.Value == nil {
+				return nil
+			}
+			return *param.Value
+		}
+	}
+	return nil
+}
+
 // Regular fields are the fields that are mapped to columns in Run table.
 // Non-regular fields are the run metrics for now. Could have other non-regular
 // sorting fields later.
@@ -0,0 +1,29 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunEvent records a change of a run, so that the run watchers of every API
+// server replica receive it.
+type RunEvent struct {
+	// Seq orders the events, it is assigned by the database.
+	Seq          int64  `gorm:"column:Seq; primary_key; AUTO_INCREMENT"`
+	Type         string `gorm:"column:Type; not null"`
+	RunUUID      string `gorm:"column:RunUUID; not null"`
+	EventAtInSec int64  `gorm:"column:EventAtInSec; not null; index"`
+	// Run is the JSON of the state of the run after the change, or before it
+	// for a deleted run, without its pipeline spec. Set size to 65535 so it
+	// will be stored as longtext.
+	Run string `gorm:"column:Run; not null; size:65535"`
+}
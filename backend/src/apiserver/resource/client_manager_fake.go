@@ -36,6 +36,9 @@ type FakeClientManager struct {
 	jobStore                      storage.JobStoreInterface
 	runStore                      storage.RunStoreInterface
 	taskStore                     storage.TaskStoreInterface
+	auditEventStore               storage.AuditEventStoreInterface
+	runEventStore                 storage.RunEventStoreInterface
+	auditEventTime                util.TimeInterface
 	resourceReferenceStore        storage.ResourceReferenceStoreInterface
 	dBStatusStore                 storage.DBStatusStoreInterface
 	defaultExperimentStore        storage.DefaultExperimentStoreInterface
@@ -49,6 +52,11 @@ type FakeClientManager struct {
 	time                          util.TimeInterface
 	uuid                          util.UUIDGeneratorInterface
 	AuthenticatorsFake            []auth.Authenticator
+	// AuthorizerFake authorizes the requests, SubjectAccessReviews with
+	// SubjectAccessReviewClientFake if it is nil.
+	AuthorizerFake auth.Authorizer
+	// AuditEventSinkFake receives the audit events if it is not nil.
+	AuditEventSinkFake storage.AuditEventSinkInterface
 }
 
 func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterface) (
@@ -68,6 +76,10 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		return nil, err
 	}
 
+	// The audit events have their own clock, so that auditing calls does not
+	// change the times of the other resources.
+	auditEventTime := util.NewFakeTimeForEpoch()
+
 	// TODO(neuromage): Pass in metadata.Store instance for tests as well.
 	return &FakeClientManager{
 		db:                            db,
@@ -76,6 +88,9 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		jobStore:                      storage.NewJobStore(db, time),
 		runStore:                      storage.NewRunStore(db, time),
 		taskStore:                     storage.NewTaskStore(db, time, uuid),
+		auditEventStore:               storage.NewAuditEventStore(db, auditEventTime, uuid),
+		auditEventTime:                auditEventTime,
+		runEventStore:                 storage.NewRunEventStore(db),
 		ArgoClientFake:                client.NewFakeArgoClient(),
 		resourceReferenceStore:        storage.NewResourceReferenceStore(db),
 		dBStatusStore:                 storage.NewDBStatusStore(db),
@@ -159,6 +174,18 @@ func (f *FakeClientManager) TaskStore() storage.TaskStoreInterface {
 	return f.taskStore
 }
 
+func (f *FakeClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return f.auditEventStore
+}
+
+func (f *FakeClientManager) AuditEventSink() storage.AuditEventSinkInterface {
+	return f.AuditEventSinkFake
+}
+
+func (f *FakeClientManager) RunEventStore() storage.RunEventStoreInterface {
+	return f.runEventStore
+}
+
 func (f *FakeClientManager) ResourceReferenceStore() storage.ResourceReferenceStoreInterface {
 	return f.resourceReferenceStore
 }
@@ -191,6 +218,13 @@ func (f *FakeClientManager) Authenticators() []auth.Authenticator {
 	return f.AuthenticatorsFake
 }
 
+func (f *FakeClientManager) Authorizer() auth.Authorizer {
+	if f.AuthorizerFake != nil {
+		return f.AuthorizerFake
+	}
+	return auth.NewSubjectAccessReviewAuthorizer(f.SubjectAccessReviewClientFake)
+}
+
 func (f *FakeClientManager) Close() error {
 	return f.db.Close()
 }
@@ -200,4 +234,5 @@ func (f *FakeClientManager) UpdateUUID(uuid util.UUIDGeneratorInterface) {
 	f.uuid = uuid
 	f.experimentStore = storage.NewExperimentStore(f.db, f.time, uuid)
 	f.pipelineStore = storage.NewPipelineStore(f.db, f.time, uuid)
+	f.auditEventStore = storage.NewAuditEventStore(f.db, f.auditEventTime, uuid)
 }
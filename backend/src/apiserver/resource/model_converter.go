@@ -26,6 +26,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 )
 
@@ -147,6 +148,7 @@ func (r *ResourceManager) ToModelJob(job *api.Job, swf *util.ScheduledWorkflow,
 		Trigger:            toModelTrigger(job.Trigger),
 		MaxConcurrency:     job.MaxConcurrency,
 		NoCatchup:          job.NoCatchup,
+		ConcurrencyPolicy:  toModelConcurrencyPolicy(job.ConcurrencyPolicy),
 		ResourceReferences: resourceReferences,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:   job.GetPipelineSpec().GetPipelineId(),
@@ -199,6 +201,19 @@ func (r *ResourceManager) ToModelPipelineVersion(version *api.PipelineVersion) (
 	}, nil
 }
 
+func toModelConcurrencyPolicy(policy api.Job_ConcurrencyPolicy) string {
+	switch policy {
+	case api.Job_FORBID:
+		return string(scheduledworkflow.ForbidConcurrent)
+	case api.Job_REPLACE:
+		return string(scheduledworkflow.ReplaceConcurrent)
+	case api.Job_SKIP:
+		return string(scheduledworkflow.SkipConcurrent)
+	default:
+		return ""
+	}
+}
+
 func toModelTrigger(trigger *api.Trigger) model.Trigger {
 	modelTrigger := model.Trigger{}
 	if trigger == nil {
@@ -213,6 +228,9 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 		if cronSchedule.EndTime != nil {
 			modelTrigger.CronScheduleEndTimeInSec = &cronSchedule.EndTime.Seconds
 		}
+		if cronSchedule.TimeZone != "" {
+			modelTrigger.CronScheduleTimeZone = &cronSchedule.TimeZone
+		}
 	}
 
 	if trigger.GetPeriodicSchedule() != nil {
@@ -226,6 +244,28 @@ func toModelTrigger(trigger *api.Trigger) model.Trigger {
 			modelTrigger.PeriodicScheduleEndTimeInSec = &periodicSchedule.EndTime.Seconds
 		}
 	}
+
+	if trigger.GetBucketObjectTrigger() != nil {
+		bucketObjectTrigger := trigger.GetBucketObjectTrigger()
+		modelTrigger.BucketObjectTrigger = model.BucketObjectTrigger{
+			BucketObjectTriggerBucket:        &bucketObjectTrigger.Bucket,
+			BucketObjectTriggerParameterName: &bucketObjectTrigger.ParameterName,
+		}
+		if bucketObjectTrigger.Pattern != "" {
+			modelTrigger.BucketObjectTriggerPattern = &bucketObjectTrigger.Pattern
+		}
+	}
+
+	if trigger.GetRunDependencyTrigger() != nil {
+		runDependencyTrigger := trigger.GetRunDependencyTrigger()
+		// The type is validated when the job is created.
+		upstreamType, _ := common.ToModelResourceType(runDependencyTrigger.GetUpstream().GetType())
+		modelTrigger.RunDependencyTrigger = model.RunDependencyTrigger{
+			RunDependencyTriggerUpstreamType:  util.StringPointer(string(upstreamType)),
+			RunDependencyTriggerUpstreamId:    util.StringPointer(runDependencyTrigger.GetUpstream().GetId()),
+			RunDependencyTriggerParameterName: &runDependencyTrigger.ParameterName,
+		}
+	}
 	return modelTrigger
 }
 
@@ -284,6 +324,104 @@ func runtimeConfigToModelParameters(runtimeConfig *api.PipelineSpec_RuntimeConfi
 	return string(paramsBytes), nil
 }
 
+// ToRuntimeParameterValue converts a stored parameter of a v2 run back to a
+// runtime config value. The stored parameters don't keep their types, so
+// values that are valid JSON become numbers, booleans, lists or structs, and
+// the other values are strings.
+func ToRuntimeParameterValue(value string) *structpb.Value {
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err == nil && v != nil {
+		if converted, err := structpb.NewValue(v); err == nil {
+			return converted
+		}
+	}
+	return structpb.NewStringValue(value)
+}
+
+// toDependentApiRun returns the run that a succeeded upstream run triggers for
+// a job with a run dependency trigger. The ID of the upstream run is passed to
+// the run as the parameter of the trigger, and the run references both the job
+// and the upstream run.
+func toDependentApiRun(job *model.Job, upstreamRunId string) (*api.Run, error) {
+	var modelParams []v1alpha1.Parameter
+	if job.Parameters != "" {
+		if err := json.Unmarshal([]byte(job.Parameters), &modelParams); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse the parameters of job %v", job.UUID)
+		}
+	}
+	params := make(map[string]string)
+	var paramNames []string
+	for _, param := range modelParams {
+		params[param.Name] = param.Value.String()
+		paramNames = append(paramNames, param.Name)
+	}
+	if job.RunDependencyTriggerParameterName != nil {
+		name := *job.RunDependencyTriggerParameterName
+		if _, ok := params[name]; !ok {
+			paramNames = append(paramNames, name)
+		}
+		params[name] = upstreamRunId
+	}
+
+	references := []*api.ResourceReference{
+		{
+			Key:          &api.ResourceKey{Type: api.ResourceType_JOB, Id: job.UUID},
+			Relationship: api.Relationship_CREATOR,
+		},
+		{
+			Key:          &api.ResourceKey{Type: api.ResourceType_RUN, Id: upstreamRunId},
+			Relationship: api.Relationship_CREATOR,
+		},
+	}
+	usesPipelineVersion := false
+	for _, ref := range job.ResourceReferences {
+		switch ref.ReferenceType {
+		case common.Experiment:
+			references = append(references, &api.ResourceReference{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: ref.ReferenceUUID},
+				Relationship: api.Relationship_OWNER,
+			})
+		case common.PipelineVersion:
+			if ref.Relationship != common.Creator {
+				// The imported pipeline versions are resolved again.
+				continue
+			}
+			usesPipelineVersion = true
+			references = append(references, &api.ResourceReference{
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: ref.ReferenceUUID},
+				Relationship: api.Relationship_CREATOR,
+			})
+		}
+	}
+
+	spec := &api.PipelineSpec{}
+	if usesPipelineVersion {
+		// The manifest comes from the pipeline version.
+		spec.PipelineId = job.PipelineId
+	} else {
+		spec.WorkflowManifest = job.WorkflowSpecManifest
+		spec.PipelineManifest = job.PipelineSpecManifest
+	}
+	if job.PipelineSpecManifest == "" {
+		for _, name := range paramNames {
+			spec.Parameters = append(spec.Parameters, &api.Parameter{Name: name, Value: params[name]})
+		}
+	} else if len(paramNames) > 0 {
+		spec.RuntimeConfig = &api.PipelineSpec_RuntimeConfig{Parameters: make(map[string]*structpb.Value)}
+		for _, name := range paramNames {
+			spec.RuntimeConfig.Parameters[name] = ToRuntimeParameterValue(params[name])
+		}
+	}
+
+	return &api.Run{
+		Name:               job.DisplayName,
+		Description:        job.Description,
+		PipelineSpec:       spec,
+		ResourceReferences: references,
+		ServiceAccount:     job.ServiceAccount,
+	}, nil
+}
+
 func (r *ResourceManager) toModelResourceReferences(
 	resourceId string, resourceType model.ResourceType, apiRefs []*api.ResourceReference) ([]*model.ResourceReference, error) {
 	var modelRefs []*model.ResourceReference
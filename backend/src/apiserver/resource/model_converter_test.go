@@ -280,10 +280,11 @@ func TestToModelJob(t *testing.T) {
 	}{
 		{name: "v1",
 			apiJob: &api.Job{
-				Name:           "name1",
-				Enabled:        true,
-				MaxConcurrency: 1,
-				NoCatchup:      true,
+				Name:              "name1",
+				Enabled:           true,
+				MaxConcurrency:    1,
+				NoCatchup:         true,
+				ConcurrencyPolicy: api.Job_FORBID,
 				Trigger: &api.Trigger{
 					Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
 						StartTime: &timestamp.Timestamp{Seconds: 1},
@@ -318,8 +319,9 @@ func TestToModelJob(t *testing.T) {
 						Cron:                       util.StringPointer("1 * * * *"),
 					},
 				},
-				MaxConcurrency: 1,
-				NoCatchup:      true,
+				MaxConcurrency:    1,
+				NoCatchup:         true,
+				ConcurrencyPolicy: "Forbid",
 				PipelineSpec: model.PipelineSpec{
 					PipelineId:           pipeline.UUID,
 					PipelineName:         pipeline.Name,
@@ -481,6 +483,45 @@ func TestToModelResourceReferences_ReferredExperimentNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "Failed to find the referred resource")
 }
 
+func TestToDependentApiRun_PipelineVersion(t *testing.T) {
+	job := &model.Job{
+		UUID:        "job1",
+		DisplayName: "dependent",
+		Trigger: model.Trigger{
+			RunDependencyTrigger: model.RunDependencyTrigger{
+				RunDependencyTriggerUpstreamType:  util.StringPointer(string(common.Job)),
+				RunDependencyTriggerUpstreamId:    util.StringPointer("upstream-job"),
+				RunDependencyTriggerParameterName: util.StringPointer("upstream"),
+			},
+		},
+		PipelineSpec: model.PipelineSpec{
+			PipelineId:           "pipeline1",
+			PipelineSpecManifest: v2SpecHelloWorld,
+			Parameters:           `[{"name":"count","value":"3"}]`,
+		},
+		ResourceReferences: []*model.ResourceReference{
+			{ReferenceUUID: "exp1", ReferenceType: common.Experiment, Relationship: common.Owner},
+			{ReferenceUUID: "version1", ReferenceType: common.PipelineVersion, Relationship: common.Creator},
+		},
+	}
+	apiRun, err := toDependentApiRun(job, "run1")
+	assert.Nil(t, err)
+	assert.Equal(t, "dependent", apiRun.Name)
+	// The manifest comes from the pipeline version.
+	assert.Equal(t, "pipeline1", apiRun.PipelineSpec.PipelineId)
+	assert.Empty(t, apiRun.PipelineSpec.PipelineManifest)
+	assert.Equal(t, map[string]*structpb.Value{
+		"count":    structpb.NewNumberValue(3),
+		"upstream": structpb.NewStringValue("run1"),
+	}, apiRun.PipelineSpec.RuntimeConfig.Parameters)
+	assert.Equal(t, []*api.ResourceReference{
+		{Key: &api.ResourceKey{Type: api.ResourceType_JOB, Id: "job1"}, Relationship: api.Relationship_CREATOR},
+		{Key: &api.ResourceKey{Type: api.ResourceType_RUN, Id: "run1"}, Relationship: api.Relationship_CREATOR},
+		{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp1"}, Relationship: api.Relationship_OWNER},
+		{Key: &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "version1"}, Relationship: api.Relationship_CREATOR},
+	}, apiRun.ResourceReferences)
+}
+
 func TestToModelPipelineVersion(t *testing.T) {
 	store, manager := initResourceManager()
 	defer store.Close()
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
+	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	workflowclient "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/packer"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
@@ -36,6 +38,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	scheduledworkflowclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -64,6 +67,9 @@ type ClientManagerInterface interface {
 	JobStore() storage.JobStoreInterface
 	RunStore() storage.RunStoreInterface
 	TaskStore() storage.TaskStoreInterface
+	AuditEventStore() storage.AuditEventStoreInterface
+	AuditEventSink() storage.AuditEventSinkInterface
+	RunEventStore() storage.RunEventStoreInterface
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
@@ -77,49 +83,56 @@ type ClientManagerInterface interface {
 	Time() util.TimeInterface
 	UUID() util.UUIDGeneratorInterface
 	Authenticators() []kfpauth.Authenticator
+	Authorizer() kfpauth.Authorizer
 }
 
 type ResourceManager struct {
-	experimentStore           storage.ExperimentStoreInterface
-	pipelineStore             storage.PipelineStoreInterface
-	jobStore                  storage.JobStoreInterface
-	runStore                  storage.RunStoreInterface
-	taskStore                 storage.TaskStoreInterface
-	resourceReferenceStore    storage.ResourceReferenceStoreInterface
-	dBStatusStore             storage.DBStatusStoreInterface
-	defaultExperimentStore    storage.DefaultExperimentStoreInterface
-	objectStore               storage.ObjectStoreInterface
-	argoClient                client.ArgoClientInterface
-	swfClient                 client.SwfClientInterface
-	k8sCoreClient             client.KubernetesCoreInterface
-	subjectAccessReviewClient client.SubjectAccessReviewInterface
-	tokenReviewClient         client.TokenReviewInterface
-	logArchive                archive.LogArchiveInterface
-	time                      util.TimeInterface
-	uuid                      util.UUIDGeneratorInterface
-	authenticators            []kfpauth.Authenticator
+	experimentStore        storage.ExperimentStoreInterface
+	pipelineStore          storage.PipelineStoreInterface
+	jobStore               storage.JobStoreInterface
+	runStore               storage.RunStoreInterface
+	taskStore              storage.TaskStoreInterface
+	auditEventStore        storage.AuditEventStoreInterface
+	auditEventSink         storage.AuditEventSinkInterface
+	resourceReferenceStore storage.ResourceReferenceStoreInterface
+	dBStatusStore          storage.DBStatusStoreInterface
+	defaultExperimentStore storage.DefaultExperimentStoreInterface
+	objectStore            storage.ObjectStoreInterface
+	argoClient             client.ArgoClientInterface
+	swfClient              client.SwfClientInterface
+	k8sCoreClient          client.KubernetesCoreInterface
+	tokenReviewClient      client.TokenReviewInterface
+	logArchive             archive.LogArchiveInterface
+	time                   util.TimeInterface
+	uuid                   util.UUIDGeneratorInterface
+	authenticators         []kfpauth.Authenticator
+	authorizer             kfpauth.Authorizer
+	runEvents              *runEventBroadcaster
 }
 
 func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 	return &ResourceManager{
-		experimentStore:           clientManager.ExperimentStore(),
-		pipelineStore:             clientManager.PipelineStore(),
-		jobStore:                  clientManager.JobStore(),
-		runStore:                  clientManager.RunStore(),
-		taskStore:                 clientManager.TaskStore(),
-		resourceReferenceStore:    clientManager.ResourceReferenceStore(),
-		dBStatusStore:             clientManager.DBStatusStore(),
-		defaultExperimentStore:    clientManager.DefaultExperimentStore(),
-		objectStore:               clientManager.ObjectStore(),
-		argoClient:                clientManager.ArgoClient(),
-		swfClient:                 clientManager.SwfClient(),
-		k8sCoreClient:             clientManager.KubernetesCoreClient(),
-		subjectAccessReviewClient: clientManager.SubjectAccessReviewClient(),
-		tokenReviewClient:         clientManager.TokenReviewClient(),
-		logArchive:                clientManager.LogArchive(),
-		time:                      clientManager.Time(),
-		uuid:                      clientManager.UUID(),
-		authenticators:            clientManager.Authenticators(),
+		experimentStore:        clientManager.ExperimentStore(),
+		pipelineStore:          clientManager.PipelineStore(),
+		jobStore:               clientManager.JobStore(),
+		runStore:               clientManager.RunStore(),
+		taskStore:              clientManager.TaskStore(),
+		auditEventStore:        clientManager.AuditEventStore(),
+		auditEventSink:         clientManager.AuditEventSink(),
+		resourceReferenceStore: clientManager.ResourceReferenceStore(),
+		dBStatusStore:          clientManager.DBStatusStore(),
+		defaultExperimentStore: clientManager.DefaultExperimentStore(),
+		objectStore:            clientManager.ObjectStore(),
+		argoClient:             clientManager.ArgoClient(),
+		swfClient:              clientManager.SwfClient(),
+		k8sCoreClient:          clientManager.KubernetesCoreClient(),
+		tokenReviewClient:      clientManager.TokenReviewClient(),
+		logArchive:             clientManager.LogArchive(),
+		time:                   clientManager.Time(),
+		uuid:                   clientManager.UUID(),
+		authenticators:         clientManager.Authenticators(),
+		authorizer:             clientManager.Authorizer(),
+		runEvents:              newRunEventBroadcaster(clientManager.RunEventStore(), clientManager.RunStore().GetRun),
 	}
 }
 
@@ -257,7 +270,10 @@ func (r *ResourceManager) UpdatePipelineDefaultVersion(pipelineId string, versio
 	return r.pipelineStore.UpdatePipelineDefaultVersion(pipelineId, versionId)
 }
 
-func (r *ResourceManager) CreatePipeline(name string, description string, namespace string, pipelineFile []byte) (*model.Pipeline, error) {
+// CreatePipeline creates a pipeline and its default version. owner is the name
+// of the user that creates the pipeline, or "" if the request isn't
+// authenticated.
+func (r *ResourceManager) CreatePipeline(name string, description string, namespace string, owner string, pipelineFile []byte) (*model.Pipeline, error) {
 	tmpl, err := template.New(pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
@@ -276,6 +292,7 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 		Parameters:  paramsJSON,
 		Status:      model.PipelineCreating,
 		Namespace:   namespace,
+		Owner:       owner,
 		DefaultVersion: &model.PipelineVersion{
 			Name:       name,
 			Parameters: paramsJSON,
@@ -355,17 +372,9 @@ func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*mode
 	if err != nil {
 		return nil, err
 	}
-	runWorkflowOptions := template.RunWorkflowOptions{
-		RunId: runId,
-		RunAt: runAt,
-	}
-	executionSpec, err := tmpl.RunWorkflow(apiRun, runWorkflowOptions)
+	manifestBytes, err = r.inlinePipelineVersions(tmpl, manifestBytes, &apiRun.ResourceReferences)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "failed to generate the ExecutionSpec.")
-	}
-	workflow, ok := executionSpec.(*util.Workflow)
-	if !ok {
-		return nil, util.NewInternalServerError(errors.New("cast error"), "failed to cast back to Workflow")
+		return nil, err
 	}
 	// Add a reference to the default experiment if run does not already have a containing experiment
 	ref, err := r.getDefaultExperimentIfNoExperiment(apiRun.GetResourceReferences())
@@ -381,6 +390,19 @@ func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*mode
 		return nil, err
 	}
 
+	runWorkflowOptions := template.RunWorkflowOptions{
+		RunId:     runId,
+		RunAt:     runAt,
+		Namespace: namespace,
+	}
+	executionSpec, err := tmpl.RunWorkflow(apiRun, runWorkflowOptions)
+	if err != nil {
+		return nil, util.Wrap(err, "failed to generate the ExecutionSpec.")
+	}
+	workflow, ok := executionSpec.(*util.Workflow)
+	if !ok {
+		return nil, util.NewInternalServerError(errors.New("cast error"), "failed to cast back to Workflow")
+	}
 	_, err = validate.ValidateWorkflow(nil, nil, workflow.Workflow, validate.ValidateOpts{
 		Lint:                       false,
 		IgnoreEntrypoint:           false,
@@ -414,7 +436,12 @@ func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*mode
 
 	// Assign the create at time.
 	runDetail.CreatedAtInSec = runAt
-	return r.runStore.CreateRun(runDetail)
+	runDetail, err = r.runStore.CreateRun(runDetail)
+	if err != nil {
+		return nil, err
+	}
+	r.publishRunEvent(RunCreated, &runDetail.Run)
+	return runDetail, nil
 }
 
 func (r *ResourceManager) GetRun(runId string) (*model.RunDetail, error) {
@@ -427,7 +454,11 @@ func (r *ResourceManager) ListRuns(filterContext *common.FilterContext,
 }
 
 func (r *ResourceManager) ArchiveRun(runId string) error {
-	return r.runStore.ArchiveRun(runId)
+	if err := r.runStore.ArchiveRun(runId); err != nil {
+		return err
+	}
+	r.publishStoredRunEvent(RunArchived, runId)
+	return nil
 }
 
 func (r *ResourceManager) UnarchiveRun(runId string) error {
@@ -445,7 +476,11 @@ func (r *ResourceManager) UnarchiveRun(runId string) error {
 		return util.NewFailedPreconditionError(errors.New("Unarchive the experiment first to allow the run to be restored"),
 			fmt.Sprintf("Unarchive experiment with name `%s` first to allow run `%s` to be restored", experimentRef.ReferenceName, runId))
 	}
-	return r.runStore.UnarchiveRun(runId)
+	if err := r.runStore.UnarchiveRun(runId); err != nil {
+		return err
+	}
+	r.publishStoredRunEvent(RunUnarchived, runId)
+	return nil
 }
 
 func (r *ResourceManager) DeleteRun(ctx context.Context, runID string) error {
@@ -467,6 +502,7 @@ func (r *ResourceManager) DeleteRun(ctx context.Context, runID string) error {
 	if err != nil {
 		return util.Wrap(err, "Delete run failed")
 	}
+	r.publishRunEvent(RunDeleted, &runDetail.Run)
 	return nil
 }
 
@@ -494,6 +530,26 @@ func (r *ResourceManager) ListTasks(filterContext *common.FilterContext,
 	return r.taskStore.ListTasks(filterContext, opts)
 }
 
+// CreateAuditEvent stores an audit event, and writes it to the audit event
+// sink if there is one.
+func (r *ResourceManager) CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error) {
+	event, err := r.auditEventStore.CreateAuditEvent(event)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to store the audit event")
+	}
+	if r.auditEventSink != nil {
+		if err := r.auditEventSink.WriteAuditEvent(event); err != nil {
+			return event, util.Wrap(err, "Failed to write the audit event to the sink")
+		}
+	}
+	return event, nil
+}
+
+func (r *ResourceManager) ListAuditEvents(filterContext *common.FilterContext,
+	opts *list.Options) (events []*model.AuditEvent, totalSize int, nextPageToken string, err error) {
+	return r.auditEventStore.ListAuditEvents(filterContext, opts)
+}
+
 func (r *ResourceManager) ListJobs(filterContext *common.FilterContext,
 	opts *list.Options) (jobs []*model.Job, total_size int, nextPageToken string, err error) {
 	return r.jobStore.ListJobs(filterContext, opts)
@@ -501,10 +557,29 @@ func (r *ResourceManager) ListJobs(filterContext *common.FilterContext,
 
 // TerminateWorkflow terminates a workflow by setting its activeDeadlineSeconds to 0
 func TerminateWorkflow(ctx context.Context, wfClient workflowclient.WorkflowInterface, name string) error {
+	return patchWorkflowSpec(ctx, wfClient, name, map[string]interface{}{
+		"activeDeadlineSeconds": 0,
+	})
+}
+
+// SuspendWorkflow suspends a workflow by setting its suspend field, so that
+// Argo doesn't start new steps.
+func SuspendWorkflow(ctx context.Context, wfClient workflowclient.WorkflowInterface, name string) error {
+	return patchWorkflowSpec(ctx, wfClient, name, map[string]interface{}{
+		"suspend": true,
+	})
+}
+
+// ResumeWorkflow resumes a suspended workflow by removing its suspend field.
+func ResumeWorkflow(ctx context.Context, wfClient workflowclient.WorkflowInterface, name string) error {
+	return patchWorkflowSpec(ctx, wfClient, name, map[string]interface{}{
+		"suspend": nil,
+	})
+}
+
+func patchWorkflowSpec(ctx context.Context, wfClient workflowclient.WorkflowInterface, name string, spec map[string]interface{}) error {
 	patchObj := map[string]interface{}{
-		"spec": map[string]interface{}{
-			"activeDeadlineSeconds": 0,
-		},
+		"spec": spec,
 	}
 
 	patch, err := json.Marshal(patchObj)
@@ -544,6 +619,62 @@ func (r *ResourceManager) TerminateRun(ctx context.Context, runId string) error
 	return nil
 }
 
+func (r *ResourceManager) SuspendRun(ctx context.Context, runId string) error {
+	runDetail, err := r.checkRunExist(runId)
+	if err != nil {
+		return util.Wrap(err, "Suspend run failed")
+	}
+
+	namespace, err := r.GetNamespaceFromRunID(runId)
+	if err != nil {
+		return util.Wrap(err, "Suspend run failed")
+	}
+
+	err = r.runStore.SuspendRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Suspend run failed")
+	}
+
+	err = SuspendWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
+	if err != nil {
+		// The run is still running, restore its conditions.
+		if revertErr := r.runStore.RevertRunConditions(runId, model.RunSuspendedConditions, runDetail.Conditions); revertErr != nil {
+			glog.Errorf("Failed to revert the conditions of run %v after it couldn't be suspended. Error: %v", runId, revertErr)
+		}
+		return util.NewInternalServerError(err, "Failed to suspend the run")
+	}
+	r.publishStoredRunEvent(RunUpdated, runId)
+	return nil
+}
+
+func (r *ResourceManager) ResumeRun(ctx context.Context, runId string) error {
+	runDetail, err := r.checkRunExist(runId)
+	if err != nil {
+		return util.Wrap(err, "Resume run failed")
+	}
+
+	namespace, err := r.GetNamespaceFromRunID(runId)
+	if err != nil {
+		return util.Wrap(err, "Resume run failed")
+	}
+
+	err = r.runStore.ResumeRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Resume run failed")
+	}
+
+	err = ResumeWorkflow(ctx, r.getWorkflowClient(namespace), runDetail.Run.Name)
+	if err != nil {
+		// The run is still suspended, restore its conditions.
+		if revertErr := r.runStore.RevertRunConditions(runId, string(workflowapi.NodeRunning), runDetail.Conditions); revertErr != nil {
+			glog.Errorf("Failed to revert the conditions of run %v after it couldn't be resumed. Error: %v", runId, revertErr)
+		}
+		return util.NewInternalServerError(err, "Failed to resume the run")
+	}
+	r.publishStoredRunEvent(RunUpdated, runId)
+	return nil
+}
+
 func (r *ResourceManager) RetryRun(ctx context.Context, runId string) error {
 	runDetail, err := r.checkRunExist(runId)
 	if err != nil {
@@ -701,11 +832,14 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 	if err != nil {
 		return nil, err
 	}
-
-	scheduledWorkflow, err := tmpl.ScheduledWorkflow(apiJob)
+	manifestBytes, err = r.inlinePipelineVersions(tmpl, manifestBytes, &apiJob.ResourceReferences)
 	if err != nil {
-		return nil, util.Wrap(err, "failed to generate the scheduledWorkflow.")
+		return nil, err
+	}
+	if err := r.validateRunDependencyTrigger(apiJob); err != nil {
+		return nil, err
 	}
+
 	// Add a reference to the default experiment if run does not already have a containing experiment
 	ref, err := r.getDefaultExperimentIfNoExperiment(apiJob.GetResourceReferences())
 	if err != nil {
@@ -720,6 +854,10 @@ func (r *ResourceManager) CreateJob(ctx context.Context, apiJob *api.Job) (*mode
 		return nil, err
 	}
 
+	scheduledWorkflow, err := tmpl.ScheduledWorkflow(apiJob, namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "failed to generate the scheduledWorkflow.")
+	}
 	newScheduledWorkflow, err := r.getScheduledWorkflowClient(namespace).Create(ctx, scheduledWorkflow)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create a scheduled workflow for (%s)", scheduledWorkflow.Name)
@@ -770,6 +908,43 @@ func (r *ResourceManager) EnableJob(ctx context.Context, jobID string, enabled b
 	return nil
 }
 
+// BackfillJob makes the scheduled workflow of the job create the runs it would
+// have triggered between startTime and endTime, both inclusive.
+func (r *ResourceManager) BackfillJob(ctx context.Context, jobID string, startTime time.Time, endTime time.Time) error {
+	job, err := r.checkJobExist(ctx, jobID)
+	if err != nil {
+		return util.Wrap(err, "Backfill job failed")
+	}
+	if job.Cron == nil && job.IntervalSecond == nil {
+		return util.NewInvalidInputError("Job %v has no cron or periodic schedule to backfill.", jobID)
+	}
+	if endTime.Before(startTime) {
+		return util.NewInvalidInputError("The end time of the backfill %v is before its start time %v.",
+			endTime.UTC(), startTime.UTC())
+	}
+	if now := r.time.Now(); endTime.After(now) {
+		return util.NewInvalidInputError("The end time of the backfill %v is in the future. Now: %v.",
+			endTime.UTC(), now)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backfill": scheduledworkflow.Backfill{
+				StartTime: v1.NewTime(startTime.UTC()),
+				EndTime:   v1.NewTime(endTime.UTC()),
+			},
+		},
+	})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the backfill of job %v", jobID)
+	}
+	_, err = r.getScheduledWorkflowClient(job.Namespace).Patch(ctx, job.Name, types.MergePatchType, patch)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to backfill job CR. jobID: %v", jobID)
+	}
+	return nil
+}
+
 func (r *ResourceManager) DeleteJob(ctx context.Context, jobID string) error {
 	job, err := r.jobStore.GetJob(jobID)
 	if err != nil {
@@ -830,7 +1005,18 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 		*workflow.Spec.ActiveDeadlineSeconds == 0 &&
 		!workflow.IsInFinalState() {
 		condition = model.RunTerminatingConditions
+	} else if workflow.Spec.Suspend != nil &&
+		*workflow.Spec.Suspend &&
+		!workflow.IsInFinalState() {
+		// Argo keeps a suspended workflow Running.
+		condition = model.RunSuspendedConditions
+	}
+	// The run of a job doesn't exist before the first report.
+	previousRun, err := r.runStore.GetRun(runId)
+	if err != nil && !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrap(err, "Failed to get the reported run.")
 	}
+	var createdRun *model.Run
 	if jobId == "" {
 		// If a run doesn't have job ID, it's a one-time run created by Pipeline API server.
 		// In this case the DB entry should already been created when argo workflow CR is created.
@@ -908,6 +1094,24 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 		if err != nil {
 			return util.Wrap(err, "Failed to create or update the run.")
 		}
+		createdRun = &runDetail.Run
+	}
+	// Publish the run if it is new, or if its conditions changed.
+	if previousRun == nil && createdRun != nil {
+		r.publishRunEvent(RunCreated, createdRun)
+	} else if previousRun != nil && previousRun.Conditions != condition {
+		run := previousRun.Run
+		run.Conditions = condition
+		run.FinishedAtInSec = workflow.FinishedAt()
+		r.publishRunEvent(RunUpdated, &run)
+	}
+
+	// Trigger the dependent jobs before persisting the final state, so that
+	// the report is retried if a dependent run can't be created.
+	if workflow.IsSucceeded() && !workflow.PersistedFinalState() {
+		if err := r.triggerDependentJobs(ctx, runId, jobId); err != nil {
+			return util.Wrapf(err, "Failed to trigger the jobs depending on run %s", runId)
+		}
 	}
 
 	if workflow.IsInFinalState() {
@@ -928,6 +1132,239 @@ func (r *ResourceManager) ReportWorkflowResource(ctx context.Context, workflow *
 	return nil
 }
 
+// triggerDependentJobs creates a run for each enabled job with a run dependency
+// trigger on the job or on the pipeline version of a succeeded run. The jobs
+// that already created a run for the upstream run are skipped, so that
+// reporting the same workflow again doesn't create duplicate runs. The
+// concurrency policies of the jobs apply to the runs: if a job waits for its
+// active runs, an error is returned after the runs of the other jobs are
+// created, so that the report is retried later.
+func (r *ResourceManager) triggerDependentJobs(ctx context.Context, runId string, jobId string) error {
+	upstreams, err := r.getRunUpstreams(runId, jobId)
+	if err != nil {
+		return err
+	}
+	jobs, err := r.jobStore.ListDependentJobs(upstreams, false)
+	if err != nil {
+		return util.Wrap(err, "Failed to list the dependent jobs")
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	triggeredJobIds, err := r.getTriggeredJobIds(runId)
+	if err != nil {
+		return err
+	}
+	var waitingJobIds []string
+	for _, job := range jobs {
+		if triggeredJobIds[job.UUID] {
+			continue
+		}
+		runNow, wait, err := r.applyDependentJobConcurrencyPolicy(ctx, job)
+		if err != nil {
+			return util.Wrapf(err, "Failed to apply the concurrency policy of job %s", job.UUID)
+		}
+		if !runNow {
+			if wait {
+				waitingJobIds = append(waitingJobIds, job.UUID)
+			} else {
+				glog.Infof("Skipped the run of job %s for run %s, the job has active runs", job.UUID, runId)
+			}
+			continue
+		}
+		apiRun, err := toDependentApiRun(job, runId)
+		if err != nil {
+			return util.Wrapf(err, "Failed to create the run of job %s", job.UUID)
+		}
+		if _, err := r.CreateRun(ctx, apiRun); err != nil {
+			return util.Wrapf(err, "Failed to create the run of job %s", job.UUID)
+		}
+	}
+	if len(waitingJobIds) > 0 {
+		return util.NewResourceExhaustedError(errors.New("the jobs have too many active runs"),
+			"The jobs %v wait for their active runs to finish", waitingJobIds)
+	}
+	return nil
+}
+
+// applyDependentJobConcurrencyPolicy applies the concurrency policy of a job
+// to the run that a succeeded upstream run triggers, like the scheduled
+// workflow controller does for the workflows of the other triggers. It returns
+// whether the run should be created now, and if not, whether it waits for the
+// active runs of the job or is skipped. With the Replace policy, the active
+// runs are terminated.
+func (r *ResourceManager) applyDependentJobConcurrencyPolicy(ctx context.Context, job *model.Job) (
+	runNow bool, wait bool, err error) {
+	activeRunIds, err := r.runStore.ListActiveRunIds(job.UUID)
+	if err != nil {
+		return false, false, err
+	}
+	switch scheduledworkflow.ConcurrencyPolicy(job.ConcurrencyPolicy) {
+	case scheduledworkflow.ForbidConcurrent:
+		return len(activeRunIds) == 0, true, nil
+	case scheduledworkflow.SkipConcurrent:
+		return len(activeRunIds) == 0, false, nil
+	case scheduledworkflow.ReplaceConcurrent:
+		for _, activeRunId := range activeRunIds {
+			err := r.TerminateRun(ctx, activeRunId)
+			// The runs that are already terminating can't be terminated again.
+			if err != nil && !util.IsUserErrorCodeMatch(err, codes.InvalidArgument) {
+				return false, false, err
+			}
+		}
+		return true, false, nil
+	default:
+		maxConcurrency := job.MaxConcurrency
+		if maxConcurrency < 1 {
+			maxConcurrency = 1
+		}
+		return int64(len(activeRunIds)) < maxConcurrency, true, nil
+	}
+}
+
+// validateRunDependencyTrigger rejects the run dependency trigger of a new job
+// if the runs of the job would trigger the job again: if the upstream is the
+// pipeline version of the job, or if the runs of the job trigger other jobs
+// whose runs trigger the job, including the disabled jobs.
+func (r *ResourceManager) validateRunDependencyTrigger(apiJob *api.Job) error {
+	trigger := apiJob.GetTrigger().GetRunDependencyTrigger()
+	if trigger == nil {
+		return nil
+	}
+	upstreamType, err := common.ToModelResourceType(trigger.GetUpstream().GetType())
+	if err != nil {
+		return util.Wrap(err, "Run dependency trigger has an invalid upstream")
+	}
+	upstream := common.ReferenceKey{Type: upstreamType, ID: trigger.GetUpstream().GetId()}
+
+	var upstreams []*common.ReferenceKey
+	for _, ref := range apiJob.GetResourceReferences() {
+		if ref.GetKey().GetType() == api.ResourceType_PIPELINE_VERSION && ref.GetRelationship() == api.Relationship_CREATOR {
+			if upstream == (common.ReferenceKey{Type: common.PipelineVersion, ID: ref.GetKey().GetId()}) {
+				return util.NewInvalidInputError(
+					"Run dependency trigger can't refer to the pipeline version %q of the job", upstream.ID)
+			}
+			upstreams = append(upstreams, &common.ReferenceKey{Type: common.PipelineVersion, ID: ref.GetKey().GetId()})
+		}
+	}
+
+	// Walk the jobs that the runs of the job trigger, directly or not.
+	visited := make(map[string]bool)
+	for len(upstreams) > 0 {
+		jobs, err := r.jobStore.ListDependentJobs(upstreams, true)
+		if err != nil {
+			return util.Wrap(err, "Failed to list the dependent jobs")
+		}
+		upstreams = nil
+		for _, job := range jobs {
+			if visited[job.UUID] {
+				continue
+			}
+			visited[job.UUID] = true
+			jobUpstreams := []*common.ReferenceKey{{Type: common.Job, ID: job.UUID}}
+			for _, ref := range job.ResourceReferences {
+				if ref.ReferenceType == common.PipelineVersion && ref.Relationship == common.Creator {
+					jobUpstreams = append(jobUpstreams, &common.ReferenceKey{Type: common.PipelineVersion, ID: ref.ReferenceUUID})
+				}
+			}
+			for _, jobUpstream := range jobUpstreams {
+				if *jobUpstream == upstream {
+					return util.NewInvalidInputError(
+						"Run dependency trigger on %s %q makes a cycle: the runs of the job trigger job %q, whose runs trigger the job",
+						upstream.Type, upstream.ID, job.UUID)
+				}
+			}
+			upstreams = append(upstreams, jobUpstreams...)
+		}
+	}
+	return nil
+}
+
+// getRunUpstreams returns the job and the pipeline version of a run, which
+// the run dependency triggers of other jobs can refer to.
+func (r *ResourceManager) getRunUpstreams(runId string, jobId string) ([]*common.ReferenceKey, error) {
+	if jobId == "" {
+		// The runs that the API server creates for a job, such as the runs of
+		// run dependency triggers, reference their job.
+		jobRef, err := r.resourceReferenceStore.GetResourceReference(runId, common.Run, common.Job)
+		if err == nil {
+			jobId = jobRef.ReferenceUUID
+		} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			return nil, util.Wrap(err, "Failed to retrieve the job of the run")
+		}
+	}
+
+	var upstreams []*common.ReferenceKey
+	resourceId, resourceType := runId, common.Run
+	if jobId != "" {
+		upstreams = append(upstreams, &common.ReferenceKey{Type: common.Job, ID: jobId})
+		// The pipeline version of the runs of a job is the one of the job.
+		resourceId, resourceType = jobId, common.Job
+	}
+	versionRef, err := r.resourceReferenceStore.GetResourceReference(resourceId, resourceType, common.PipelineVersion)
+	if err == nil {
+		upstreams = append(upstreams, &common.ReferenceKey{Type: common.PipelineVersion, ID: versionRef.ReferenceUUID})
+	} else if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return nil, util.Wrap(err, "Failed to retrieve the pipeline version of the run")
+	}
+	return upstreams, nil
+}
+
+// getTriggeredJobIds returns the IDs of the jobs that created a run for an
+// upstream run.
+func (r *ResourceManager) getTriggeredJobIds(upstreamRunId string) (map[string]bool, error) {
+	refs, err := r.resourceReferenceStore.ListReferencesTo(upstreamRunId, common.Run, common.Run)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list the runs triggered by the run")
+	}
+	triggeredJobIds := make(map[string]bool)
+	for _, ref := range refs {
+		jobRef, err := r.resourceReferenceStore.GetResourceReference(ref.ResourceUUID, common.Run, common.Job)
+		if err != nil {
+			if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				continue
+			}
+			return nil, util.Wrap(err, "Failed to retrieve the job of a triggered run")
+		}
+		triggeredJobIds[jobRef.ReferenceUUID] = true
+	}
+	return triggeredJobIds, nil
+}
+
+// WatchRuns returns a watcher of the runs created, updated, archived,
+// unarchived or deleted by any API server replica. The caller must stop the
+// watcher.
+func (r *ResourceManager) WatchRuns() (*RunWatcher, error) {
+	watcher, err := r.runEvents.watch()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to watch the runs")
+	}
+	return watcher, nil
+}
+
+// publishRunEvent records the event for the run watchers. The change of the
+// run is already done, so a failure is only logged.
+func (r *ResourceManager) publishRunEvent(eventType RunEventType, run *model.Run) {
+	err := r.runEvents.record(&RunEvent{
+		Type:         eventType,
+		Run:          run,
+		EventAtInSec: r.time.Now().Unix(),
+	})
+	if err != nil {
+		glog.Errorf("Failed to record the %v event of run %v. Error: %v", eventType, run.UUID, err)
+	}
+}
+
+// publishStoredRunEvent publishes an event with the run as stored in the DB.
+func (r *ResourceManager) publishStoredRunEvent(eventType RunEventType, runId string) {
+	runDetail, err := r.runStore.GetRun(runId)
+	if err != nil {
+		glog.Errorf("Failed to get run %v to publish its %v event. Error: %v", runId, eventType, err)
+		return
+	}
+	r.publishRunEvent(eventType, &runDetail.Run)
+}
+
 // AddWorkflowLabel add label for a workflow
 func AddWorkflowLabel(ctx context.Context, wfClient workflowclient.WorkflowInterface, name string, labelKey string, labelValue string) error {
 	patchObj := map[string]interface{}{
@@ -1030,6 +1467,64 @@ func getManifestBytes(pipelineSpec *api.PipelineSpec, resourceReferences *[]*api
 	return manifestBytes, nil
 }
 
+// inlinePipelineVersions inlines the pipeline versions that a v2 pipeline
+// imports, and references them with IMPORTED relationships instead of the
+// IMPORTED references of the request. It returns the manifest of the pipeline
+// with the imported pipeline versions.
+func (r *ResourceManager) inlinePipelineVersions(tmpl template.Template, manifestBytes []byte, resourceReferences *[]*api.ResourceReference) ([]byte, error) {
+	var pipelineVersionId string
+	references := make([]*api.ResourceReference, 0, len(*resourceReferences))
+	for _, reference := range *resourceReferences {
+		if reference.GetRelationship() == api.Relationship_IMPORTED {
+			continue
+		}
+		if reference.GetKey().GetType() == api.ResourceType_PIPELINE_VERSION && reference.GetRelationship() == api.Relationship_CREATOR {
+			pipelineVersionId = reference.GetKey().GetId()
+		}
+		references = append(references, reference)
+	}
+	*resourceReferences = references
+	v2Spec, ok := tmpl.(*template.V2Spec)
+	if !ok {
+		return manifestBytes, nil
+	}
+	importedIds, err := v2Spec.InlinePipelineVersions(pipelineVersionId, r.getImportedPipelineVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(importedIds) == 0 {
+		return manifestBytes, nil
+	}
+	for _, id := range importedIds {
+		*resourceReferences = append(*resourceReferences, &api.ResourceReference{
+			Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: id},
+			Relationship: api.Relationship_IMPORTED,
+		})
+	}
+	return v2Spec.Bytes(), nil
+}
+
+// getImportedPipelineVersion returns the pipeline spec of a pipeline version
+// that a pipeline imports.
+func (r *ResourceManager) getImportedPipelineVersion(versionId string) (*template.V2Spec, error) {
+	if _, err := r.pipelineStore.GetPipelineVersion(versionId); err != nil {
+		return nil, util.Wrapf(err, "Failed to get imported pipeline version %s", versionId)
+	}
+	manifestBytes, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(versionId))
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get the manifest of imported pipeline version %s", versionId)
+	}
+	tmpl, err := template.New(manifestBytes)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to parse the manifest of imported pipeline version %s", versionId)
+	}
+	v2Spec, ok := tmpl.(*template.V2Spec)
+	if !ok {
+		return nil, util.NewInvalidInputError("Imported pipeline version %s is not a v2 pipeline", versionId)
+	}
+	return v2Spec, nil
+}
+
 // Used to initialize the Experiment database with a default to be used for runs
 func (r *ResourceManager) CreateDefaultExperiment() (string, error) {
 	// First check that we don't already have a default experiment ID in the DB.
@@ -1252,53 +1747,26 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 	return template, nil
 }
 
-func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (string, error) {
+func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (*kfpauth.UserInfo, error) {
 	if ctx == nil {
-		return "", util.NewUnauthenticatedError(errors.New("Request error: context is nil"), "Request error: context is nil.")
+		return nil, util.NewUnauthenticatedError(errors.New("Request error: context is nil"), "Request error: context is nil.")
 	}
 
 	// If the request header contains the user identity, requests are authorized
 	// based on the namespace field in the request.
 	var errlist []error
 	for _, auth := range r.authenticators {
-		userIdentity, err := auth.GetUserIdentity(ctx)
+		user, err := kfpauth.GetUserInfo(ctx, auth)
 		if err == nil {
-			return userIdentity, nil
+			return user, nil
 		}
 		errlist = append(errlist, err)
 	}
-	return "", utilerrors.NewAggregate(errlist)
+	return nil, utilerrors.NewAggregate(errlist)
 }
 
-func (r *ResourceManager) IsRequestAuthorized(ctx context.Context, userIdentity string, resourceAttributes *authorizationv1.ResourceAttributes) error {
-	result, err := r.subjectAccessReviewClient.Create(
-		ctx,
-		&authorizationv1.SubjectAccessReview{
-			Spec: authorizationv1.SubjectAccessReviewSpec{
-				ResourceAttributes: resourceAttributes,
-				User:               userIdentity,
-			},
-		},
-		v1.CreateOptions{},
-	)
-	if err != nil {
-		return util.NewInternalServerError(
-			err,
-			"Failed to create SubjectAccessReview for user '%s' (request: %+v)",
-			userIdentity,
-			resourceAttributes,
-		)
-	}
-	if !result.Status.Allowed {
-		return util.NewPermissionDeniedError(
-			errors.New("Unauthorized access"),
-			"User '%s' is not authorized with reason: %s (request: %+v)",
-			userIdentity,
-			result.Status.Reason,
-			resourceAttributes,
-		)
-	}
-	return nil
+func (r *ResourceManager) IsRequestAuthorized(ctx context.Context, user *kfpauth.UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	return r.authorizer.Authorize(ctx, user, resourceAttributes)
 }
 
 func (r *ResourceManager) GetNamespaceFromExperimentID(experimentID string) (string, error) {
@@ -39,6 +39,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -96,7 +97,7 @@ func initWithPipeline(t *testing.T) (*FakeClientManager, *ResourceManager, *mode
 	initEnvVars()
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	manager := NewResourceManager(store)
-	p, err := manager.CreatePipeline("p1", "", "ns1", []byte(testWorkflow.ToStringForStore()))
+	p, err := manager.CreatePipeline("p1", "", "ns1", "", []byte(testWorkflow.ToStringForStore()))
 	assert.Nil(t, err)
 	return store, manager, p
 }
@@ -118,7 +119,7 @@ func initWithExperimentAndPipeline(t *testing.T) (*FakeClientManager, *ResourceM
 	apiExperiment := &api.Experiment{Name: "e1"}
 	experiment, err := manager.CreateExperiment(apiExperiment)
 	assert.Nil(t, err)
-	pipeline, err := manager.CreatePipeline("p1", "", "", []byte(testWorkflow.ToStringForStore()))
+	pipeline, err := manager.CreatePipeline("p1", "", "", "", []byte(testWorkflow.ToStringForStore()))
 	assert.Nil(t, err)
 	return store, manager, experiment, pipeline
 }
@@ -189,9 +190,14 @@ func initWithJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job
 func initWithJobV2(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
 	store, manager, exp := initWithExperiment(t)
 	job := &api.Job{
-		Name:         "j1",
-		Enabled:      true,
-		PipelineSpec: &api.PipelineSpec{PipelineManifest: v2SpecHelloWorld},
+		Name:    "j1",
+		Enabled: true,
+		PipelineSpec: &api.PipelineSpec{
+			PipelineManifest: v2SpecHelloWorld,
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("world")},
+			},
+		},
 		ResourceReferences: []*api.ResourceReference{
 			{
 				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
@@ -233,6 +239,9 @@ func initWithOneTimeRunV2(t *testing.T) (*FakeClientManager, *ResourceManager, *
 		Name: "run1",
 		PipelineSpec: &api.PipelineSpec{
 			PipelineManifest: v2SpecHelloWorld,
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("world")},
+			},
 		},
 		ResourceReferences: []*api.ResourceReference{
 			{
@@ -466,6 +475,7 @@ func TestCreatePipeline(t *testing.T) {
 				test.name,
 				test.description,
 				"",
+				"",
 				// Do not upload test.template here, because pipeline API is out of test scope.
 				[]byte(test.template),
 			)
@@ -711,6 +721,7 @@ func TestCreateRun_ThroughWorkflowSpecV2(t *testing.T) {
 			CreatedAtInSec: 2,
 			PipelineSpec: model.PipelineSpec{
 				PipelineSpecManifest: v2SpecHelloWorld,
+				Parameters:           `[{"name":"text","value":"world"}]`,
 			},
 			ResourceReferences: []*model.ResourceReference{
 				{
@@ -731,6 +742,199 @@ func TestCreateRun_ThroughWorkflowSpecV2(t *testing.T) {
 	assert.Equal(t, expectedRunDetail, runDetail, "CreateRun stored invalid data in database")
 }
 
+func TestCreateRun_InvalidV2Parameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters map[string]*structpb.Value
+		errorMsg   string
+	}{
+		{"missing", nil, `input parameter "text" is required`},
+		{"unknown", map[string]*structpb.Value{
+			"text":  structpb.NewStringValue("world"),
+			"other": structpb.NewStringValue("world"),
+		}, `Unknown pipeline input parameters ["other"]`},
+		{"null", map[string]*structpb.Value{"text": structpb.NewNullValue()}, `got null for input parameter "text"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, manager, exp := initWithExperiment(t)
+			defer store.Close()
+			apiRun := &api.Run{
+				Name: "run1",
+				PipelineSpec: &api.PipelineSpec{
+					PipelineManifest: v2SpecHelloWorld,
+					RuntimeConfig:    &api.PipelineSpec_RuntimeConfig{Parameters: tt.parameters},
+				},
+				ResourceReferences: []*api.ResourceReference{
+					{
+						Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+						Relationship: api.Relationship_OWNER,
+					},
+				},
+			}
+			_, err := manager.CreateRun(context.Background(), apiRun)
+			require.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errorMsg)
+			assert.Equal(t, 0, store.ArgoClientFake.GetWorkflowCount())
+		})
+	}
+}
+
+// v2SpecImporting returns a v2 pipeline spec whose component comp-sub
+// imports a pipeline version.
+func v2SpecImporting(versionId string) string {
+	return fmt.Sprintf(`{
+  "components": {
+    "comp-sub": {
+      "pipelineVersionId": %q
+    }
+  },
+  "deploymentSpec": {},
+  "pipelineInfo": {
+    "name": "importing"
+  },
+  "root": {
+    "dag": {
+      "tasks": {
+        "sub": {
+          "componentRef": {"name": "comp-sub"},
+          "inputs": {"parameters": {"text": {"componentInputParameter": "text"}}},
+          "taskInfo": {"name": "sub"}
+        }
+      }
+    },
+    "inputDefinitions": {"parameters": {"text": {"type": "STRING"}}}
+  },
+  "schemaVersion": "2.0.0"
+}`, versionId)
+}
+
+func TestCreateRun_ImportPipelineVersion(t *testing.T) {
+	store, manager, experiment, pipeline := initWithExperimentAndPipeline(t)
+	defer store.Close()
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	version, err := manager.CreatePipelineVersion(&api.PipelineVersion{
+		Name: "hello_world_version",
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Id: pipeline.UUID, Type: api.ResourceType_PIPELINE},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}, []byte(v2SpecHelloWorld), true)
+	require.Nil(t, err)
+
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			PipelineManifest: v2SpecImporting(version.UUID),
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("world")},
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+			{
+				// Replaced by the pipeline versions that the pipeline imports.
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "stale"},
+				Relationship: api.Relationship_IMPORTED,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	require.Nil(t, err)
+	assert.Contains(t, runDetail.PipelineSpecManifest, `"comp-sub-comp-hello-world"`)
+	assert.NotContains(t, runDetail.PipelineSpecManifest, "pipelineVersionId")
+
+	run, err := manager.GetRun(runDetail.UUID)
+	require.Nil(t, err)
+	var importedRefs []*model.ResourceReference
+	for _, ref := range run.ResourceReferences {
+		if ref.Relationship == common.Imported {
+			ref.Payload = ""
+			importedRefs = append(importedRefs, ref)
+		}
+	}
+	assert.Equal(t, []*model.ResourceReference{{
+		ResourceUUID:  runDetail.UUID,
+		ResourceType:  common.Run,
+		ReferenceUUID: version.UUID,
+		ReferenceName: "hello_world_version",
+		ReferenceType: common.PipelineVersion,
+		Relationship:  common.Imported,
+	}}, importedRefs)
+}
+
+func TestCreateRun_ImportPipelineVersion_Errors(t *testing.T) {
+	store, manager, experiment, pipeline := initWithExperimentAndPipeline(t)
+	defer store.Close()
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	assert.True(t, ok)
+	pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	// The pipeline version imports itself.
+	version, err := manager.CreatePipelineVersion(&api.PipelineVersion{
+		Name: "importing_version",
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Id: pipeline.UUID, Type: api.ResourceType_PIPELINE},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}, []byte(v2SpecImporting(FakeUUIDOne)), true)
+	require.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		spec       *api.PipelineSpec
+		references []*api.ResourceReference
+		code       codes.Code
+		errorMsg   string
+	}{
+		{
+			name: "cycle",
+			spec: &api.PipelineSpec{},
+			references: []*api.ResourceReference{{
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: version.UUID},
+				Relationship: api.Relationship_CREATOR,
+			}},
+			code:     codes.InvalidArgument,
+			errorMsg: fmt.Sprintf("cycle in pipeline version imports: %s -> %s", version.UUID, version.UUID),
+		},
+		{
+			name:     "not found",
+			spec:     &api.PipelineSpec{PipelineManifest: v2SpecImporting("missing")},
+			code:     codes.NotFound,
+			errorMsg: "Failed to get imported pipeline version missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.RuntimeConfig = &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("world")},
+			}
+			apiRun := &api.Run{
+				Name:         "run1",
+				PipelineSpec: tt.spec,
+				ResourceReferences: append([]*api.ResourceReference{{
+					Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+					Relationship: api.Relationship_OWNER,
+				}}, tt.references...),
+			}
+			_, err := manager.CreateRun(context.Background(), apiRun)
+			require.NotNil(t, err)
+			assert.Equal(t, tt.code, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errorMsg)
+			assert.Equal(t, 0, store.ArgoClientFake.GetWorkflowCount())
+		})
+	}
+}
+
 func TestCreateRun_ThroughWorkflowSpec(t *testing.T) {
 	store, manager, runDetail := initWithOneTimeRun(t)
 	expectedExperimentUUID := runDetail.ExperimentUUID
@@ -1326,6 +1530,83 @@ func TestTerminateRun_DbFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "database is closed")
 }
 
+func TestSuspendAndResumeRun(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+	watcher, err := manager.WatchRuns()
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	err = manager.SuspendRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Suspended", actualRunDetail.Conditions)
+	isSuspended, err := store.ArgoClientFake.IsSuspended(runDetail.Run.Name)
+	assert.Nil(t, err)
+	assert.True(t, isSuspended)
+	event := nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunUpdated, event.Type)
+	assert.Equal(t, "Suspended", event.Run.Conditions)
+
+	err = manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Nil(t, err)
+	actualRunDetail, err = manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", actualRunDetail.Conditions)
+	isSuspended, err = store.ArgoClientFake.IsSuspended(runDetail.Run.Name)
+	assert.Nil(t, err)
+	assert.False(t, isSuspended)
+	event = nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunUpdated, event.Type)
+	assert.Equal(t, "Running", event.Run.Conditions)
+}
+
+func TestSuspendRun_WorkflowPatchFailed(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+	manager.argoClient = client.NewFakeArgoClientWithBadWorkflow()
+
+	err := manager.SuspendRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Failed to suspend the run")
+	// The run is not marked as suspended, so that it can be suspended again.
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, runDetail.Conditions, actualRunDetail.Conditions)
+}
+
+func TestResumeRun_WorkflowPatchFailed(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+	require.Nil(t, manager.SuspendRun(context.Background(), runDetail.UUID))
+	manager.argoClient = client.NewFakeArgoClientWithBadWorkflow()
+
+	err := manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Failed to resume the run")
+	actualRunDetail, err := manager.GetRun(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RunSuspendedConditions, actualRunDetail.Conditions)
+}
+
+func TestSuspendRun_RunNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	err := manager.SuspendRun(context.Background(), "1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestResumeRun_NotSuspended(t *testing.T) {
+	store, manager, runDetail := initWithOneTimeRun(t)
+	defer store.Close()
+
+	err := manager.ResumeRun(context.Background(), runDetail.UUID)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestRetryRun(t *testing.T) {
 	store, manager, runDetail := initWithOneTimeFailedRun(t)
 	defer store.Close()
@@ -1464,6 +1745,7 @@ func TestCreateJob_ThroughWorkflowSpecV2(t *testing.T) {
 		Conditions:     "NO_STATUS",
 		PipelineSpec: model.PipelineSpec{
 			PipelineSpecManifest: v2SpecHelloWorld,
+			Parameters:           `[{"name":"text","value":"world"}]`,
 		},
 		ResourceReferences: []*model.ResourceReference{
 			{
@@ -1797,6 +2079,52 @@ func TestCreateJob_ExtraInputParameterError(t *testing.T) {
 	assert.Contains(t, err.Error(), "Unrecognized input parameter: param2")
 }
 
+func TestCreateJob_BucketObjectTriggerParameter(t *testing.T) {
+	tests := []struct {
+		name          string
+		pipelineSpec  *api.PipelineSpec
+		parameterName string
+		errorMsg      string
+	}{
+		{"v1", &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()}, "param1", ""},
+		{"v1 unknown", &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()}, "other",
+			"Unrecognized input parameter: other"},
+		// The parameter of the trigger doesn't need a value in the runtime config.
+		{"v2", &api.PipelineSpec{PipelineManifest: v2SpecHelloWorld}, "text", ""},
+		{"v2 unknown", &api.PipelineSpec{PipelineManifest: v2SpecHelloWorld}, "other",
+			`The parameter "other" of the trigger is not a pipeline input parameter`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, manager, exp := initWithExperiment(t)
+			defer store.Close()
+			job := &api.Job{
+				Name:         "j1",
+				Enabled:      true,
+				PipelineSpec: tt.pipelineSpec,
+				Trigger: &api.Trigger{Trigger: &api.Trigger_BucketObjectTrigger{BucketObjectTrigger: &api.BucketObjectTrigger{
+					Bucket:        "minio://mlpipeline/incoming",
+					ParameterName: tt.parameterName,
+				}}},
+				ResourceReferences: []*api.ResourceReference{
+					{
+						Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+						Relationship: api.Relationship_OWNER,
+					},
+				},
+			}
+			_, err := manager.CreateJob(context.Background(), job)
+			if tt.errorMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
 func TestCreateJob_FailedToCreateScheduleWorkflow(t *testing.T) {
 	store, manager, p := initWithPipeline(t)
 	defer store.Close()
@@ -1875,6 +2203,69 @@ func TestEnableJob_CustomResourceNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func initWithPeriodicJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job) {
+	store, manager, exp := initWithExperiment(t)
+	job := &api.Job{
+		Name:    "j1",
+		Enabled: true,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &api.PeriodicSchedule{IntervalSecond: 3600}},
+		},
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	j, err := manager.CreateJob(context.Background(), job)
+	require.Nil(t, err)
+	return store, manager, j
+}
+
+func TestBackfillJob(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	err := manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1, 0))
+	require.Nil(t, err)
+
+	swf, err := manager.getScheduledWorkflowClient(job.Namespace).Get(context.Background(), job.Name, v1.GetOptions{})
+	require.Nil(t, err)
+	require.NotNil(t, swf.Spec.Backfill)
+	assert.Equal(t, int64(0), swf.Spec.Backfill.StartTime.Unix())
+	assert.Equal(t, int64(1), swf.Spec.Backfill.EndTime.Unix())
+}
+
+func TestBackfillJob_InvalidTimeRange(t *testing.T) {
+	store, manager, job := initWithPeriodicJob(t)
+	defer store.Close()
+	err := manager.BackfillJob(context.Background(), job.UUID, time.Unix(1, 0), time.Unix(0, 0))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "is before its start time")
+
+	err = manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1000, 0))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "is in the future")
+}
+
+func TestBackfillJob_NoSchedule(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	err := manager.BackfillJob(context.Background(), job.UUID, time.Unix(0, 0), time.Unix(1, 0))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "no cron or periodic schedule")
+}
+
+func TestBackfillJob_JobNotExist(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	err := manager.BackfillJob(context.Background(), "1", time.Unix(0, 0), time.Unix(1, 0))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Job 1 not found")
+}
+
 func TestDisableJob_CustomResourceNotFound(t *testing.T) {
 	store, manager, job := initWithJob(t)
 	defer store.Close()
@@ -2002,6 +2393,33 @@ func TestReportWorkflowResource_ScheduledWorkflowIDEmpty_Success(t *testing.T) {
 	assert.Equal(t, expectedRun, runDetail.Run)
 }
 
+func TestReportWorkflowResource_SuspendedWorkflow(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	suspend := true
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+			Namespace: "ns1",
+		},
+		Spec:   v1alpha1.WorkflowSpec{Suspend: &suspend},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning},
+	})
+	err := manager.ReportWorkflowResource(context.Background(), workflow)
+	assert.Nil(t, err)
+	runDetail, err := manager.GetRun(run.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Suspended", runDetail.Conditions)
+
+	workflow.Spec.Suspend = nil
+	err = manager.ReportWorkflowResource(context.Background(), workflow)
+	assert.Nil(t, err)
+	runDetail, err = manager.GetRun(run.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Conditions)
+}
+
 func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_Success(t *testing.T) {
 	store, manager, job := initWithJob(t)
 	defer store.Close()
@@ -2067,6 +2485,268 @@ func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_Success(t *testing.T
 	assert.Equal(t, expectedRunDetail, runDetail)
 }
 
+func TestReportWorkflowResource_RunDependencyTrigger(t *testing.T) {
+	store, manager, job := initWithJob(t)
+	defer store.Close()
+	_, err := store.JobStore().CreateJob(&model.Job{
+		UUID:           "DEPENDENT_JOB",
+		DisplayName:    "dependent",
+		Name:           "dependent",
+		Namespace:      "ns1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: model.Trigger{
+			RunDependencyTrigger: model.RunDependencyTrigger{
+				RunDependencyTriggerUpstreamType:  util.StringPointer(string(common.Job)),
+				RunDependencyTriggerUpstreamId:    util.StringPointer(job.UUID),
+				RunDependencyTriggerParameterName: util.StringPointer("param1"),
+			},
+		},
+		PipelineSpec: model.PipelineSpec{
+			WorkflowSpecManifest: testWorkflow.ToStringForStore(),
+			Parameters:           `[{"name":"param1","value":"world"}]`,
+		},
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID:  "DEPENDENT_JOB",
+				ResourceType:  common.Job,
+				ReferenceUUID: DefaultFakeUUID,
+				ReferenceName: "e1",
+				ReferenceType: common.Experiment,
+				Relationship:  common.Owner,
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	newWorkflow := func(phase v1alpha1.WorkflowPhase) *util.Workflow {
+		return util.NewWorkflow(&v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "MY_NAME",
+				Namespace: "MY_NAMESPACE",
+				UID:       "WORKFLOW_1",
+				Labels:    map[string]string{util.LabelKeyWorkflowRunId: "WORKFLOW_1"},
+				OwnerReferences: []v1.OwnerReference{{
+					APIVersion: "kubeflow.org/v1beta1",
+					Kind:       "ScheduledWorkflow",
+					Name:       "SCHEDULE_NAME",
+					UID:        types.UID(job.UUID),
+				}},
+			},
+			Status: v1alpha1.WorkflowStatus{Phase: phase},
+		})
+	}
+	_, err = store.ArgoClientFake.Workflow("MY_NAMESPACE").Create(
+		context.Background(), newWorkflow(v1alpha1.WorkflowRunning).Workflow, v1.CreateOptions{})
+	require.Nil(t, err)
+
+	// The runs that didn't succeed don't trigger the dependent jobs.
+	err = manager.ReportWorkflowResource(context.Background(), newWorkflow(v1alpha1.WorkflowRunning))
+	assert.Nil(t, err)
+	refs, err := store.ResourceReferenceStore().ListReferencesTo("WORKFLOW_1", common.Run, common.Run)
+	assert.Nil(t, err)
+	assert.Empty(t, refs)
+
+	err = manager.ReportWorkflowResource(context.Background(), newWorkflow(v1alpha1.WorkflowSucceeded))
+	assert.Nil(t, err)
+	refs, err = store.ResourceReferenceStore().ListReferencesTo("WORKFLOW_1", common.Run, common.Run)
+	assert.Nil(t, err)
+	require.Len(t, refs, 1)
+	runDetail, err := manager.GetRun(refs[0].ResourceUUID)
+	require.Nil(t, err)
+	assert.Equal(t, "dependent", runDetail.DisplayName)
+	assert.Equal(t, `[{"name":"param1","value":"WORKFLOW_1"}]`, runDetail.Parameters)
+	assert.ElementsMatch(t, []*model.ResourceReference{
+		{
+			ResourceUUID:  runDetail.UUID,
+			ResourceType:  common.Run,
+			ReferenceUUID: "DEPENDENT_JOB",
+			ReferenceName: "dependent",
+			ReferenceType: common.Job,
+			Relationship:  common.Creator,
+		},
+		{
+			ResourceUUID:  runDetail.UUID,
+			ResourceType:  common.Run,
+			ReferenceUUID: "WORKFLOW_1",
+			ReferenceName: "MY_NAME",
+			ReferenceType: common.Run,
+			Relationship:  common.Creator,
+		},
+		{
+			ResourceUUID:  runDetail.UUID,
+			ResourceType:  common.Run,
+			ReferenceUUID: DefaultFakeUUID,
+			ReferenceName: "e1",
+			ReferenceType: common.Experiment,
+			Relationship:  common.Owner,
+		},
+	}, runDetail.ResourceReferences)
+
+	// Reporting the workflow again doesn't trigger the job again.
+	err = manager.ReportWorkflowResource(context.Background(), newWorkflow(v1alpha1.WorkflowSucceeded))
+	assert.Nil(t, err)
+	refs, err = store.ResourceReferenceStore().ListReferencesTo("WORKFLOW_1", common.Run, common.Run)
+	assert.Nil(t, err)
+	assert.Len(t, refs, 1)
+}
+
+func TestReportWorkflowResource_RunDependencyTrigger_ConcurrencyPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		concurrencyPolicy string
+		wantCode          codes.Code
+	}{
+		// The second run of the upstream job waits for the dependent run.
+		{"forbid", string(swfapi.ForbidConcurrent), codes.ResourceExhausted},
+		{"allow", "", codes.ResourceExhausted},
+		// The second run of the upstream job doesn't trigger the dependent job.
+		{"skip", string(swfapi.SkipConcurrent), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, manager, job := initWithJob(t)
+			defer store.Close()
+			_, err := store.JobStore().CreateJob(&model.Job{
+				UUID:              "DEPENDENT_JOB",
+				DisplayName:       "dependent",
+				Name:              "dependent",
+				Namespace:         "ns1",
+				Enabled:           true,
+				MaxConcurrency:    1,
+				ConcurrencyPolicy: tt.concurrencyPolicy,
+				Trigger: model.Trigger{
+					RunDependencyTrigger: model.RunDependencyTrigger{
+						RunDependencyTriggerUpstreamType: util.StringPointer(string(common.Job)),
+						RunDependencyTriggerUpstreamId:   util.StringPointer(job.UUID),
+					},
+				},
+				PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+				ResourceReferences: []*model.ResourceReference{
+					{
+						ResourceUUID:  "DEPENDENT_JOB",
+						ResourceType:  common.Job,
+						ReferenceUUID: DefaultFakeUUID,
+						ReferenceName: "e1",
+						ReferenceType: common.Experiment,
+						Relationship:  common.Owner,
+					},
+				},
+			})
+			require.Nil(t, err)
+
+			newWorkflow := func(runId string) *util.Workflow {
+				return util.NewWorkflow(&v1alpha1.Workflow{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "NAME_" + runId,
+						Namespace: "MY_NAMESPACE",
+						UID:       types.UID(runId),
+						Labels:    map[string]string{util.LabelKeyWorkflowRunId: runId},
+						OwnerReferences: []v1.OwnerReference{{
+							APIVersion: "kubeflow.org/v1beta1",
+							Kind:       "ScheduledWorkflow",
+							Name:       "SCHEDULE_NAME",
+							UID:        types.UID(job.UUID),
+						}},
+					},
+					Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded},
+				})
+			}
+			for _, runId := range []string{"WORKFLOW_1", "WORKFLOW_2"} {
+				_, err = store.ArgoClientFake.Workflow("MY_NAMESPACE").Create(
+					context.Background(), newWorkflow(runId).Workflow, v1.CreateOptions{})
+				require.Nil(t, err)
+			}
+
+			// The dependent run of the first run is still active.
+			err = manager.ReportWorkflowResource(context.Background(), newWorkflow("WORKFLOW_1"))
+			require.Nil(t, err)
+			err = manager.ReportWorkflowResource(context.Background(), newWorkflow("WORKFLOW_2"))
+			if tt.wantCode == codes.OK {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.True(t, util.IsUserErrorCodeMatch(err, tt.wantCode), err.Error())
+			}
+			refs, err := store.ResourceReferenceStore().ListReferencesTo("WORKFLOW_2", common.Run, common.Run)
+			assert.Nil(t, err)
+			assert.Empty(t, refs)
+		})
+	}
+}
+
+func TestCreateJob_RunDependencyTrigger_Cycle(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	pipelineStore, ok := store.pipelineStore.(*storage.PipelineStore)
+	require.True(t, ok)
+	newJob := func(versionId string, upstream *api.ResourceKey) *api.Job {
+		return &api.Job{
+			Name:    "j1",
+			Enabled: true,
+			Trigger: &api.Trigger{Trigger: &api.Trigger_RunDependencyTrigger{RunDependencyTrigger: &api.RunDependencyTrigger{
+				Upstream: upstream,
+			}}},
+			ResourceReferences: []*api.ResourceReference{
+				{
+					Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+					Relationship: api.Relationship_OWNER,
+				},
+				{
+					Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: versionId},
+					Relationship: api.Relationship_CREATOR,
+				},
+			},
+		}
+	}
+	var versionIds []string
+	for _, uuid := range []string{FakeUUIDOne, NonDefaultFakeUUID} {
+		pipelineStore.SetUUIDGenerator(util.NewFakeUUIDGeneratorOrFatal(uuid, nil))
+		p, err := manager.CreatePipeline("p"+uuid, "", "", "", []byte(testWorkflow.ToStringForStore()))
+		require.Nil(t, err)
+		versionIds = append(versionIds, p.DefaultVersionId)
+	}
+
+	// The runs of the job would trigger the job.
+	_, err := manager.CreateJob(context.Background(), newJob(versionIds[0],
+		&api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: versionIds[0]}))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "can't refer to the pipeline version")
+
+	// The runs of version 0 trigger a job running version 1, whose runs would
+	// trigger the job running version 0.
+	_, err = store.JobStore().CreateJob(&model.Job{
+		UUID:        "DEPENDENT_JOB",
+		DisplayName: "dependent",
+		Name:        "dependent",
+		Namespace:   "ns1",
+		Trigger: model.Trigger{
+			RunDependencyTrigger: model.RunDependencyTrigger{
+				RunDependencyTriggerUpstreamType: util.StringPointer(string(common.PipelineVersion)),
+				RunDependencyTriggerUpstreamId:   util.StringPointer(versionIds[0]),
+			},
+		},
+		PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*model.ResourceReference{
+			{
+				ResourceUUID:  "DEPENDENT_JOB",
+				ResourceType:  common.Job,
+				ReferenceUUID: versionIds[1],
+				ReferenceName: "version",
+				ReferenceType: common.PipelineVersion,
+				Relationship:  common.Creator,
+			},
+		},
+	})
+	require.Nil(t, err)
+	_, err = manager.CreateJob(context.Background(), newJob(versionIds[0],
+		&api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: versionIds[1]}))
+	require.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "makes a cycle")
+}
+
 func TestReportWorkflowResource_ScheduledWorkflowIDNotEmpty_NoExperiment_Success(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -2329,7 +3009,7 @@ func TestReportScheduledWorkflowResource_Error(t *testing.T) {
 	workflow := util.NewWorkflow(&v1alpha1.Workflow{
 		TypeMeta:   v1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
 		ObjectMeta: v1.ObjectMeta{Name: "workflow-name"}})
-	p, err := manager.CreatePipeline("1", "", "", []byte(workflow.ToStringForStore()))
+	p, err := manager.CreatePipeline("1", "", "", "", []byte(workflow.ToStringForStore()))
 	assert.Nil(t, err)
 
 	// Create job
@@ -3183,6 +3863,7 @@ func TestCreatePipelineVersion(t *testing.T) {
 				"my_pipeline",
 				"",
 				"",
+				"",
 				// Do not upload test.template here, because pipeline API is out of test scope.
 				[]byte(testWorkflow.ToStringForStore()),
 			)
@@ -3259,7 +3940,7 @@ func TestCreatePipelineOrVersion_V2PipelineName(t *testing.T) {
 			}
 
 			// Verify v2 pipeline name of CreatePipeline template.
-			createdPipeline, err := manager.CreatePipeline(test.name, "", test.namespace, []byte(test.template))
+			createdPipeline, err := manager.CreatePipeline(test.name, "", test.namespace, "", []byte(test.template))
 			require.Nil(t, err)
 			bytes, err := manager.GetPipelineTemplate(createdPipeline.UUID)
 			require.Nil(t, err)
@@ -3296,7 +3977,7 @@ func TestDeletePipelineVersion(t *testing.T) {
 	manager := NewResourceManager(store)
 
 	// Create a pipeline.
-	_, err := manager.CreatePipeline("pipeline", "", "", []byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+	_, err := manager.CreatePipeline("pipeline", "", "", "", []byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
 	assert.Nil(t, err)
 
 	// Create a version under the above pipeline.
@@ -3333,7 +4014,7 @@ func TestDeletePipelineVersion_FileError(t *testing.T) {
 	manager := NewResourceManager(store)
 
 	// Create a pipeline.
-	_, err := manager.CreatePipeline("pipeline", "", "", []byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
+	_, err := manager.CreatePipeline("pipeline", "", "", "", []byte("apiVersion: argoproj.io/v1alpha1\nkind: Workflow"))
 	assert.Nil(t, err)
 
 	// Create a version under the above pipeline.
@@ -0,0 +1,323 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// RunComparison is the side by side comparison of the parameters, metrics and
+// pipeline specs of runs. The values of each comparison are in the order of
+// RunIds.
+type RunComparison struct {
+	RunIds                  []string
+	Parameters              []*ParameterComparison
+	Metrics                 []*MetricComparison
+	PipelineSpecDifferences []*PipelineSpecDifference
+}
+
+// ComparedParameterValue is the value of a parameter in a run.
+type ComparedParameterValue struct {
+	Present bool
+	Value   string
+}
+
+type ParameterComparison struct {
+	Name    string
+	Values  []ComparedParameterValue
+	Differs bool
+}
+
+// ComparedMetricValue is the value of a metric in a run. Delta is the value
+// minus the value of the first run, and is only set if both runs reported the
+// metric.
+type ComparedMetricValue struct {
+	Present     bool
+	NumberValue float64
+	Delta       float64
+	HasDelta    bool
+}
+
+type MetricComparison struct {
+	NodeID string
+	Name   string
+	Values []ComparedMetricValue
+}
+
+// PipelineSpecDifference is a field of the pipeline specs whose value isn't
+// the same in all the runs. The values are JSON, and empty if a run doesn't
+// have the field.
+type PipelineSpecDifference struct {
+	Path   string
+	Values []string
+}
+
+// MaxComparedRuns is the maximum number of runs in a comparison, which loads
+// every run with its pipeline spec and workflow.
+const MaxComparedRuns = 10
+
+// ValidateComparedRunIds checks that there are at least two and at most
+// MaxComparedRuns runs to compare, and that no run is compared more than once.
+func ValidateComparedRunIds(runIds []string) error {
+	if len(runIds) < 2 {
+		return util.NewInvalidInputError("At least two runs are required for a comparison, got %d.", len(runIds))
+	}
+	if len(runIds) > MaxComparedRuns {
+		return util.NewInvalidInputError("At most %d runs can be compared, got %d.", MaxComparedRuns, len(runIds))
+	}
+	seen := make(map[string]bool)
+	for _, runId := range runIds {
+		if seen[runId] {
+			return util.NewInvalidInputError("Run %s is compared more than once.", runId)
+		}
+		seen[runId] = true
+	}
+	return nil
+}
+
+// CompareRuns compares the runs. The first run is the baseline of the metric
+// deltas.
+func (r *ResourceManager) CompareRuns(runIds []string) (*RunComparison, error) {
+	if err := ValidateComparedRunIds(runIds); err != nil {
+		return nil, err
+	}
+	runs := make([]*model.RunDetail, 0, len(runIds))
+	for _, runId := range runIds {
+		run, err := r.GetRun(runId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to compare runs: failed to get run %s", runId)
+		}
+		runs = append(runs, run)
+	}
+
+	parameters, err := compareParameters(runs)
+	if err != nil {
+		return nil, err
+	}
+	differences, err := comparePipelineSpecs(runs)
+	if err != nil {
+		return nil, err
+	}
+	return &RunComparison{
+		RunIds:                  runIds,
+		Parameters:              parameters,
+		Metrics:                 compareMetrics(runs),
+		PipelineSpecDifferences: differences,
+	}, nil
+}
+
+func compareParameters(runs []*model.RunDetail) ([]*ParameterComparison, error) {
+	comparisons := make(map[string]*ParameterComparison)
+	for i, run := range runs {
+		params, err := runParameters(run)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range params {
+			comparison, ok := comparisons[name]
+			if !ok {
+				comparison = &ParameterComparison{
+					Name:   name,
+					Values: make([]ComparedParameterValue, len(runs)),
+				}
+				comparisons[name] = comparison
+			}
+			comparison.Values[i] = ComparedParameterValue{Present: true, Value: value}
+		}
+	}
+
+	result := make([]*ParameterComparison, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		for _, value := range comparison.Values[1:] {
+			if value != comparison.Values[0] {
+				comparison.Differs = true
+				break
+			}
+		}
+		result = append(result, comparison)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// runParameters returns the parameters of a run by name. A run created with the
+// API stores the parameters of its v1 pipeline, or the runtime config
+// parameters of its v2 pipeline. The runs of the jobs are stored by the
+// persistence agent without parameters, so they are read from their workflows.
+func runParameters(run *model.RunDetail) (map[string]string, error) {
+	params := make(map[string]string)
+	if run.Parameters != "" {
+		storedParams, err := template.UnmarshalParameters(run.Parameters)
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Run %s has parameters with a wrong format", run.UUID)
+		}
+		for _, param := range storedParams {
+			params[param.Name] = ""
+			if param.Value != nil {
+				params[param.Name] = param.Value.String()
+			}
+		}
+		return params, nil
+	}
+
+	manifest := run.WorkflowRuntimeManifest
+	if manifest == "" {
+		manifest = run.WorkflowSpecManifest
+	}
+	if manifest == "" {
+		return params, nil
+	}
+	workflow, err := util.NewWorkflowFromBytes([]byte(manifest))
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Run %s has a workflow with a wrong format", run.UUID)
+	}
+	runtimeParams, isV2, err := workflow.RuntimeConfigParameters()
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get the parameters of run %s", run.UUID)
+	}
+	if isV2 {
+		return runtimeParams, nil
+	}
+	return workflow.GetWorkflowParametersAsMap(), nil
+}
+
+func compareMetrics(runs []*model.RunDetail) []*MetricComparison {
+	type metricKey struct{ nodeID, name string }
+	comparisons := make(map[metricKey]*MetricComparison)
+	for i, run := range runs {
+		for _, metric := range run.Metrics {
+			key := metricKey{metric.NodeID, metric.Name}
+			comparison, ok := comparisons[key]
+			if !ok {
+				comparison = &MetricComparison{
+					NodeID: metric.NodeID,
+					Name:   metric.Name,
+					Values: make([]ComparedMetricValue, len(runs)),
+				}
+				comparisons[key] = comparison
+			}
+			comparison.Values[i] = ComparedMetricValue{Present: true, NumberValue: metric.NumberValue}
+		}
+	}
+
+	result := make([]*MetricComparison, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		baseline := comparison.Values[0]
+		for i := range comparison.Values {
+			value := &comparison.Values[i]
+			if baseline.Present && value.Present {
+				value.Delta = value.NumberValue - baseline.NumberValue
+				value.HasDelta = true
+			}
+		}
+		result = append(result, comparison)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].NodeID != result[j].NodeID {
+			return result[i].NodeID < result[j].NodeID
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func comparePipelineSpecs(runs []*model.RunDetail) ([]*PipelineSpecDifference, error) {
+	manifests := []struct {
+		path     string
+		manifest func(run *model.RunDetail) string
+	}{
+		{"pipeline_spec_manifest", func(run *model.RunDetail) string { return run.PipelineSpecManifest }},
+		{"workflow_spec_manifest", func(run *model.RunDetail) string { return run.WorkflowSpecManifest }},
+	}
+	var result []*PipelineSpecDifference
+	for _, m := range manifests {
+		fields := make([]map[string]string, len(runs))
+		paths := make(map[string]bool)
+		for i, run := range runs {
+			flattened, err := flattenManifest(m.manifest(run))
+			if err != nil {
+				return nil, util.NewInternalServerError(err, "Failed to parse the %s of run %s", m.path, run.UUID)
+			}
+			fields[i] = flattened
+			for path := range flattened {
+				paths[path] = true
+			}
+		}
+		for path := range paths {
+			values := make([]string, len(runs))
+			differs := false
+			for i := range runs {
+				values[i] = fields[i][path]
+				if values[i] != values[0] {
+					differs = true
+				}
+			}
+			if differs {
+				result = append(result, &PipelineSpecDifference{Path: m.path + path, Values: values})
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+	return result, nil
+}
+
+// flattenManifest maps the path of each leaf field of a YAML or JSON manifest,
+// such as ".spec.templates[0].name", to its JSON value.
+func flattenManifest(manifest string) (map[string]string, error) {
+	fields := make(map[string]string)
+	if manifest == "" {
+		return fields, nil
+	}
+	jsonManifest, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(jsonManifest, &value); err != nil {
+		return nil, err
+	}
+	return fields, flatten("", value, fields)
+}
+
+func flatten(path string, value interface{}, fields map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if err := flatten(path+"."+key, child, fields); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			if err := flatten(fmt.Sprintf("%s[%d]", path, i), child, fields); err != nil {
+				return err
+			}
+		}
+	default:
+		leaf, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fields[path] = string(leaf)
+	}
+	return nil
+}
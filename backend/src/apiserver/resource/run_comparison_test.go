@@ -0,0 +1,232 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func createRunForComparison(t *testing.T, manager *ResourceManager, experimentId string, parameters []*api.Parameter, metrics []*api.RunMetric) string {
+	apiRun := &api.Run{
+		Name: "run",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       parameters,
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experimentId},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	require.Nil(t, err)
+	for _, metric := range metrics {
+		require.Nil(t, manager.ReportMetric(metric, runDetail.UUID))
+	}
+	return runDetail.UUID
+}
+
+func TestCompareRuns(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	run1 := createRunForComparison(t, manager, exp.UUID,
+		[]*api.Parameter{{Name: "param1", Value: "hello"}},
+		[]*api.RunMetric{
+			{NodeId: "node1", Name: "accuracy", Value: &api.RunMetric_NumberValue{NumberValue: 0.5}},
+			{NodeId: "node1", Name: "loss", Value: &api.RunMetric_NumberValue{NumberValue: 0.25}},
+		})
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(FakeUUIDOne, nil))
+	manager = NewResourceManager(store)
+	run2 := createRunForComparison(t, manager, exp.UUID,
+		[]*api.Parameter{{Name: "param1", Value: "world"}},
+		[]*api.RunMetric{
+			{NodeId: "node1", Name: "accuracy", Value: &api.RunMetric_NumberValue{NumberValue: 0.75}},
+		})
+
+	comparison, err := manager.CompareRuns([]string{run1, run2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{run1, run2}, comparison.RunIds)
+	assert.Equal(t, []*ParameterComparison{{
+		Name: "param1",
+		Values: []ComparedParameterValue{
+			{Present: true, Value: "hello"},
+			{Present: true, Value: "world"},
+		},
+		Differs: true,
+	}}, comparison.Parameters)
+	assert.Equal(t, []*MetricComparison{
+		{
+			NodeID: "node1",
+			Name:   "accuracy",
+			Values: []ComparedMetricValue{
+				{Present: true, NumberValue: 0.5, HasDelta: true},
+				{Present: true, NumberValue: 0.75, Delta: 0.25, HasDelta: true},
+			},
+		},
+		{
+			NodeID: "node1",
+			Name:   "loss",
+			Values: []ComparedMetricValue{
+				{Present: true, NumberValue: 0.25, HasDelta: true},
+				{},
+			},
+		},
+	}, comparison.Metrics)
+	// Both runs use the same workflow.
+	assert.Empty(t, comparison.PipelineSpecDifferences)
+}
+
+func TestCompareRuns_InvalidRunIds(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+
+	_, err := manager.CompareRuns([]string{run.UUID})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	_, err = manager.CompareRuns([]string{run.UUID, run.UUID})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "compared more than once")
+
+	_, err = manager.CompareRuns([]string{run.UUID, "missing"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	runIds := make([]string, MaxComparedRuns+1)
+	for i := range runIds {
+		runIds[i] = fmt.Sprintf("run%d", i)
+	}
+	_, err = manager.CompareRuns(runIds)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "At most")
+}
+
+func TestCompareParameters_V2AndJobRuns(t *testing.T) {
+	// A v2 run created with the API stores its runtime config parameters.
+	v2Params, err := runtimeConfigToModelParameters(&api.PipelineSpec_RuntimeConfig{
+		Parameters: map[string]*structpb.Value{
+			"learning_rate": structpb.NewNumberValue(0.1),
+			"name":          structpb.NewStringValue("a"),
+		},
+	})
+	require.Nil(t, err)
+	v2Run := &model.RunDetail{Run: model.Run{
+		UUID:         "run1",
+		PipelineSpec: model.PipelineSpec{Parameters: v2Params, PipelineSpecManifest: "{}"},
+	}}
+	// The runs of a v2 job have the parameters in the runtime config of their
+	// workflow.
+	v2JobRun := &model.RunDetail{
+		Run: model.Run{UUID: "run2"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: util.NewWorkflow(&v1alpha1.Workflow{
+			Spec: v1alpha1.WorkflowSpec{
+				Templates: []v1alpha1.Template{{
+					Name: "entrypoint",
+					DAG: &v1alpha1.DAGTemplate{Tasks: []v1alpha1.DAGTask{{
+						Name: "root-driver",
+						Arguments: v1alpha1.Arguments{Parameters: []v1alpha1.Parameter{
+							{Name: "runtime-config", Value: v1alpha1.AnyStringPtr(`{"parameterValues":{"learning_rate":0.2,"name":"a"}}`)},
+							{Name: "driver-type", Value: v1alpha1.AnyStringPtr("ROOT_DAG")},
+						}},
+					}}},
+				}},
+			},
+		}).ToStringForStore()},
+	}
+	// The runs of a v1 job have the parameters in the arguments of their
+	// workflow.
+	v1JobRun := &model.RunDetail{
+		Run: model.Run{UUID: "run3"},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: util.NewWorkflow(&v1alpha1.Workflow{
+			Spec: v1alpha1.WorkflowSpec{
+				Arguments: v1alpha1.Arguments{Parameters: []v1alpha1.Parameter{
+					{Name: "learning_rate", Value: v1alpha1.AnyStringPtr("0.1")},
+				}},
+			},
+		}).ToStringForStore()},
+	}
+
+	parameters, err := compareParameters([]*model.RunDetail{v2Run, v2JobRun, v1JobRun})
+	require.Nil(t, err)
+	assert.Equal(t, []*ParameterComparison{
+		{
+			Name: "learning_rate",
+			Values: []ComparedParameterValue{
+				{Present: true, Value: "0.1"},
+				{Present: true, Value: "0.2"},
+				{Present: true, Value: "0.1"},
+			},
+			Differs: true,
+		},
+		{
+			Name: "name",
+			Values: []ComparedParameterValue{
+				{Present: true, Value: "a"},
+				{Present: true, Value: "a"},
+				{},
+			},
+			Differs: true,
+		},
+	}, parameters)
+}
+
+func TestComparePipelineSpecs(t *testing.T) {
+	run1 := &model.RunDetail{Run: model.Run{PipelineSpec: model.PipelineSpec{
+		WorkflowSpecManifest: `{"spec": {"entrypoint": "main", "templates": [{"name": "main", "container": {"image": "python:3.7"}}]}}`,
+	}}}
+	run2 := &model.RunDetail{Run: model.Run{PipelineSpec: model.PipelineSpec{
+		WorkflowSpecManifest: "spec:\n  entrypoint: main\n  templates:\n  - name: main\n    container:\n      image: python:3.9\n      command: [echo]\n",
+	}}}
+	differences, err := comparePipelineSpecs([]*model.RunDetail{run1, run2})
+	require.Nil(t, err)
+	assert.Equal(t, []*PipelineSpecDifference{
+		{
+			Path:   "workflow_spec_manifest.spec.templates[0].container.command[0]",
+			Values: []string{"", `"echo"`},
+		},
+		{
+			Path:   "workflow_spec_manifest.spec.templates[0].container.image",
+			Values: []string{`"python:3.7"`, `"python:3.9"`},
+		},
+	}, differences)
+}
+
+func TestFlattenManifest(t *testing.T) {
+	fields, err := flattenManifest("spec:\n  templates:\n  - name: a\n    retries: 2\n  - name: b\n")
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		".spec.templates[0].name":    `"a"`,
+		".spec.templates[0].retries": "2",
+		".spec.templates[1].name":    `"b"`,
+	}, fields)
+
+	fields, err = flattenManifest("")
+	require.Nil(t, err)
+	assert.Empty(t, fields)
+
+	_, err = flattenManifest("spec: [")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,333 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+)
+
+// RunEventType is the kind of change a RunEvent reports.
+type RunEventType string
+
+const (
+	RunCreated    RunEventType = "CREATED"
+	RunUpdated    RunEventType = "UPDATED"
+	RunArchived   RunEventType = "ARCHIVED"
+	RunUnarchived RunEventType = "UNARCHIVED"
+	RunDeleted    RunEventType = "DELETED"
+)
+
+// RunEvent is a change of a run.
+type RunEvent struct {
+	Type RunEventType
+	// Run is the run after the change, or before it for a deleted run.
+	Run          *model.Run
+	EventAtInSec int64
+}
+
+// runWatcherBufferSize is the number of events buffered for a watcher. A
+// watcher that falls further behind is stopped, so that a slow client can't
+// block the API server.
+const runWatcherBufferSize = 100
+
+const (
+	// runEventPollInterval is how often the run events are read from the DB
+	// while there are watchers.
+	runEventPollInterval = time.Second
+	// runEventPollLimit is the maximum number of events read by a poll.
+	runEventPollLimit = runWatcherBufferSize
+	// runEventGapTimeout is how long a poll waits for a missing sequence number
+	// before skipping it. The insert of an event may commit after the insert of
+	// the next one, and a failed insert leaves a gap that is never filled.
+	runEventGapTimeout = 5 * time.Second
+	// runEventRetentionInSec is how long the run events are kept in the DB.
+	// Watchers only read the events recorded since they started, so the events
+	// only need to be kept until every replica polled them.
+	runEventRetentionInSec = 60 * 60
+	// runEventPruneIntervalInSec is how often the old run events are deleted.
+	runEventPruneIntervalInSec = 60
+)
+
+// RunWatcher receives the run events recorded after its creation.
+type RunWatcher struct {
+	events      chan *RunEvent
+	broadcaster *runEventBroadcaster
+	// fellBehind is set when the watcher is stopped because its buffer is full.
+	fellBehind bool
+}
+
+// Events returns the channel of run events. It is closed when the watcher is
+// stopped.
+func (w *RunWatcher) Events() <-chan *RunEvent {
+	return w.events
+}
+
+// FellBehind reports whether the watcher was stopped because it didn't consume
+// its events fast enough. Only valid after the events channel is closed.
+func (w *RunWatcher) FellBehind() bool {
+	return w.fellBehind
+}
+
+// Stop stops the watcher and closes its events channel.
+func (w *RunWatcher) Stop() {
+	w.broadcaster.remove(w, false)
+}
+
+// runEventBroadcaster records the run events in the DB, and sends the events
+// recorded by every API server replica to the watchers of this one. It polls
+// the DB only while there are watchers.
+type runEventBroadcaster struct {
+	store storage.RunEventStoreInterface
+	// getRun loads the runs of the events sent to the watchers, as the events
+	// only store the part of the run that changed.
+	getRun func(runId string) (*model.RunDetail, error)
+	// pollMu serializes the polls.
+	pollMu sync.Mutex
+
+	mu       sync.Mutex
+	watchers map[*RunWatcher]bool
+	// stopPolling stops the polling goroutine. It is nil while there are no
+	// watchers, and replaced whenever the polling starts again.
+	stopPolling chan struct{}
+	// lastSeq is the sequence number of the last event sent to the watchers.
+	lastSeq int64
+	// gapSince is when a poll first found the event after lastSeq missing.
+	gapSince         time.Time
+	lastPruneAtInSec int64
+}
+
+func newRunEventBroadcaster(store storage.RunEventStoreInterface, getRun func(runId string) (*model.RunDetail, error)) *runEventBroadcaster {
+	return &runEventBroadcaster{store: store, getRun: getRun, watchers: make(map[*RunWatcher]bool)}
+}
+
+// runEventRun returns the part of run that a run event stores: its state, and
+// the fields that the watchers match the resource references on. The rest of
+// the run, e.g. its pipeline spec, is loaded when the event is sent to the
+// watchers, unless the run is deleted.
+func runEventRun(run *model.Run) *model.Run {
+	var references []*model.ResourceReference
+	for _, reference := range run.ResourceReferences {
+		ref := *reference
+		ref.Payload = ""
+		references = append(references, &ref)
+	}
+	return &model.Run{
+		UUID:               run.UUID,
+		ExperimentUUID:     run.ExperimentUUID,
+		DisplayName:        run.DisplayName,
+		Name:               run.Name,
+		StorageState:       run.StorageState,
+		Namespace:          run.Namespace,
+		CreatedAtInSec:     run.CreatedAtInSec,
+		ScheduledAtInSec:   run.ScheduledAtInSec,
+		FinishedAtInSec:    run.FinishedAtInSec,
+		Conditions:         run.Conditions,
+		ResourceReferences: references,
+	}
+}
+
+// record stores the event in the DB, and deletes the expired events from time
+// to time.
+func (b *runEventBroadcaster) record(event *RunEvent) error {
+	run, err := json.Marshal(runEventRun(event.Run))
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal run %v", event.Run.UUID)
+	}
+	err = b.store.CreateRunEvent(&model.RunEvent{
+		Type:         string(event.Type),
+		RunUUID:      event.Run.UUID,
+		EventAtInSec: event.EventAtInSec,
+		Run:          string(run),
+	})
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	prune := event.EventAtInSec-b.lastPruneAtInSec >= runEventPruneIntervalInSec
+	if prune {
+		b.lastPruneAtInSec = event.EventAtInSec
+	}
+	b.mu.Unlock()
+	if prune {
+		return b.store.DeleteRunEventsBefore(event.EventAtInSec - runEventRetentionInSec)
+	}
+	return nil
+}
+
+func (b *runEventBroadcaster) watch() (*RunWatcher, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.watchers) == 0 {
+		// The watchers get the events recorded after they start.
+		seq, err := b.store.LatestRunEventSeq()
+		if err != nil {
+			return nil, err
+		}
+		b.lastSeq = seq
+		b.gapSince = time.Time{}
+		b.stopPolling = make(chan struct{})
+		go b.pollUntil(b.stopPolling)
+	}
+	w := &RunWatcher{
+		events:      make(chan *RunEvent, runWatcherBufferSize),
+		broadcaster: b,
+	}
+	b.watchers[w] = true
+	return w, nil
+}
+
+func (b *runEventBroadcaster) pollUntil(stop chan struct{}) {
+	ticker := time.NewTicker(runEventPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := b.poll(); err != nil {
+				glog.Errorf("Failed to poll the run events. Error: %v", err)
+			}
+		}
+	}
+}
+
+// poll reads the events recorded since the last poll and sends them to the
+// watchers.
+func (b *runEventBroadcaster) poll() error {
+	b.pollMu.Lock()
+	defer b.pollMu.Unlock()
+
+	b.mu.Lock()
+	stop := b.stopPolling
+	lastSeq := b.lastSeq
+	b.mu.Unlock()
+	if stop == nil {
+		return nil
+	}
+	events, err := b.store.ListRunEventsAfter(lastSeq, runEventPollLimit)
+	if err != nil {
+		return err
+	}
+	runs := b.loadRuns(events)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// The watchers stopped, or were replaced, during the query.
+	if b.stopPolling != stop {
+		return nil
+	}
+	for _, event := range events {
+		if event.Seq != b.lastSeq+1 {
+			if b.gapSince.IsZero() {
+				b.gapSince = time.Now()
+			}
+			if time.Since(b.gapSince) < runEventGapTimeout {
+				break
+			}
+			glog.Warningf("Skipping the missing run events %v to %v", b.lastSeq+1, event.Seq-1)
+		}
+		b.gapSince = time.Time{}
+		b.lastSeq = event.Seq
+		if runs[event.Seq] == nil {
+			continue
+		}
+		b.publishLocked(&RunEvent{
+			Type:         RunEventType(event.Type),
+			Run:          runs[event.Seq],
+			EventAtInSec: event.EventAtInSec,
+		})
+	}
+	return nil
+}
+
+// loadRuns returns the runs of the events by sequence number. The state of
+// each run is the one stored in its event, and the rest of the run is loaded
+// from the DB, once per run. The run of an event is missing if the event can't
+// be unmarshalled.
+func (b *runEventBroadcaster) loadRuns(events []*model.RunEvent) map[int64]*model.Run {
+	runs := make(map[int64]*model.Run)
+	loaded := make(map[string]*model.RunDetail)
+	for _, event := range events {
+		var run model.Run
+		if err := json.Unmarshal([]byte(event.Run), &run); err != nil {
+			glog.Errorf("Failed to unmarshal the run of run event %v. Error: %v", event.Seq, err)
+			continue
+		}
+		runs[event.Seq] = &run
+		if RunEventType(event.Type) == RunDeleted {
+			continue
+		}
+		runDetail, ok := loaded[run.UUID]
+		if !ok {
+			var err error
+			runDetail, err = b.getRun(run.UUID)
+			if err != nil && !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				glog.Errorf("Failed to get run %v of run event %v. Error: %v", run.UUID, event.Seq, err)
+			}
+			loaded[run.UUID] = runDetail
+		}
+		// The run was deleted since, or couldn't be read: only send the part of
+		// it that the event stored.
+		if runDetail == nil {
+			continue
+		}
+		fullRun := runDetail.Run
+		fullRun.StorageState = run.StorageState
+		fullRun.ScheduledAtInSec = run.ScheduledAtInSec
+		fullRun.FinishedAtInSec = run.FinishedAtInSec
+		fullRun.Conditions = run.Conditions
+		runs[event.Seq] = &fullRun
+	}
+	return runs
+}
+
+func (b *runEventBroadcaster) publishLocked(event *RunEvent) {
+	for w := range b.watchers {
+		select {
+		case w.events <- event:
+		default:
+			glog.Warningf("Stopping a run watcher that fell behind by %v events", runWatcherBufferSize)
+			b.removeLocked(w, true)
+		}
+	}
+}
+
+func (b *runEventBroadcaster) remove(w *RunWatcher, fellBehind bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.removeLocked(w, fellBehind)
+}
+
+func (b *runEventBroadcaster) removeLocked(w *RunWatcher, fellBehind bool) {
+	if !b.watchers[w] {
+		return
+	}
+	delete(b.watchers, w)
+	w.fellBehind = fellBehind
+	close(w.events)
+	if len(b.watchers) == 0 {
+		close(b.stopPolling)
+		b.stopPolling = nil
+	}
+}
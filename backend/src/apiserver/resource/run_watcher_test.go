@@ -0,0 +1,241 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// nextRunEvent polls the run events and returns the next one sent to the
+// watcher.
+func nextRunEvent(t *testing.T, manager *ResourceManager, watcher *RunWatcher) *RunEvent {
+	require.Nil(t, manager.runEvents.poll())
+	select {
+	case event, ok := <-watcher.Events():
+		require.True(t, ok, "The watcher was stopped")
+		return event
+	default:
+		require.FailNow(t, "No run event was published")
+		return nil
+	}
+}
+
+func assertNoRunEvent(t *testing.T, manager *ResourceManager, watcher *RunWatcher) {
+	require.Nil(t, manager.runEvents.poll())
+	select {
+	case event := <-watcher.Events():
+		assert.Failf(t, "Unexpected run event", "%+v", event)
+	default:
+	}
+}
+
+func recordRunEvent(t *testing.T, broadcaster *runEventBroadcaster, eventType RunEventType, runId string) {
+	require.Nil(t, broadcaster.record(&RunEvent{Type: eventType, Run: &model.Run{UUID: runId}, EventAtInSec: 1}))
+}
+
+func TestRunEventBroadcaster_Poll(t *testing.T) {
+	db := storage.NewFakeDbOrFatal()
+	defer db.Close()
+	store := storage.NewRunEventStore(db)
+	broadcaster := newRunEventBroadcaster(store, storage.NewRunStore(db, util.NewFakeTimeForEpoch()).GetRun)
+	// The events recorded before the watchers start are not sent.
+	recordRunEvent(t, broadcaster, RunCreated, "run0")
+
+	watcher1, err := broadcaster.watch()
+	require.Nil(t, err)
+	watcher2, err := broadcaster.watch()
+	require.Nil(t, err)
+
+	// Another replica records an event.
+	otherReplica := newRunEventBroadcaster(store, storage.NewRunStore(db, util.NewFakeTimeForEpoch()).GetRun)
+	recordRunEvent(t, otherReplica, RunCreated, "run1")
+	require.Nil(t, broadcaster.poll())
+	event := &RunEvent{Type: RunCreated, Run: &model.Run{UUID: "run1"}, EventAtInSec: 1}
+	assert.Equal(t, event, <-watcher1.Events())
+	assert.Equal(t, event, <-watcher2.Events())
+	// Polling again sends nothing new.
+	require.Nil(t, broadcaster.poll())
+	assert.Empty(t, watcher1.Events())
+
+	watcher1.Stop()
+	_, ok := <-watcher1.Events()
+	assert.False(t, ok)
+	assert.False(t, watcher1.FellBehind())
+	// Stopping twice is a no-op.
+	watcher1.Stop()
+
+	recordRunEvent(t, broadcaster, RunDeleted, "run1")
+	require.Nil(t, broadcaster.poll())
+	assert.Equal(t, RunDeleted, (<-watcher2.Events()).Type)
+	watcher2.Stop()
+	assert.Nil(t, broadcaster.stopPolling)
+}
+
+func TestRunEventBroadcaster_WaitsForMissingEvent(t *testing.T) {
+	db := storage.NewFakeDbOrFatal()
+	defer db.Close()
+	store := storage.NewRunEventStore(db)
+	broadcaster := newRunEventBroadcaster(store, storage.NewRunStore(db, util.NewFakeTimeForEpoch()).GetRun)
+	watcher, err := broadcaster.watch()
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	// The insert of event 1 is not committed yet.
+	_, err = db.Exec(`INSERT INTO run_events (Seq, Type, RunUUID, EventAtInSec, Run) VALUES (2, 'CREATED', 'run2', 1, '{"UUID":"run2"}')`)
+	require.Nil(t, err)
+	require.Nil(t, broadcaster.poll())
+	assert.Empty(t, watcher.Events())
+
+	// The poll skips the missing event after a while.
+	broadcaster.gapSince = time.Now().Add(-runEventGapTimeout)
+	require.Nil(t, broadcaster.poll())
+	assert.Equal(t, "run2", (<-watcher.Events()).Run.UUID)
+}
+
+func TestRunEventBroadcaster_WatcherFellBehind(t *testing.T) {
+	db := storage.NewFakeDbOrFatal()
+	defer db.Close()
+	broadcaster := newRunEventBroadcaster(storage.NewRunEventStore(db), storage.NewRunStore(db, util.NewFakeTimeForEpoch()).GetRun)
+	watcher, err := broadcaster.watch()
+	require.Nil(t, err)
+	for i := 0; i <= runWatcherBufferSize; i++ {
+		recordRunEvent(t, broadcaster, RunUpdated, "run1")
+	}
+	// A poll reads at most a buffer of events.
+	require.Nil(t, broadcaster.poll())
+	require.Nil(t, broadcaster.poll())
+
+	received := 0
+	for range watcher.Events() {
+		received++
+	}
+	assert.Equal(t, runWatcherBufferSize, received)
+	assert.True(t, watcher.FellBehind())
+	assert.Nil(t, broadcaster.stopPolling)
+}
+
+func TestWatchRuns_CreateArchiveUnarchiveDelete(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	watcher, err := manager.WatchRuns()
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	require.Nil(t, err)
+	event := nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunCreated, event.Type)
+	assert.Equal(t, runDetail.UUID, event.Run.UUID)
+	// The event only stores the state of the run, the rest is loaded when the
+	// event is sent.
+	assert.Equal(t, runDetail.WorkflowSpecManifest, event.Run.WorkflowSpecManifest)
+	storedEvents, err := store.RunEventStore().ListRunEventsAfter(0, runEventPollLimit)
+	require.Nil(t, err)
+	require.Len(t, storedEvents, 1)
+	var storedRun model.Run
+	require.Nil(t, json.Unmarshal([]byte(storedEvents[0].Run), &storedRun))
+	assert.Equal(t, runDetail.UUID, storedRun.UUID)
+	assert.Equal(t, runDetail.Conditions, storedRun.Conditions)
+	assert.Empty(t, storedRun.WorkflowSpecManifest)
+
+	require.Nil(t, manager.ArchiveRun(runDetail.UUID))
+	event = nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunArchived, event.Type)
+	assert.Equal(t, "STORAGESTATE_ARCHIVED", event.Run.StorageState)
+
+	require.Nil(t, manager.UnarchiveRun(runDetail.UUID))
+	event = nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunUnarchived, event.Type)
+	assert.Equal(t, "STORAGESTATE_AVAILABLE", event.Run.StorageState)
+
+	require.Nil(t, manager.DeleteRun(context.Background(), runDetail.UUID))
+	event = nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunDeleted, event.Type)
+	assert.Equal(t, runDetail.UUID, event.Run.UUID)
+	assertNoRunEvent(t, manager, watcher)
+}
+
+func TestWatchRuns_ReportWorkflowResource(t *testing.T) {
+	store, manager, run := initWithOneTimeRun(t)
+	defer store.Close()
+	watcher, err := manager.WatchRuns()
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	workflow := util.NewWorkflow(&v1alpha1.Workflow{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      run.Name,
+			Namespace: "ns1",
+			UID:       types.UID(run.UUID),
+			Labels:    map[string]string{util.LabelKeyWorkflowRunId: run.UUID},
+		},
+		Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowPending},
+	})
+	require.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+	event := nextRunEvent(t, manager, watcher)
+	assert.Equal(t, RunUpdated, event.Type)
+	assert.Equal(t, "Pending", event.Run.Conditions)
+
+	// Reporting the same status again isn't a change.
+	require.Nil(t, manager.ReportWorkflowResource(context.Background(), workflow))
+	assertNoRunEvent(t, manager, watcher)
+}
+
+func TestRunEventBroadcaster_DeletedRun(t *testing.T) {
+	db := storage.NewFakeDbOrFatal()
+	defer db.Close()
+	broadcaster := newRunEventBroadcaster(storage.NewRunEventStore(db), storage.NewRunStore(db, util.NewFakeTimeForEpoch()).GetRun)
+	watcher, err := broadcaster.watch()
+	require.Nil(t, err)
+	defer watcher.Stop()
+
+	// The run of the event doesn't exist anymore, the watchers get the part of
+	// it that the event stored.
+	run := &model.Run{
+		UUID:         "run1",
+		Namespace:    "ns1",
+		Conditions:   "Running",
+		PipelineSpec: model.PipelineSpec{WorkflowSpecManifest: "manifest"},
+	}
+	require.Nil(t, broadcaster.record(&RunEvent{Type: RunUpdated, Run: run, EventAtInSec: 1}))
+	require.Nil(t, broadcaster.poll())
+	event := <-watcher.Events()
+	assert.Equal(t, &model.Run{UUID: "run1", Namespace: "ns1", Conditions: "Running"}, event.Run)
+}
@@ -19,8 +19,11 @@ import (
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/template"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func ToApiExperiment(experiment *model.Experiment) *api.Experiment {
@@ -185,6 +188,68 @@ func toApiRun(run *model.Run) *api.Run {
 	}
 }
 
+// toClonedApiRun returns a new run with the pipeline spec and resource
+// references of the run. The parameters override the run's parameters with
+// the same names. The reference to the job that created the run isn't kept.
+func toClonedApiRun(run *model.RunDetail, name string, parameters []*api.Parameter) (*api.Run, error) {
+	params, err := toApiParameters(run.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range parameters {
+		overridden := false
+		for _, param := range params {
+			if param.Name == override.Name {
+				param.Value = override.Value
+				overridden = true
+			}
+		}
+		if !overridden {
+			params = append(params, &api.Parameter{Name: override.Name, Value: override.Value})
+		}
+	}
+
+	var references []*api.ResourceReference
+	usesPipelineVersion := false
+	for _, ref := range toApiResourceReferences(run.ResourceReferences) {
+		switch ref.GetKey().GetType() {
+		case api.ResourceType_JOB, api.ResourceType_RUN:
+			continue
+		case api.ResourceType_PIPELINE_VERSION:
+			usesPipelineVersion = true
+		}
+		references = append(references, ref)
+	}
+
+	spec := &api.PipelineSpec{}
+	if usesPipelineVersion {
+		// The manifest comes from the pipeline version.
+		spec.PipelineId = run.PipelineId
+	} else {
+		spec.WorkflowManifest = run.WorkflowSpecManifest
+		spec.PipelineManifest = run.PipelineSpecManifest
+	}
+	if run.PipelineSpecManifest == "" {
+		spec.Parameters = params
+	} else if len(params) > 0 {
+		spec.RuntimeConfig = &api.PipelineSpec_RuntimeConfig{Parameters: make(map[string]*structpb.Value)}
+		for _, param := range params {
+			spec.RuntimeConfig.Parameters[param.Name] = resource.ToRuntimeParameterValue(param.Value)
+		}
+	}
+
+	if name == "" {
+		name = "Clone of " + run.DisplayName
+	}
+	return &api.Run{
+		Name:               name,
+		Description:        run.Description,
+		PipelineSpec:       spec,
+		ResourceReferences: references,
+		ServiceAccount:     run.ServiceAccount,
+	}, nil
+}
+
 func ToApiRuns(runs []*model.Run) []*api.Run {
 	apiRuns := make([]*api.Run, 0)
 	for _, run := range runs {
@@ -203,6 +268,57 @@ func ToApiRunDetail(run *model.RunDetail) *api.RunDetail {
 	}
 }
 
+func ToApiRunEvent(event *resource.RunEvent) *api.RunEvent {
+	return &api.RunEvent{
+		Type:    api.RunEvent_Type(api.RunEvent_Type_value[string(event.Type)]),
+		Run:     toApiRun(event.Run),
+		EventAt: &timestamp.Timestamp{Seconds: event.EventAtInSec},
+	}
+}
+
+func ToApiRunComparison(comparison *resource.RunComparison) *api.CompareRunsResponse {
+	response := &api.CompareRunsResponse{
+		RunIds:                  comparison.RunIds,
+		Parameters:              make([]*api.ParameterComparison, 0, len(comparison.Parameters)),
+		Metrics:                 make([]*api.MetricComparison, 0, len(comparison.Metrics)),
+		PipelineSpecDifferences: make([]*api.PipelineSpecDifference, 0, len(comparison.PipelineSpecDifferences)),
+	}
+	for _, parameter := range comparison.Parameters {
+		values := make([]*api.ParameterComparisonValue, 0, len(parameter.Values))
+		for _, value := range parameter.Values {
+			values = append(values, &api.ParameterComparisonValue{Present: value.Present, Value: value.Value})
+		}
+		response.Parameters = append(response.Parameters, &api.ParameterComparison{
+			Name:    parameter.Name,
+			Values:  values,
+			Differs: parameter.Differs,
+		})
+	}
+	for _, metric := range comparison.Metrics {
+		values := make([]*api.MetricComparisonValue, 0, len(metric.Values))
+		for _, value := range metric.Values {
+			values = append(values, &api.MetricComparisonValue{
+				Present:     value.Present,
+				NumberValue: value.NumberValue,
+				Delta:       value.Delta,
+				HasDelta:    value.HasDelta,
+			})
+		}
+		response.Metrics = append(response.Metrics, &api.MetricComparison{
+			NodeId: metric.NodeID,
+			Name:   metric.Name,
+			Values: values,
+		})
+	}
+	for _, difference := range comparison.PipelineSpecDifferences {
+		response.PipelineSpecDifferences = append(response.PipelineSpecDifferences, &api.PipelineSpecDifference{
+			Path:   difference.Path,
+			Values: difference.Values,
+		})
+	}
+	return response
+}
+
 func ToApiTask(task *model.Task) *api.Task {
 	return &api.Task{
 		Id:              task.UUID,
@@ -232,17 +348,18 @@ func ToApiJob(job *model.Job) *api.Job {
 		}
 	}
 	return &api.Job{
-		Id:             job.UUID,
-		Name:           job.DisplayName,
-		ServiceAccount: job.ServiceAccount,
-		Description:    job.Description,
-		Enabled:        job.Enabled,
-		CreatedAt:      &timestamp.Timestamp{Seconds: job.CreatedAtInSec},
-		UpdatedAt:      &timestamp.Timestamp{Seconds: job.UpdatedAtInSec},
-		Status:         job.Conditions,
-		MaxConcurrency: job.MaxConcurrency,
-		NoCatchup:      job.NoCatchup,
-		Trigger:        toApiTrigger(job.Trigger),
+		Id:                job.UUID,
+		Name:              job.DisplayName,
+		ServiceAccount:    job.ServiceAccount,
+		Description:       job.Description,
+		Enabled:           job.Enabled,
+		CreatedAt:         &timestamp.Timestamp{Seconds: job.CreatedAtInSec},
+		UpdatedAt:         &timestamp.Timestamp{Seconds: job.UpdatedAtInSec},
+		Status:            job.Conditions,
+		MaxConcurrency:    job.MaxConcurrency,
+		NoCatchup:         job.NoCatchup,
+		Trigger:           toApiTrigger(job.Trigger),
+		ConcurrencyPolicy: toApiConcurrencyPolicy(job.ConcurrencyPolicy),
 		PipelineSpec: &api.PipelineSpec{
 			PipelineId:       job.PipelineId,
 			PipelineName:     job.PipelineName,
@@ -298,6 +415,8 @@ func toApiResourceType(modelType model.ResourceType) api.ResourceType {
 		return api.ResourceType_PIPELINE_VERSION
 	case common.Namespace:
 		return api.ResourceType_NAMESPACE
+	case common.Run:
+		return api.ResourceType_RUN
 	default:
 		return api.ResourceType_UNKNOWN_RESOURCE_TYPE
 	}
@@ -309,11 +428,26 @@ func toApiRelationship(r model.Relationship) api.Relationship {
 		return api.Relationship_CREATOR
 	case common.Owner:
 		return api.Relationship_OWNER
+	case common.Imported:
+		return api.Relationship_IMPORTED
 	default:
 		return api.Relationship_UNKNOWN_RELATIONSHIP
 	}
 }
 
+func toApiConcurrencyPolicy(policy string) api.Job_ConcurrencyPolicy {
+	switch scheduledworkflow.ConcurrencyPolicy(policy) {
+	case scheduledworkflow.ForbidConcurrent:
+		return api.Job_FORBID
+	case scheduledworkflow.ReplaceConcurrent:
+		return api.Job_REPLACE
+	case scheduledworkflow.SkipConcurrent:
+		return api.Job_SKIP
+	default:
+		return api.Job_ALLOW
+	}
+}
+
 func toApiTrigger(trigger model.Trigger) *api.Trigger {
 	if trigger.Cron != nil && *trigger.Cron != "" {
 		var cronSchedule api.CronSchedule
@@ -326,6 +460,9 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 			cronSchedule.EndTime = &timestamp.Timestamp{
 				Seconds: *trigger.CronScheduleEndTimeInSec}
 		}
+		if trigger.CronScheduleTimeZone != nil {
+			cronSchedule.TimeZone = *trigger.CronScheduleTimeZone
+		}
 		return &api.Trigger{Trigger: &api.Trigger_CronSchedule{CronSchedule: &cronSchedule}}
 	}
 
@@ -342,5 +479,51 @@ func toApiTrigger(trigger model.Trigger) *api.Trigger {
 		}
 		return &api.Trigger{Trigger: &api.Trigger_PeriodicSchedule{PeriodicSchedule: &periodicSchedule}}
 	}
+
+	if trigger.BucketObjectTriggerBucket != nil && *trigger.BucketObjectTriggerBucket != "" {
+		var bucketObjectTrigger api.BucketObjectTrigger
+		bucketObjectTrigger.Bucket = *trigger.BucketObjectTriggerBucket
+		if trigger.BucketObjectTriggerPattern != nil {
+			bucketObjectTrigger.Pattern = *trigger.BucketObjectTriggerPattern
+		}
+		if trigger.BucketObjectTriggerParameterName != nil {
+			bucketObjectTrigger.ParameterName = *trigger.BucketObjectTriggerParameterName
+		}
+		return &api.Trigger{Trigger: &api.Trigger_BucketObjectTrigger{BucketObjectTrigger: &bucketObjectTrigger}}
+	}
+
+	if trigger.RunDependencyTriggerUpstreamId != nil && *trigger.RunDependencyTriggerUpstreamId != "" {
+		var runDependencyTrigger api.RunDependencyTrigger
+		runDependencyTrigger.Upstream = &api.ResourceKey{Id: *trigger.RunDependencyTriggerUpstreamId}
+		if trigger.RunDependencyTriggerUpstreamType != nil {
+			runDependencyTrigger.Upstream.Type = toApiResourceType(model.ResourceType(*trigger.RunDependencyTriggerUpstreamType))
+		}
+		if trigger.RunDependencyTriggerParameterName != nil {
+			runDependencyTrigger.ParameterName = *trigger.RunDependencyTriggerParameterName
+		}
+		return &api.Trigger{Trigger: &api.Trigger_RunDependencyTrigger{RunDependencyTrigger: &runDependencyTrigger}}
+	}
 	return &api.Trigger{}
 }
+
+func ToApiAuditEvent(event *model.AuditEvent) *api.AuditEvent {
+	return &api.AuditEvent{
+		Id:           event.UUID,
+		CreatedAt:    &timestamp.Timestamp{Seconds: event.CreatedAtInSec},
+		User:         event.UserIdentity,
+		Method:       event.Method,
+		ResourceType: event.ResourceType,
+		ResourceId:   event.ResourceUUID,
+		Namespace:    event.Namespace,
+		Outcome:      event.Outcome,
+		Error:        event.Error,
+	}
+}
+
+func ToApiAuditEvents(events []*model.AuditEvent) []*api.AuditEvent {
+	apiEvents := make([]*api.AuditEvent, 0)
+	for _, event := range events {
+		apiEvents = append(apiEvents, ToApiAuditEvent(event))
+	}
+	return apiEvents
+}
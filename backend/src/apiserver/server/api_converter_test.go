@@ -25,6 +25,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestToApiPipeline(t *testing.T) {
@@ -125,6 +126,88 @@ func TestToApiRunDetail(t *testing.T) {
 	assert.Equal(t, expectedApiRun, apiRun)
 }
 
+func TestToClonedApiRun(t *testing.T) {
+	modelRun := &model.RunDetail{
+		Run: model.Run{
+			UUID:           "run123",
+			DisplayName:    "displayName123",
+			Description:    "description123",
+			ServiceAccount: "sa123",
+			Conditions:     "Succeeded",
+			PipelineSpec: model.PipelineSpec{
+				WorkflowSpecManifest: "manifest",
+				Parameters:           `[{"name":"param1","value":"one"},{"name":"param2","value":"two"}]`,
+			},
+			ResourceReferences: []*model.ResourceReference{
+				{ResourceUUID: "run123", ResourceType: common.Run, ReferenceUUID: "job123",
+					ReferenceName: "j123", ReferenceType: common.Job, Relationship: common.Creator},
+				{ResourceUUID: "run123", ResourceType: common.Run, ReferenceUUID: "exp123",
+					ReferenceName: "e123", ReferenceType: common.Experiment, Relationship: common.Owner},
+			},
+		},
+	}
+	apiRun, err := toClonedApiRun(modelRun, "", []*api.Parameter{
+		{Name: "param2", Value: "overridden"},
+		{Name: "param3", Value: "three"},
+	})
+	assert.Nil(t, err)
+	expectedApiRun := &api.Run{
+		Name:           "Clone of displayName123",
+		Description:    "description123",
+		ServiceAccount: "sa123",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: "manifest",
+			Parameters: []*api.Parameter{
+				{Name: "param1", Value: "one"},
+				{Name: "param2", Value: "overridden"},
+				{Name: "param3", Value: "three"},
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp123"},
+				Name: "e123", Relationship: api.Relationship_OWNER},
+		},
+	}
+	assert.Equal(t, expectedApiRun, apiRun)
+}
+
+func TestToClonedApiRun_PipelineVersion(t *testing.T) {
+	modelRun := &model.RunDetail{
+		Run: model.Run{
+			UUID:        "run123",
+			DisplayName: "displayName123",
+			PipelineSpec: model.PipelineSpec{
+				PipelineId:           "pipeline123",
+				PipelineSpecManifest: "manifest",
+				Parameters:           `[{"name":"text","value":"hello"},{"name":"count","value":"3"}]`,
+			},
+			ResourceReferences: []*model.ResourceReference{
+				{ResourceUUID: "run123", ResourceType: common.Run, ReferenceUUID: "version123",
+					ReferenceName: "v123", ReferenceType: common.PipelineVersion, Relationship: common.Creator},
+			},
+		},
+	}
+	apiRun, err := toClonedApiRun(modelRun, "clone", nil)
+	assert.Nil(t, err)
+	expectedApiRun := &api.Run{
+		Name: "clone",
+		PipelineSpec: &api.PipelineSpec{
+			PipelineId: "pipeline123",
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{
+					"text":  structpb.NewStringValue("hello"),
+					"count": structpb.NewNumberValue(3),
+				},
+			},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "version123"},
+				Name: "v123", Relationship: api.Relationship_CREATOR},
+		},
+	}
+	assert.Equal(t, expectedApiRun, apiRun)
+}
+
 func TestToApiRuns(t *testing.T) {
 	metric1 := &model.RunMetric{
 		Name:        "metric-1",
@@ -351,6 +434,59 @@ func TestCronScheduledJobToApiJob(t *testing.T) {
 	assert.Equal(t, expectedJob, apiJob)
 }
 
+func TestToApiTrigger_CronScheduleTimeZone(t *testing.T) {
+	trigger := toApiTrigger(model.Trigger{
+		CronSchedule: model.CronSchedule{
+			Cron:                 util.StringPointer("0 0 2 * * *"),
+			CronScheduleTimeZone: util.StringPointer("Europe/Stockholm"),
+		},
+	})
+	assert.Equal(t, &api.Trigger{
+		Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+			Cron:     "0 0 2 * * *",
+			TimeZone: "Europe/Stockholm",
+		}}}, trigger)
+}
+
+func TestToApiTrigger_BucketObjectTrigger(t *testing.T) {
+	trigger := toApiTrigger(model.Trigger{
+		BucketObjectTrigger: model.BucketObjectTrigger{
+			BucketObjectTriggerBucket:        util.StringPointer("minio://mlpipeline/incoming"),
+			BucketObjectTriggerPattern:       util.StringPointer("*.csv"),
+			BucketObjectTriggerParameterName: util.StringPointer("data"),
+		},
+	})
+	assert.Equal(t, &api.Trigger{
+		Trigger: &api.Trigger_BucketObjectTrigger{BucketObjectTrigger: &api.BucketObjectTrigger{
+			Bucket:        "minio://mlpipeline/incoming",
+			Pattern:       "*.csv",
+			ParameterName: "data",
+		}}}, trigger)
+}
+
+func TestToApiTrigger_RunDependencyTrigger(t *testing.T) {
+	trigger := toApiTrigger(model.Trigger{
+		RunDependencyTrigger: model.RunDependencyTrigger{
+			RunDependencyTriggerUpstreamType:  util.StringPointer("Job"),
+			RunDependencyTriggerUpstreamId:    util.StringPointer("job1"),
+			RunDependencyTriggerParameterName: util.StringPointer("upstream"),
+		},
+	})
+	assert.Equal(t, &api.Trigger{
+		Trigger: &api.Trigger_RunDependencyTrigger{RunDependencyTrigger: &api.RunDependencyTrigger{
+			Upstream:      &api.ResourceKey{Type: api.ResourceType_JOB, Id: "job1"},
+			ParameterName: "upstream",
+		}}}, trigger)
+}
+
+func TestToApiConcurrencyPolicy(t *testing.T) {
+	assert.Equal(t, api.Job_ALLOW, toApiConcurrencyPolicy(""))
+	assert.Equal(t, api.Job_ALLOW, toApiConcurrencyPolicy("Allow"))
+	assert.Equal(t, api.Job_FORBID, toApiConcurrencyPolicy("Forbid"))
+	assert.Equal(t, api.Job_REPLACE, toApiConcurrencyPolicy("Replace"))
+	assert.Equal(t, api.Job_SKIP, toApiConcurrencyPolicy("Skip"))
+}
+
 func TestPeriodicScheduledJobToApiJob(t *testing.T) {
 	modelJob := model.Job{
 		UUID:        "job1",
@@ -0,0 +1,261 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	AuditOutcomeOK = "OK"
+
+	auditResourceTypePipeline        = "Pipeline"
+	auditResourceTypePipelineVersion = "PipelineVersion"
+
+	uploadPipelineAuditMethod        = "POST /apis/v1beta1/pipelines/upload"
+	uploadPipelineVersionAuditMethod = "POST /apis/v1beta1/pipelines/upload_version"
+)
+
+// Services whose calls are not audited, although some of their methods are
+// not read-only.
+var unauditedServices = map[string]bool{
+	// The persistence agent reports the status of the workflows.
+	"ReportService": true,
+	// Visualizations are generated on the fly and not stored.
+	"VisualizationService": true,
+	// Authorize only checks the permissions of the user.
+	"AuthService": true,
+}
+
+// Methods starting with these prefixes do not change resources.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Read", "Watch", "Compare"}
+
+// The request fields holding the ID of the resource of a call, besides id.
+var auditResourceIDFields = map[string]protoreflect.Name{
+	"Run":                            "run_id",
+	"Job":                            "job_id",
+	"Experiment":                     "experiment_id",
+	auditResourceTypePipeline:        "pipeline_id",
+	auditResourceTypePipelineVersion: "version_id",
+}
+
+type auditCallKey struct{}
+
+// auditCall collects the audit event of a call while the call is handled.
+// Its methods can be called on nil, for the calls that are not audited.
+type auditCall struct {
+	mutex sync.Mutex
+	event model.AuditEvent
+	// Whether the request was authenticated, successfully or not.
+	authenticated bool
+}
+
+func newAuditCall(ctx context.Context, method string, resourceType string) (context.Context, *auditCall) {
+	call := &auditCall{event: model.AuditEvent{Method: method, ResourceType: resourceType}}
+	return context.WithValue(ctx, auditCallKey{}, call), call
+}
+
+// auditCallFromContext returns the audit call of a context, or nil if the
+// call is not audited.
+func auditCallFromContext(ctx context.Context) *auditCall {
+	if ctx == nil {
+		return nil
+	}
+	call, _ := ctx.Value(auditCallKey{}).(*auditCall)
+	return call
+}
+
+// setUser records the result of the authentication of the call, user is nil
+// if the authentication failed.
+func (c *auditCall) setUser(user *kfpauth.UserInfo) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.authenticated = true
+	if user != nil {
+		c.event.UserIdentity = user.Name
+	}
+}
+
+// setNamespace records the namespace of the call. Calls that are authorized
+// several times keep the first namespace.
+func (c *auditCall) setNamespace(namespace string) {
+	if c == nil || namespace == "" {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.event.Namespace == "" {
+		c.event.Namespace = namespace
+	}
+}
+
+func (c *auditCall) setResourceID(id string) {
+	if c == nil || id == "" {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.event.ResourceUUID = id
+}
+
+// record stores the audit event of a finished call. Failures are logged only,
+// since the call itself already happened.
+func (c *auditCall) record(ctx context.Context, resourceManager *resource.ResourceManager, err error) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	event := c.event
+	authenticated := c.authenticated
+	c.mutex.Unlock()
+
+	if !authenticated {
+		// The call was not authorized, e.g. in single-user mode, the user is
+		// recorded if the request identifies one.
+		if user, authErr := resourceManager.AuthenticateRequest(ctx); authErr == nil && user != nil {
+			event.UserIdentity = user.Name
+		}
+	}
+	event.Outcome = AuditOutcomeOK
+	if err != nil {
+		event.Outcome = status.Code(util.ToGRPCError(err)).String()
+		event.Error = err.Error()
+	}
+	if _, err := resourceManager.CreateAuditEvent(&event); err != nil {
+		glog.Errorf("Failed to record the audit event of %s: %+v", event.Method, err)
+	}
+}
+
+// AuditUnaryCall calls the handler of a unary call and, if the method can
+// change resources, records an audit event of the call.
+func AuditUnaryCall(resourceManager *resource.ResourceManager, ctx context.Context, req interface{}, fullMethod string, handler grpc.UnaryHandler) (interface{}, error) {
+	service, method := splitFullMethod(fullMethod)
+	if !isAuditedMethod(service, method) {
+		return handler(ctx, req)
+	}
+	resourceType := auditResourceType(service, method)
+	ctx, call := newAuditCall(ctx, fullMethod, resourceType)
+	resp, err := handler(ctx, req)
+	call.setResourceID(auditResourceID(resourceType, req, resp))
+	call.record(ctx, resourceManager, err)
+	return resp, err
+}
+
+// splitFullMethod splits a method name like /api.RunService/CreateRun into
+// the service and the method, without the package.
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	index := strings.LastIndex(fullMethod, "/")
+	if index < 0 {
+		return "", fullMethod
+	}
+	service := fullMethod[:index]
+	if dot := strings.LastIndex(service, "."); dot >= 0 {
+		service = service[dot+1:]
+	}
+	return service, fullMethod[index+1:]
+}
+
+func isAuditedMethod(service string, method string) bool {
+	if unauditedServices[service] {
+		return false
+	}
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// auditResourceType returns the type of the resources of a method, the name
+// of its service without the Service suffix.
+func auditResourceType(service string, method string) string {
+	resourceType := strings.TrimSuffix(service, "Service")
+	if resourceType == auditResourceTypePipeline && strings.Contains(method, auditResourceTypePipelineVersion) {
+		return auditResourceTypePipelineVersion
+	}
+	return resourceType
+}
+
+// auditResourceID returns the ID of the resource of a call. This is the ID of
+// the returned resource, e.g. the created run, or the ID in the request for
+// the calls that return nothing, e.g. deletions.
+func auditResourceID(resourceType string, req interface{}, resp interface{}) string {
+	if message, ok := resp.(protoreflect.ProtoMessage); ok && message.ProtoReflect().IsValid() {
+		if id := messageID(message.ProtoReflect(), "id"); id != "" {
+			return id
+		}
+		// The resource is nested in details, e.g. RunDetail.run.
+		id := ""
+		message.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if field.Kind() == protoreflect.MessageKind && field.Cardinality() != protoreflect.Repeated && !field.IsMap() {
+				id = messageID(value.Message(), "id")
+			}
+			return id == ""
+		})
+		if id != "" {
+			return id
+		}
+	}
+	if message, ok := req.(protoreflect.ProtoMessage); ok {
+		if field, ok := auditResourceIDFields[resourceType]; ok {
+			if id := messageID(message.ProtoReflect(), field); id != "" {
+				return id
+			}
+		}
+		return messageID(message.ProtoReflect(), "id")
+	}
+	return ""
+}
+
+// messageID returns the value of a string field of a message, or "" if the
+// message has no such field.
+func messageID(message protoreflect.Message, name protoreflect.Name) string {
+	if !message.IsValid() {
+		return ""
+	}
+	field := message.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.Cardinality() == protoreflect.Repeated {
+		return ""
+	}
+	return message.Get(field).String()
+}
+
+// httpAuditContext returns the context of an HTTP request, with the headers
+// of the request as the gRPC metadata read by the authenticators.
+func httpAuditContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Append(key, values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
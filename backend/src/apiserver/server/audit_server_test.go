@@ -0,0 +1,135 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func initWithAuditEvents(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	resourceManager := resource.NewResourceManager(clientManager)
+	events := []*model.AuditEvent{
+		{UserIdentity: "user@google.com", Method: "/api.RunService/CreateRun", ResourceType: "Run", ResourceUUID: "run1", Namespace: "ns1", Outcome: "OK"},
+		{UserIdentity: "user@google.com", Method: "/api.JobService/CreateJob", ResourceType: "Job", ResourceUUID: "job1", Namespace: "ns2", Outcome: "OK"},
+	}
+	for i, event := range events {
+		clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal([]string{resource.DefaultFakeUUID, resource.FakeUUIDOne}[i], nil))
+		_, err := clientManager.AuditEventStore().CreateAuditEvent(event)
+		require.Nil(t, err)
+	}
+	return clientManager, resourceManager
+}
+
+func TestListAuditEvents(t *testing.T) {
+	clientManager, resourceManager := initWithAuditEvents(t)
+	defer clientManager.Close()
+	server := NewAuditServer(resourceManager)
+
+	result, err := server.ListAuditEvents(nil, &api.ListAuditEventsRequest{SortBy: "created_at desc"})
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), result.TotalSize)
+	assert.Equal(t, []*api.AuditEvent{
+		{
+			Id:           resource.FakeUUIDOne,
+			CreatedAt:    &timestamp.Timestamp{Seconds: 2},
+			User:         "user@google.com",
+			Method:       "/api.JobService/CreateJob",
+			ResourceType: "Job",
+			ResourceId:   "job1",
+			Namespace:    "ns2",
+			Outcome:      "OK",
+		},
+		{
+			Id:           resource.DefaultFakeUUID,
+			CreatedAt:    &timestamp.Timestamp{Seconds: 1},
+			User:         "user@google.com",
+			Method:       "/api.RunService/CreateRun",
+			ResourceType: "Run",
+			ResourceId:   "run1",
+			Namespace:    "ns1",
+			Outcome:      "OK",
+		},
+	}, result.AuditEvents)
+}
+
+func TestListAuditEvents_SingleUser_NamespaceNotAllowed(t *testing.T) {
+	clientManager, resourceManager := initWithAuditEvents(t)
+	defer clientManager.Close()
+	server := NewAuditServer(resourceManager)
+
+	_, err := server.ListAuditEvents(nil, &api.ListAuditEventsRequest{
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "In single-user mode, ListAuditEvents cannot filter by namespace.")
+}
+
+func TestListAuditEvents_Multiuser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, resourceManager := initWithAuditEvents(t)
+	defer clientManager.Close()
+	server := NewAuditServer(resourceManager)
+
+	result, err := server.ListAuditEvents(ctx, &api.ListAuditEventsRequest{
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), result.TotalSize)
+	require.Len(t, result.AuditEvents, 1)
+	assert.Equal(t, "run1", result.AuditEvents[0].ResourceId)
+
+	_, err = server.ListAuditEvents(ctx, &api.ListAuditEventsRequest{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ListAuditEvents requires filtering by namespace.")
+}
+
+func TestListAuditEvents_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, _ := initWithAuditEvents(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	server := NewAuditServer(resource.NewResourceManager(clientManager))
+
+	_, err := server.ListAuditEvents(ctx, &api.ListAuditEventsRequest{
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+}
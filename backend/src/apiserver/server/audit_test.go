@@ -0,0 +1,223 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func listAllAuditEvents(t *testing.T, clientManager *resource.FakeClientManager) []*model.AuditEvent {
+	opts, err := list.NewOptions(&model.AuditEvent{}, 100, "", nil)
+	require.Nil(t, err)
+	events, _, _, err := clientManager.AuditEventStore().ListAuditEvents(&common.FilterContext{}, opts)
+	require.Nil(t, err)
+	return events
+}
+
+func TestAuditUnaryCall_CreateExperiment(t *testing.T) {
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer clientManager.Close()
+	var sinkBuffer bytes.Buffer
+	clientManager.AuditEventSinkFake = storage.NewJSONLinesAuditEventSink(&sinkBuffer)
+	resourceManager := resource.NewResourceManager(clientManager)
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.CreateExperiment(ctx, req.(*api.CreateExperimentRequest))
+	}
+
+	resp, err := AuditUnaryCall(resourceManager, context.Background(),
+		&api.CreateExperimentRequest{Experiment: &api.Experiment{Name: "exp1"}},
+		"/api.ExperimentService/CreateExperiment", handler)
+	require.Nil(t, err)
+
+	events := listAllAuditEvents(t, clientManager)
+	require.Len(t, events, 1)
+	assert.Equal(t, &model.AuditEvent{
+		UUID:           resource.DefaultFakeUUID,
+		CreatedAtInSec: events[0].CreatedAtInSec,
+		Method:         "/api.ExperimentService/CreateExperiment",
+		ResourceType:   "Experiment",
+		ResourceUUID:   resp.(*api.Experiment).Id,
+		Outcome:        AuditOutcomeOK,
+	}, events[0])
+	assert.Contains(t, sinkBuffer.String(), `"method":"/api.ExperimentService/CreateExperiment"`)
+}
+
+func TestAuditUnaryCall_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, resourceManager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clientManager.Close()
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.DeleteExperiment(ctx, req.(*api.DeleteExperimentRequest))
+	}
+
+	_, err := AuditUnaryCall(resourceManager, ctx, &api.DeleteExperimentRequest{Id: experiment.UUID},
+		"/api.ExperimentService/DeleteExperiment", handler)
+	require.NotNil(t, err)
+
+	events := listAllAuditEvents(t, clientManager)
+	require.Len(t, events, 1)
+	assert.Equal(t, userIdentity, events[0].UserIdentity)
+	assert.Equal(t, "Experiment", events[0].ResourceType)
+	assert.Equal(t, experiment.UUID, events[0].ResourceUUID)
+	assert.Equal(t, "ns1", events[0].Namespace)
+	assert.Equal(t, "PermissionDenied", events[0].Outcome)
+	assert.Contains(t, events[0].Error, "is not authorized")
+}
+
+func TestAuditUnaryCall_ReadOnlyMethod(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	server := ExperimentServer{resourceManager: resourceManager, options: &ExperimentServerOptions{CollectMetrics: false}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.GetExperiment(ctx, req.(*api.GetExperimentRequest))
+	}
+
+	_, err := AuditUnaryCall(resourceManager, context.Background(), &api.GetExperimentRequest{Id: experiment.UUID},
+		"/api.ExperimentService/GetExperiment", handler)
+	require.Nil(t, err)
+	assert.Empty(t, listAllAuditEvents(t, clientManager))
+}
+
+func TestUploadPipeline_AuditEvent(t *testing.T) {
+	clientManager, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Workflow", writer)
+	response := uploadPipeline("/apis/v1beta1/pipelines/upload",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.UploadPipeline)
+	require.Equal(t, 200, response.Code)
+
+	events := listAllAuditEvents(t, clientManager)
+	require.Len(t, events, 1)
+	assert.Equal(t, uploadPipelineAuditMethod, events[0].Method)
+	assert.Equal(t, "Pipeline", events[0].ResourceType)
+	assert.Equal(t, resource.DefaultFakeUUID, events[0].ResourceUUID)
+	assert.Equal(t, AuditOutcomeOK, events[0].Outcome)
+}
+
+func TestUploadPipelineVersion_AuditEventOfError(t *testing.T) {
+	clientManager, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Workflow", writer)
+	response := uploadPipeline("/apis/v1beta1/pipelines/upload_version?name="+fakeVersionName,
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.UploadPipelineVersion)
+	require.Equal(t, 400, response.Code)
+
+	events := listAllAuditEvents(t, clientManager)
+	require.Len(t, events, 1)
+	assert.Equal(t, uploadPipelineVersionAuditMethod, events[0].Method)
+	assert.Equal(t, "PipelineVersion", events[0].ResourceType)
+	assert.Equal(t, "", events[0].ResourceUUID)
+	assert.Equal(t, "Internal", events[0].Outcome)
+	assert.Contains(t, events[0].Error, "Please specify a pipeline id when creating versions.")
+}
+
+func TestAuditedMethods(t *testing.T) {
+	tests := []struct {
+		fullMethod   string
+		audited      bool
+		resourceType string
+	}{
+		{"/api.RunService/CreateRun", true, "Run"},
+		{"/api.RunService/RetryRun", true, "Run"},
+		{"/api.RunService/ListRuns", false, "Run"},
+		{"/api.RunService/ReadArtifact", false, "Run"},
+		{"/api.JobService/DisableJob", true, "Job"},
+		{"/api.PipelineService/DeletePipeline", true, "Pipeline"},
+		{"/api.PipelineService/UpdatePipelineDefaultVersion", true, "Pipeline"},
+		{"/api.PipelineService/CreatePipelineVersion", true, "PipelineVersion"},
+		{"/api.PipelineService/GetPipelineVersionTemplate", false, "PipelineVersion"},
+		{"/api.ReportService/ReportWorkflow", false, "Report"},
+		{"/api.AuthService/Authorize", false, "Auth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			service, method := splitFullMethod(tt.fullMethod)
+			assert.Equal(t, tt.audited, isAuditedMethod(service, method))
+			assert.Equal(t, tt.resourceType, auditResourceType(service, method))
+		})
+	}
+}
+
+func TestAuditResourceID(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		req          interface{}
+		resp         interface{}
+		id           string
+	}{
+		{
+			name:         "response",
+			resourceType: "Job",
+			req:          &api.CreateJobRequest{Job: &api.Job{Name: "job1"}},
+			resp:         &api.Job{Id: "job1"},
+			id:           "job1",
+		},
+		{
+			name:         "nested response",
+			resourceType: "Run",
+			req:          &api.CloneRunRequest{RunId: "run1"},
+			resp:         &api.RunDetail{Run: &api.Run{Id: "run2"}},
+			id:           "run2",
+		},
+		{
+			name:         "request",
+			resourceType: "Run",
+			req:          &api.TerminateRunRequest{RunId: "run1"},
+			resp:         &api.RunDetail{},
+			id:           "run1",
+		},
+		{
+			name:         "request of failed call",
+			resourceType: "PipelineVersion",
+			req:          &api.DeletePipelineVersionRequest{VersionId: "version1"},
+			resp:         (*api.RunDetail)(nil),
+			id:           "version1",
+		},
+		{
+			name:         "request id",
+			resourceType: "Experiment",
+			req:          &api.ArchiveExperimentRequest{Id: "experiment1"},
+			id:           "experiment1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.id, auditResourceID(tt.resourceType, tt.req, tt.resp))
+		})
+	}
+}
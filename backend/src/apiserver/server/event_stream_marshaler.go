@@ -0,0 +1,84 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// EventStreamContentType is the MIME type of server-sent events.
+const EventStreamContentType = "text/event-stream"
+
+// EventStreamMarshaler is a grpc-gateway marshaler that writes the messages of
+// server streaming APIs as server-sent events
+// (https://html.spec.whatwg.org/multipage/server-sent-events.html).
+//
+// grpc-gateway wraps every streamed message in a {"result": message} object,
+// and the error that ends the stream in an {"error": status} object. The
+// marshaler sends the message as the data of a default event, and the error
+// as the data of an "error" event.
+type EventStreamMarshaler struct {
+	runtime.Marshaler
+}
+
+// NewEventStreamMarshaler creates an EventStreamMarshaler that encodes the
+// event data like the default grpc-gateway marshaler.
+func NewEventStreamMarshaler() *EventStreamMarshaler {
+	return &EventStreamMarshaler{Marshaler: &runtime.JSONPb{OrigName: true}}
+}
+
+// ContentType implements runtime.Marshaler.
+func (m *EventStreamMarshaler) ContentType() string {
+	return EventStreamContentType
+}
+
+// Marshal implements runtime.Marshaler.
+func (m *EventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	var event bytes.Buffer
+	isError := false
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		if result, ok := chunk["result"]; ok {
+			v = result
+		}
+	case map[string]proto.Message:
+		if streamError, ok := chunk["error"]; ok {
+			isError = true
+			v = streamError
+			event.WriteString("event: error\n")
+		}
+	}
+	data, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// The data of an event can't have line breaks, and the default marshaler
+	// doesn't indent the JSON it writes.
+	event.WriteString("data: ")
+	event.Write(data)
+	if isError {
+		// grpc-gateway doesn't write a delimiter after the error.
+		event.Write(m.Delimiter())
+	}
+	return event.Bytes(), nil
+}
+
+// Delimiter implements runtime.Delimited. A blank line ends an event.
+func (m *EventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
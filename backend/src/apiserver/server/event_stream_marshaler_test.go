@@ -0,0 +1,46 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStreamMarshaler_Result(t *testing.T) {
+	marshaler := NewEventStreamMarshaler()
+	data, err := marshaler.Marshal(map[string]interface{}{
+		"result": &api.RunEvent{Type: api.RunEvent_CREATED, Run: &api.Run{Id: "run1"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `data: {"type":"CREATED","run":{"id":"run1"}}`, string(data))
+	assert.Equal(t, "\n\n", string(marshaler.Delimiter()))
+}
+
+func TestEventStreamMarshaler_Error(t *testing.T) {
+	marshaler := NewEventStreamMarshaler()
+	data, err := marshaler.Marshal(map[string]proto.Message{
+		"error": &api.Error{ErrorMessage: "bad"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "event: error\ndata: {\"error_message\":\"bad\"}\n\n", string(data))
+}
+
+func TestEventStreamMarshaler_ContentType(t *testing.T) {
+	assert.Equal(t, "text/event-stream", NewEventStreamMarshaler().ContentType())
+}
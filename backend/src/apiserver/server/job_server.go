@@ -16,6 +16,8 @@ package server
 
 import (
 	"context"
+	"path"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
@@ -23,6 +25,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -63,6 +66,11 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
+	backfillJobRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "job_server_backfill_requests",
+		Help: "The total number of BackfillJob requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -112,6 +120,12 @@ func (s *JobServer) CreateJob(ctx context.Context, request *api.CreateJobRequest
 			return nil, util.Wrap(err, "Failed to authorize the request")
 		}
 	}
+	if upstream := request.Job.GetTrigger().GetRunDependencyTrigger().GetUpstream(); upstream != nil {
+		err = s.canAccessRunDependencyUpstream(ctx, upstream)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize the request")
+		}
+	}
 
 	newJob, err := s.resourceManager.CreateJob(ctx, request.Job)
 	if err != nil {
@@ -230,6 +244,27 @@ func (s *JobServer) DisableJob(ctx context.Context, request *api.DisableJobReque
 	return s.enableJob(ctx, request.Id, false)
 }
 
+func (s *JobServer) BackfillJob(ctx context.Context, request *api.BackfillJobRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		backfillJobRequests.Inc()
+	}
+
+	err := s.canAccessJob(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbBackfill})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	if request.StartTime == nil || request.EndTime == nil {
+		return nil, util.NewInvalidInputError("The start time and end time of the backfill are required.")
+	}
+	err = s.resourceManager.BackfillJob(ctx, request.Id,
+		time.Unix(request.StartTime.Seconds, 0), time.Unix(request.EndTime.Seconds, 0))
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
 func (s *JobServer) DeleteJob(ctx context.Context, request *api.DeleteJobRequest) (*empty.Empty, error) {
 	if s.options.CollectMetrics {
 		deleteJobRequests.Inc()
@@ -265,6 +300,12 @@ func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) erro
 			return util.NewInvalidInputError(
 				"Schedule cron is not a supported format(https://godoc.org/github.com/robfig/cron). Error: %v", err)
 		}
+		if timeZone := job.Trigger.GetCronSchedule().TimeZone; timeZone != "" {
+			if _, err := time.LoadLocation(timeZone); err != nil {
+				return util.NewInvalidInputError(
+					"Schedule time zone %q is not a valid IANA time zone. Error: %v", timeZone, err)
+			}
+		}
 	}
 	if job.Trigger != nil && job.Trigger.GetPeriodicSchedule() != nil {
 		periodicScheduleInterval := job.Trigger.GetPeriodicSchedule().IntervalSecond
@@ -273,6 +314,42 @@ func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) erro
 				"Found invalid period schedule interval %v. Set at interval to least 1 second.", periodicScheduleInterval)
 		}
 	}
+	if job.Trigger != nil && job.Trigger.GetBucketObjectTrigger() != nil {
+		trigger := job.Trigger.GetBucketObjectTrigger()
+		if _, err := objectstore.ParseBucketConfig(trigger.Bucket); err != nil {
+			return util.NewInvalidInputError(
+				"Bucket object trigger has an invalid bucket %q. Error: %v", trigger.Bucket, err)
+		}
+		if _, err := path.Match(trigger.Pattern, ""); err != nil {
+			return util.NewInvalidInputError(
+				"Bucket object trigger has an invalid pattern %q. Error: %v", trigger.Pattern, err)
+		}
+		if trigger.ParameterName == "" {
+			return util.NewInvalidInputError(
+				"Bucket object trigger needs the name of the parameter to set to the URI of the object.")
+		}
+	}
+	if job.Trigger != nil && job.Trigger.GetRunDependencyTrigger() != nil {
+		trigger := job.Trigger.GetRunDependencyTrigger()
+		upstream := trigger.GetUpstream()
+		switch upstream.GetType() {
+		case api.ResourceType_JOB:
+			if _, err := s.resourceManager.GetJob(upstream.GetId()); err != nil {
+				return util.Wrapf(err, "Run dependency trigger has an invalid upstream job %q", upstream.GetId())
+			}
+		case api.ResourceType_PIPELINE_VERSION:
+			if _, err := s.resourceManager.GetPipelineVersion(upstream.GetId()); err != nil {
+				return util.Wrapf(err, "Run dependency trigger has an invalid upstream pipeline version %q", upstream.GetId())
+			}
+		default:
+			return util.NewInvalidInputError(
+				"Run dependency trigger needs an upstream job or pipeline version. Received %v.", upstream.GetType())
+		}
+		if trigger.ParameterName == "" {
+			return util.NewInvalidInputError(
+				"Run dependency trigger needs the name of the parameter to set to the ID of the upstream run.")
+		}
+	}
 	return nil
 }
 
@@ -324,6 +401,33 @@ func (s *JobServer) canAccessJob(ctx context.Context, jobID string, resourceAttr
 	return nil
 }
 
+// canAccessRunDependencyUpstream checks that the user can get the upstream job
+// or pipeline version of a run dependency trigger, whose runs reveal their IDs
+// to the runs of the job.
+func (s *JobServer) canAccessRunDependencyUpstream(ctx context.Context, upstream *api.ResourceKey) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet}
+	if upstream.GetType() == api.ResourceType_JOB {
+		return s.canAccessJob(ctx, upstream.GetId(), resourceAttributes)
+	}
+	namespace, err := s.resourceManager.GetNamespaceFromPipelineVersion(upstream.GetId())
+	if err != nil {
+		return util.Wrap(err, "Failed to get namespace from the upstream pipeline version")
+	}
+	if namespace == "" {
+		// The pipeline version is shared.
+		return nil
+	}
+	resourceAttributes.Namespace = namespace
+	resourceAttributes.Group = common.RbacPipelinesGroup
+	resourceAttributes.Version = common.RbacPipelinesVersion
+	resourceAttributes.Resource = common.RbacResourceTypePipelines
+	return isAuthorized(s.resourceManager, ctx, resourceAttributes)
+}
+
 func NewJobServer(resourceManager *resource.ResourceManager, options *JobServerOptions) *JobServer {
 	return &JobServer{resourceManager: resourceManager, options: options}
 }
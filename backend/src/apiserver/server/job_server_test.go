@@ -241,6 +241,121 @@ func TestValidateApiJob_InvalidCron(t *testing.T) {
 	assert.Contains(t, err.Error(), "Schedule cron is not a supported format")
 }
 
+func TestValidateApiJob_InvalidTimeZone(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_CronSchedule{CronSchedule: &api.CronSchedule{
+				Cron:     "0 0 2 * * *",
+				TimeZone: "Europe/Nowhere",
+			}}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "is not a valid IANA time zone")
+
+	apiJob.Trigger.GetCronSchedule().TimeZone = "Europe/Stockholm"
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+}
+
+func TestValidateApiJob_BucketObjectTrigger(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	trigger := &api.BucketObjectTrigger{
+		Bucket:        "minio://mlpipeline/incoming",
+		Pattern:       "*.csv",
+		ParameterName: "param1",
+	}
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_BucketObjectTrigger{BucketObjectTrigger: trigger}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+
+	trigger.Bucket = "mlpipeline/incoming"
+	err := server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "invalid bucket")
+
+	trigger.Bucket = "minio://mlpipeline/incoming"
+	trigger.Pattern = "["
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "invalid pattern")
+
+	trigger.Pattern = ""
+	trigger.ParameterName = ""
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "needs the name of the parameter")
+}
+
+func TestValidateApiJob_RunDependencyTrigger(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	upstreamJob, err := server.CreateJob(context.Background(), &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+	trigger := &api.RunDependencyTrigger{
+		Upstream:      &api.ResourceKey{Type: api.ResourceType_JOB, Id: upstreamJob.Id},
+		ParameterName: "param1",
+	}
+	apiJob := &api.Job{
+		Name:           "job1",
+		Enabled:        true,
+		MaxConcurrency: 1,
+		Trigger: &api.Trigger{
+			Trigger: &api.Trigger_RunDependencyTrigger{RunDependencyTrigger: trigger}},
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{Key: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, Relationship: api.Relationship_OWNER},
+		},
+	}
+	assert.Nil(t, server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob}))
+
+	trigger.Upstream.Id = "not-a-job"
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "invalid upstream job")
+
+	trigger.Upstream = &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "needs an upstream job or pipeline version")
+
+	trigger.Upstream = &api.ResourceKey{Type: api.ResourceType_JOB, Id: upstreamJob.Id}
+	trigger.ParameterName = ""
+	err = server.validateCreateJobRequest(&api.CreateJobRequest{Job: apiJob})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "needs the name of the parameter")
+}
+
 func TestValidateApiJob_MaxConcurrencyOutOfRange(t *testing.T) {
 	clients, manager, experiment := initWithExperiment(t)
 	defer clients.Close()
@@ -643,6 +758,67 @@ func TestDisableJob_Multiuser(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBackfillJob(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(context.Background(), &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	_, err = server.BackfillJob(context.Background(), &api.BackfillJobRequest{
+		Id:        job.Id,
+		StartTime: &timestamp.Timestamp{Seconds: 0},
+		EndTime:   &timestamp.Timestamp{Seconds: 1},
+	})
+	assert.Nil(t, err)
+
+	_, err = server.BackfillJob(context.Background(), &api.BackfillJobRequest{
+		Id:        job.Id,
+		StartTime: &timestamp.Timestamp{Seconds: 0},
+	})
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "start time and end time of the backfill are required")
+}
+
+func TestBackfillJob_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	userIdentity := "user@google.com"
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + userIdentity})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+	job, err := server.CreateJob(ctx, &api.CreateJobRequest{Job: commonApiJob})
+	assert.Nil(t, err)
+
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	manager = resource.NewResourceManager(clients)
+	server = NewJobServer(manager, &JobServerOptions{CollectMetrics: false})
+
+	_, err = server.BackfillJob(ctx, &api.BackfillJobRequest{
+		Id:        job.Id,
+		StartTime: &timestamp.Timestamp{Seconds: 0},
+		EndTime:   &timestamp.Timestamp{Seconds: 1},
+	})
+	assert.NotNil(t, err)
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: "ns1",
+		Verb:      common.RbacResourceVerbBackfill,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeJobs,
+		Name:      job.Name,
+	}
+	assert.EqualError(
+		t,
+		err,
+		wrapFailedAuthzRequestError(wrapFailedAuthzApiResourcesError(getPermissionDeniedError(userIdentity, resourceAttributes))).Error(),
+	)
+}
+
 func TestListJobs_Unauthenticated(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
@@ -22,6 +22,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
@@ -136,7 +137,12 @@ func (s *PipelineServer) CreatePipeline(ctx context.Context, request *api.Create
 		return nil, util.Wrap(err, "Failed to authorize with API resource references")
 	}
 
-	pipeline, err := s.resourceManager.CreatePipeline(pipelineName, request.Pipeline.Description, namespace, pipelineFile)
+	owner, err := getPipelineOwner(s.resourceManager, ctx, namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the owner of the pipeline")
+	}
+
+	pipeline, err := s.resourceManager.CreatePipeline(pipelineName, request.Pipeline.Description, namespace, owner, pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed.")
 	}
@@ -439,7 +445,7 @@ func (s *PipelineServer) DeletePipelineVersion(ctx context.Context, request *api
 		return nil, util.Wrap(err, "Failed to get Pipeline Version.")
 	}
 	resourceAttributes := &authorizationv1.ResourceAttributes{
-		Verb: common.RbacResourceVerbList,
+		Verb: common.RbacResourceVerbDelete,
 	}
 	err = s.CanAccessPipeline(ctx, pipelineVersion.PipelineId, resourceAttributes)
 	if err != nil {
@@ -483,6 +489,14 @@ func (s *PipelineServer) CanAccessPipelineVersion(ctx context.Context, versionId
 			return util.Wrap(err, "Failed to get namespace from Pipeline VersionId")
 		}
 		resourceAttributes.Namespace = namespace
+		pipelineVersion, err := s.resourceManager.GetPipelineVersion(versionId)
+		if err != nil {
+			return util.Wrap(err, "Failed to get Pipeline Version")
+		}
+		ctx, err = s.withPipelineOwner(ctx, pipelineVersion.PipelineId)
+		if err != nil {
+			return err
+		}
 	}
 	if resourceAttributes.Namespace == "" {
 		return nil
@@ -504,6 +518,10 @@ func (s *PipelineServer) CanAccessPipeline(ctx context.Context, pipelineId strin
 			return util.Wrap(err, "Failed to authorize with the Pipeline ID.")
 		}
 		resourceAttributes.Namespace = namespace
+		ctx, err = s.withPipelineOwner(ctx, pipelineId)
+		if err != nil {
+			return err
+		}
 	}
 	if resourceAttributes.Namespace == "" {
 		return nil
@@ -512,6 +530,19 @@ func (s *PipelineServer) CanAccessPipeline(ctx context.Context, pipelineId strin
 	return s.haveAccess(ctx, resourceAttributes)
 }
 
+// withPipelineOwner returns a context with the owner of a pipeline for the
+// authorization policy rules: the user that created it. The owner of the
+// pipelines that have none, such as the ones created before the owners were
+// recorded, is "", which is never the name of a user, so that the rules that
+// compare it to the user never grant their owner access.
+func (s *PipelineServer) withPipelineOwner(ctx context.Context, pipelineId string) (context.Context, error) {
+	pipeline, err := s.resourceManager.GetPipeline(pipelineId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the owner of the pipeline")
+	}
+	return kfpauth.WithResourceOwner(ctx, pipeline.Owner), nil
+}
+
 func (s *PipelineServer) haveAccess(ctx context.Context, resourceAttributes *authorizationv1.ResourceAttributes) error {
 	resourceAttributes.Group = common.RbacPipelinesGroup
 	resourceAttributes.Version = common.RbacPipelinesVersion
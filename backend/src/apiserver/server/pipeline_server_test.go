@@ -10,12 +10,16 @@ import (
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestCreatePipeline_YAML(t *testing.T) {
@@ -447,3 +451,95 @@ func getBadMockServer() *httptest.Server {
 	}))
 	return httpServer
 }
+
+// initWithPipelineOwnerPolicy returns a pipeline server in multi-user mode,
+// whose policy only lets the owners of the pipelines delete them.
+func initWithPipelineOwnerPolicy(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager, *PipelineServer) {
+	viper.Set(common.MultiUserMode, "true")
+	clientManager := resource.NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	authorizer, err := auth.NewPolicyAuthorizer(&auth.Policy{Rules: []auth.PolicyRule{
+		{
+			Effect:    auth.PolicyEffectDeny,
+			Condition: `request.resource == "pipelines" && request.verb == "delete" && request.owner != user`,
+			Reason:    "only the owners of pipelines can delete them",
+		},
+		{Effect: auth.PolicyEffectAllow, Condition: "true"},
+	}})
+	require.Nil(t, err)
+	clientManager.AuthorizerFake = authorizer
+	resourceManager := resource.NewResourceManager(clientManager)
+	pipelineServer := &PipelineServer{resourceManager: resourceManager, options: &PipelineServerOptions{CollectMetrics: false}}
+	return clientManager, resourceManager, pipelineServer
+}
+
+func userContext(user string) context.Context {
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + user})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestDeletePipelineVersion_PolicyOnPipelineOwner(t *testing.T) {
+	clientManager, resourceManager, pipelineServer := initWithPipelineOwnerPolicy(t)
+	defer viper.Set(common.MultiUserMode, "false")
+	defer clientManager.Close()
+	// The pipeline was created by alice.
+	pipeline, err := resourceManager.CreatePipeline("pipeline", "", "ns1", "alice", []byte(testWorkflow.ToStringForStore()))
+	require.Nil(t, err)
+	// The audit events of the other calls on the pipeline don't make their
+	// users owners.
+	_, err = clientManager.AuditEventStore().CreateAuditEvent(&model.AuditEvent{
+		UserIdentity: "bob",
+		Method:       uploadPipelineVersionAuditMethod,
+		ResourceType: auditResourceTypePipeline,
+		ResourceUUID: pipeline.UUID,
+		Namespace:    "ns1",
+		Outcome:      AuditOutcomeOK,
+	})
+	require.Nil(t, err)
+
+	_, err = pipelineServer.DeletePipelineVersion(userContext("bob"), &api.DeletePipelineVersionRequest{VersionId: pipeline.DefaultVersionId})
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.PermissionDenied))
+	assert.Contains(t, err.Error(), "only the owners of pipelines can delete them")
+
+	_, err = pipelineServer.DeletePipelineVersion(userContext("alice"), &api.DeletePipelineVersionRequest{VersionId: pipeline.DefaultVersionId})
+	assert.Nil(t, err)
+}
+
+func TestDeletePipeline_PolicyOnPipelineWithoutOwner(t *testing.T) {
+	clientManager, resourceManager, pipelineServer := initWithPipelineOwnerPolicy(t)
+	defer viper.Set(common.MultiUserMode, "false")
+	defer clientManager.Close()
+	// The pipeline was created before the owners were recorded.
+	pipeline, err := resourceManager.CreatePipeline("pipeline", "", "ns1", "", []byte(testWorkflow.ToStringForStore()))
+	require.Nil(t, err)
+
+	// Nobody owns it.
+	for _, user := range []string{"alice", "bob"} {
+		_, err = pipelineServer.DeletePipeline(userContext(user), &api.DeletePipelineRequest{Id: pipeline.UUID})
+		require.NotNil(t, err, user)
+		assert.Contains(t, err.Error(), "only the owners of pipelines can delete them", user)
+	}
+}
+
+func TestCreatePipeline_MultiUserOwner(t *testing.T) {
+	httpServer := getMockServer(t)
+	defer httpServer.Close()
+	clientManager, resourceManager, pipelineServer := initWithPipelineOwnerPolicy(t)
+	defer viper.Set(common.MultiUserMode, "false")
+	defer clientManager.Close()
+	pipelineServer.httpClient = httpServer.Client()
+
+	pipeline, err := pipelineServer.CreatePipeline(userContext("alice"), &api.CreatePipelineRequest{
+		Pipeline: &api.Pipeline{
+			Url:  &api.Url{PipelineUrl: httpServer.URL + "/arguments-parameters.yaml"},
+			Name: "argument-parameters",
+			ResourceReferences: []*api.ResourceReference{{
+				Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+				Relationship: api.Relationship_OWNER,
+			}},
+		}})
+	require.Nil(t, err)
+	newPipeline, err := resourceManager.GetPipeline(pipeline.Id)
+	require.Nil(t, err)
+	assert.Equal(t, "alice", newPipeline.Owner)
+}
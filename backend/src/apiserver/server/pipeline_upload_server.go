@@ -79,6 +79,10 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 	}
 
 	glog.Infof("Upload pipeline called")
+	ctx, auditCall := newAuditCall(httpAuditContext(r), uploadPipelineAuditMethod, auditResourceTypePipeline)
+	var err error
+	defer func() { auditCall.record(ctx, s.resourceManager, err) }()
+
 	file, header, err := r.FormFile(FormFileKey)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline from file"))
@@ -99,7 +103,8 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	err = s.canUploadVersionedPipeline(r, pipelineNamespace)
+	auditCall.setNamespace(pipelineNamespace)
+	err = s.canUploadVersionedPipeline(ctx, pipelineNamespace)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Authorization to namespace failed."))
 		return
@@ -116,11 +121,17 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Error read pipeline description."))
 		return
 	}
-	newPipeline, err := s.resourceManager.CreatePipeline(pipelineName, pipelineDescription, pipelineNamespace, pipelineFile)
+	owner, err := getPipelineOwner(s.resourceManager, ctx, pipelineNamespace)
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to get the owner of the pipeline."))
+		return
+	}
+	newPipeline, err := s.resourceManager.CreatePipeline(pipelineName, pipelineDescription, pipelineNamespace, owner, pipelineFile)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline"))
 		return
 	}
+	auditCall.setResourceID(newPipeline.UUID)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -143,6 +154,10 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 	}
 
 	glog.Infof("Upload pipeline version called")
+	ctx, auditCall := newAuditCall(httpAuditContext(r), uploadPipelineVersionAuditMethod, auditResourceTypePipelineVersion)
+	var err error
+	defer func() { auditCall.record(ctx, s.resourceManager, err) }()
+
 	file, header, err := r.FormFile(FormFileKey)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline version from file"))
@@ -168,7 +183,8 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 
 	pipelineId := r.URL.Query().Get(PipelineKey)
 	if len(pipelineId) == 0 {
-		s.writeErrorToResponse(w, http.StatusBadRequest, errors.New("Please specify a pipeline id when creating versions."))
+		err = errors.New("Please specify a pipeline id when creating versions.")
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
 		return
 	}
 
@@ -178,7 +194,8 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		return
 	}
 
-	err = s.canUploadVersionedPipeline(r, namespace)
+	auditCall.setNamespace(namespace)
+	err = s.canUploadVersionedPipeline(ctx, namespace)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Authorization to namespace failed."))
 		return
@@ -202,6 +219,7 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline version"))
 		return
 	}
+	auditCall.setResourceID(newPipelineVersion.UUID)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -221,11 +239,12 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 	}
 }
 
-func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, namespace string) error {
+// canUploadVersionedPipeline authenticates the user of an upload request like
+// the gRPC requests, from the metadata of the request headers in ctx.
+func (s *PipelineUploadServer) canUploadVersionedPipeline(ctx context.Context, namespace string) error {
 	if namespace == "" {
 		return nil
 	}
-	userIdentityHeader := r.Header.Get(common.GetKubeflowUserIDHeader())
 	resourceAttributes := &authorizationv1.ResourceAttributes{
 		Namespace: namespace,
 		Verb:      common.RbacResourceVerbCreate,
@@ -233,7 +252,7 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, names
 		Version:   common.RbacPipelinesVersion,
 		Resource:  common.RbacResourceTypePipelines,
 	}
-	err := s.resourceManager.IsRequestAuthorized(context.TODO(), userIdentityHeader, resourceAttributes)
+	err := isAuthorized(s.resourceManager, ctx, resourceAttributes)
 	if err != nil {
 		return util.Wrap(err, "Authorization Failure.")
 	}
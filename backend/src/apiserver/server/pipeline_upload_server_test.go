@@ -447,6 +447,34 @@ func TestDefaultUpdatedPipelineVersion(t *testing.T) {
 	assert.Equal(t, pipeline.DefaultVersionId, fakeVersionUUID)
 }
 
+func TestUploadPipeline_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, server := setupClientManagerAndServer()
+
+	// The user is authenticated from the request headers.
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Workflow", writer)
+	response := uploadPipeline("/apis/v1beta1/pipelines/upload?namespace=ns1",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.UploadPipeline)
+	assert.Equal(t, 400, response.Code)
+	assert.Contains(t, response.Body.String(), "Authorization to namespace failed")
+
+	bytesBuffer, writer = setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Workflow", writer)
+	req, _ := http.NewRequest("POST", "/apis/v1beta1/pipelines/upload?namespace=ns1", bytes.NewReader(bytesBuffer.Bytes()))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set(common.GoogleIAPUserIdentityHeader, common.GoogleIAPUserIdentityPrefix+"user@google.com")
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(server.UploadPipeline).ServeHTTP(rr, req)
+	assert.Equal(t, 200, rr.Code, rr.Body.String())
+
+	// The user owns the pipeline.
+	pipeline, err := clientManager.PipelineStore().GetPipeline(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, "user@google.com", pipeline.Owner)
+}
+
 func setWriterWithBuffer(fieldname string, filename string, buffer string, writer *multipart.Writer) {
 	part, _ := writer.CreateFormFile(fieldname, filename)
 	io.Copy(part, bytes.NewBufferString(buffer))
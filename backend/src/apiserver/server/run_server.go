@@ -20,12 +20,14 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
@@ -82,6 +84,31 @@ var (
 		Help: "The total number of RetryRun requests",
 	})
 
+	watchRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_watch_requests",
+		Help: "The total number of WatchRuns requests",
+	})
+
+	compareRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_compare_requests",
+		Help: "The total number of CompareRuns requests",
+	})
+
+	suspendRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_suspend_requests",
+		Help: "The total number of SuspendRun requests",
+	})
+
+	resumeRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_resume_requests",
+		Help: "The total number of ResumeRun requests",
+	})
+
+	cloneRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_clone_requests",
+		Help: "The total number of CloneRun requests",
+	})
+
 	// TODO(jingzhang36): error count and success count.
 
 	runCount = promauto.NewGauge(prometheus.GaugeOpts{
@@ -104,13 +131,17 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 		createRunRequests.Inc()
 	}
 
-	err := s.validateCreateRunRequest(request)
+	return s.createRun(ctx, request.Run)
+}
+
+func (s *RunServer) createRun(ctx context.Context, apiRun *api.Run) (*api.RunDetail, error) {
+	err := s.validateCreateRunRequest(&api.CreateRunRequest{Run: apiRun})
 	if err != nil {
 		return nil, util.Wrap(err, "Validate create run request failed.")
 	}
 
 	if common.IsMultiUserMode() {
-		experimentID := common.GetExperimentIDFromAPIResourceReferences(request.Run.ResourceReferences)
+		experimentID := common.GetExperimentIDFromAPIResourceReferences(apiRun.ResourceReferences)
 		if experimentID == "" {
 			return nil, util.NewInvalidInputError("Job has no experiment.")
 		}
@@ -124,7 +155,7 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 		resourceAttributes := &authorizationv1.ResourceAttributes{
 			Namespace: namespace,
 			Verb:      common.RbacResourceVerbCreate,
-			Name:      request.Run.Name,
+			Name:      apiRun.Name,
 		}
 		err = s.canAccessRun(ctx, "", resourceAttributes)
 		if err != nil {
@@ -132,7 +163,7 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 		}
 	}
 
-	run, err := s.resourceManager.CreateRun(ctx, request.Run)
+	run, err := s.resourceManager.CreateRun(ctx, apiRun)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create a new run.")
 	}
@@ -175,33 +206,47 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 		return nil, util.Wrap(err, "Validating filter failed.")
 	}
 
+	if err := s.canListRuns(ctx, "ListRuns", filterContext, request.ResourceReferenceKey); err != nil {
+		return nil, err
+	}
+
+	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to list runs.")
+	}
+	return &api.ListRunsResponse{Runs: ToApiRuns(runs), TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
+}
+
+// canListRuns checks that the caller can list the runs of a resource reference
+// in multi-user mode.
+func (s *RunServer) canListRuns(ctx context.Context, apiName string, filterContext *common.FilterContext, referenceKey *api.ResourceKey) error {
 	if common.IsMultiUserMode() {
 		refKey := filterContext.ReferenceKey
 		if refKey == nil {
-			return nil, util.NewInvalidInputError("ListRuns must filter by resource reference in multi-user mode.")
+			return util.NewInvalidInputError("%s must filter by resource reference in multi-user mode.", apiName)
 		}
 		if refKey.Type == common.Namespace {
 			namespace := refKey.ID
 			if len(namespace) == 0 {
-				return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Namespace is empty.")
+				return util.NewInvalidInputError("Invalid resource references for %s. Namespace is empty.", apiName)
 			}
 			resourceAttributes := &authorizationv1.ResourceAttributes{
 				Namespace: namespace,
 				Verb:      common.RbacResourceVerbList,
 			}
-			err = s.canAccessRun(ctx, "", resourceAttributes)
+			err := s.canAccessRun(ctx, "", resourceAttributes)
 			if err != nil {
-				return nil, util.Wrap(err, "Failed to authorize with namespace resource reference.")
+				return util.Wrap(err, "Failed to authorize with namespace resource reference.")
 			}
 		} else if refKey.Type == common.Experiment || refKey.Type == "ExperimentUUID" {
 			// "ExperimentUUID" was introduced for perf optimization. We accept both refKey.Type for backward-compatible reason.
 			experimentID := refKey.ID
 			if len(experimentID) == 0 {
-				return nil, util.NewInvalidInputError("Invalid resource references for run. Experiment ID is empty.")
+				return util.NewInvalidInputError("Invalid resource references for run. Experiment ID is empty.")
 			}
 			namespace, err := s.resourceManager.GetNamespaceFromExperimentID(experimentID)
 			if err != nil {
-				return nil, util.Wrap(err, "Run's experiment has no namespace.")
+				return util.Wrap(err, "Run's experiment has no namespace.")
 			}
 			resourceAttributes := &authorizationv1.ResourceAttributes{
 				Namespace: namespace,
@@ -209,18 +254,13 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 			}
 			err = s.canAccessRun(ctx, "", resourceAttributes)
 			if err != nil {
-				return nil, util.Wrap(err, "Failed to authorize with namespace in experiment resource reference.")
+				return util.Wrap(err, "Failed to authorize with namespace in experiment resource reference.")
 			}
 		} else {
-			return nil, util.NewInvalidInputError("Invalid resource references for ListRuns. Got %+v", request.ResourceReferenceKey)
+			return util.NewInvalidInputError("Invalid resource references for %s. Got %+v", apiName, referenceKey)
 		}
 	}
-
-	runs, total_size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
-	if err != nil {
-		return nil, util.Wrap(err, "Failed to list runs.")
-	}
-	return &api.ListRunsResponse{Runs: ToApiRuns(runs), TotalSize: int32(total_size), NextPageToken: nextPageToken}, nil
+	return nil
 }
 
 func (s *RunServer) ArchiveRun(ctx context.Context, request *api.ArchiveRunRequest) (*empty.Empty, error) {
@@ -356,6 +396,165 @@ func (s *RunServer) RetryRun(ctx context.Context, request *api.RetryRunRequest)
 
 }
 
+func (s *RunServer) SuspendRun(ctx context.Context, request *api.SuspendRunRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		suspendRunRequests.Inc()
+	}
+
+	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbSuspend})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	err = s.resourceManager.SuspendRun(ctx, request.RunId)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *RunServer) ResumeRun(ctx context.Context, request *api.ResumeRunRequest) (*empty.Empty, error) {
+	if s.options.CollectMetrics {
+		resumeRunRequests.Inc()
+	}
+
+	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbResume})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	err = s.resourceManager.ResumeRun(ctx, request.RunId)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *RunServer) CloneRun(ctx context.Context, request *api.CloneRunRequest) (*api.RunDetail, error) {
+	if s.options.CollectMetrics {
+		cloneRunRequests.Inc()
+	}
+
+	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	run, err := s.resourceManager.GetRun(request.RunId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to clone the run")
+	}
+	apiRun, err := toClonedApiRun(run, request.Name, request.Parameters)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to clone the run")
+	}
+	return s.createRun(ctx, apiRun)
+}
+
+func (s *RunServer) WatchRuns(request *api.WatchRunsRequest, stream api.RunService_WatchRunsServer) error {
+	if s.options.CollectMetrics {
+		watchRunRequests.Inc()
+	}
+	ctx := stream.Context()
+
+	runFilter, err := validatedRunFilter(request.Filter)
+	if err != nil {
+		return util.Wrap(err, "Failed to create the filter")
+	}
+
+	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
+	if err != nil {
+		return util.Wrap(err, "Validating filter failed.")
+	}
+
+	if err := s.canListRuns(ctx, "WatchRuns", filterContext, request.ResourceReferenceKey); err != nil {
+		return err
+	}
+
+	watcher, err := s.resourceManager.WatchRuns()
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	// Send the headers now, so that HTTP clients get the response before the
+	// first event.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return util.Wrap(err, "Failed to send the headers")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events():
+			if !ok {
+				if watcher.FellBehind() {
+					return util.NewResourceExhaustedError(errors.New("run watcher fell behind"),
+						"The runs changed faster than they could be sent. List the runs and watch them again")
+				}
+				return nil
+			}
+			if !runMatchesReference(event.Run, filterContext) ||
+				(runFilter != nil && !runFilter.Matches(event.Run.GetFieldValue)) {
+				continue
+			}
+			if err := stream.Send(ToApiRunEvent(event)); err != nil {
+				return util.Wrap(err, "Failed to send the run event")
+			}
+		}
+	}
+}
+
+func (s *RunServer) CompareRuns(ctx context.Context, request *api.CompareRunsRequest) (*api.CompareRunsResponse, error) {
+	if s.options.CollectMetrics {
+		compareRunRequests.Inc()
+	}
+
+	if err := resource.ValidateComparedRunIds(request.RunIds); err != nil {
+		return nil, util.Wrap(err, "Failed to compare the runs")
+	}
+	for _, runId := range request.RunIds {
+		err := s.canAccessRun(ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize the request")
+		}
+	}
+
+	comparison, err := s.resourceManager.CompareRuns(request.RunIds)
+	if err != nil {
+		return nil, err
+	}
+	return ToApiRunComparison(comparison), nil
+}
+
+// validatedRunFilter parses the filter of a run request. It returns nil if the
+// request has no filter.
+func validatedRunFilter(filterSpec string) (*filter.Filter, error) {
+	filterProto, err := parseAPIFilter(filterSpec)
+	if err != nil || filterProto == nil {
+		return nil, err
+	}
+	run := &model.Run{}
+	return filter.NewWithKeyFunc(filterProto, run.GetFilterField)
+}
+
+// runMatchesReference reports whether a run belongs to the resource reference
+// of the filter context, like the runs ListRuns returns for it.
+func runMatchesReference(run *model.Run, filterContext *common.FilterContext) bool {
+	refKey := filterContext.ReferenceKey
+	if refKey == nil {
+		return true
+	}
+	switch refKey.Type {
+	case common.Experiment, "ExperimentUUID":
+		return run.ExperimentUUID == refKey.ID
+	case common.Namespace:
+		return run.Namespace == refKey.ID
+	}
+	for _, ref := range run.ResourceReferences {
+		if ref.ReferenceType == refKey.Type && ref.ReferenceUUID == refKey.ID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *RunServer) canAccessRun(ctx context.Context, runId string, resourceAttributes *authorizationv1.ResourceAttributes) error {
 	if common.IsMultiUserMode() == false {
 		// Skip authz if not multi-user mode.
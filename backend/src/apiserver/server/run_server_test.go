@@ -18,6 +18,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -827,3 +828,274 @@ func TestCanAccessRun_Unauthenticated(t *testing.T) {
 		wrapFailedAuthzApiResourcesError(kfpauth.IdentityHeaderMissingError).Error(),
 	)
 }
+
+// fakeWatchRunsServer is the server side of a WatchRuns stream.
+type fakeWatchRunsServer struct {
+	grpc.ServerStream
+	ctx        context.Context
+	headerSent chan bool
+	events     chan *api.RunEvent
+}
+
+func newFakeWatchRunsServer(ctx context.Context) *fakeWatchRunsServer {
+	return &fakeWatchRunsServer{ctx: ctx, headerSent: make(chan bool, 1), events: make(chan *api.RunEvent, 10)}
+}
+
+func (s *fakeWatchRunsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchRunsServer) SendHeader(metadata.MD) error {
+	s.headerSent <- true
+	return nil
+}
+
+func (s *fakeWatchRunsServer) Send(event *api.RunEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestWatchRuns(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeWatchRunsServer(ctx)
+
+	done := make(chan error)
+	go func() {
+		done <- server.WatchRuns(&api.WatchRunsRequest{
+			ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			Filter:               `{"predicates": [{"key": "storage_state", "op": "EQUALS", "string_value": "STORAGESTATE_ARCHIVED"}]}`,
+		}, stream)
+	}()
+	<-stream.headerSent
+
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec:       &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, manager.ArchiveRun(runDetail.UUID))
+
+	// The created run doesn't match the filter, the archived run does.
+	event := <-stream.events
+	assert.Equal(t, api.RunEvent_ARCHIVED, event.Type)
+	assert.Equal(t, runDetail.UUID, event.Run.Id)
+	assert.Equal(t, api.Run_STORAGESTATE_ARCHIVED, event.Run.StorageState)
+
+	cancel()
+	assert.Nil(t, <-done)
+	assert.Empty(t, stream.events)
+}
+
+func TestWatchRuns_FilterOnParameter(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// One stream watches the runs whose parameter matches, the other the runs
+	// whose parameter doesn't.
+	var streams []*fakeWatchRunsServer
+	done := make(chan error)
+	for _, value := range []string{"world", "hello"} {
+		stream := newFakeWatchRunsServer(ctx)
+		streams = append(streams, stream)
+		filter := `{"predicates": [{"key": "parameter.param1", "op": "EQUALS", "string_value": "` + value + `"}]}`
+		go func(stream *fakeWatchRunsServer, filter string) {
+			done <- server.WatchRuns(&api.WatchRunsRequest{
+				ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Filter:               filter,
+			}, stream)
+		}(stream, filter)
+		<-stream.headerSent
+	}
+
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+	})
+	assert.Nil(t, err)
+
+	event := <-streams[0].events
+	assert.Equal(t, api.RunEvent_CREATED, event.Type)
+	assert.Equal(t, runDetail.UUID, event.Run.Id)
+
+	cancel()
+	assert.Nil(t, <-done)
+	assert.Nil(t, <-done)
+	assert.Empty(t, streams[0].events)
+	assert.Empty(t, streams[1].events)
+}
+
+func TestWatchRuns_InvalidFilter(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	err := server.WatchRuns(&api.WatchRunsRequest{
+		Filter: `{"predicates": [{"key": "unknown", "op": "EQUALS", "string_value": "a"}]}`,
+	}, newFakeWatchRunsServer(context.Background()))
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestWatchRuns_Multiuser_NoReference(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	err := server.WatchRuns(&api.WatchRunsRequest{}, newFakeWatchRunsServer(context.Background()))
+	AssertUserError(t, err, codes.InvalidArgument)
+	assert.Contains(t, err.Error(), "WatchRuns must filter by resource reference in multi-user mode.")
+}
+
+func TestCompareRuns(t *testing.T) {
+	clientManager, resourceManager, run1 := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager)
+	runServer := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+	run2, err := resourceManager.CreateRun(context.Background(), &api.Run{
+		Name: "run2",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+			Parameters:       []*api.Parameter{{Name: "param1", Value: "world"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: run1.ExperimentUUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	for i, runId := range []string{run1.UUID, run2.UUID} {
+		_, err := runServer.ReportRunMetrics(context.Background(), &api.ReportRunMetricsRequest{
+			RunId: runId,
+			Metrics: []*api.RunMetric{{
+				Name:   "accuracy",
+				NodeId: "node-1",
+				Value:  &api.RunMetric_NumberValue{NumberValue: 0.5 + float64(i)/4},
+			}},
+		})
+		assert.Nil(t, err)
+	}
+
+	response, err := runServer.CompareRuns(context.Background(), &api.CompareRunsRequest{RunIds: []string{run1.UUID, run2.UUID}})
+	assert.Nil(t, err)
+	expectedResponse := &api.CompareRunsResponse{
+		RunIds: []string{run1.UUID, run2.UUID},
+		Parameters: []*api.ParameterComparison{{
+			Name: "param1",
+			Values: []*api.ParameterComparisonValue{
+				{Present: true, Value: "world"},
+				{Present: true, Value: "world"},
+			},
+		}},
+		Metrics: []*api.MetricComparison{{
+			NodeId: "node-1",
+			Name:   "accuracy",
+			Values: []*api.MetricComparisonValue{
+				{Present: true, NumberValue: 0.5, HasDelta: true},
+				{Present: true, NumberValue: 0.75, Delta: 0.25, HasDelta: true},
+			},
+		}},
+		PipelineSpecDifferences: []*api.PipelineSpecDifference{},
+	}
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestCompareRuns_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+
+	clients, manager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	_, err = runServer.CompareRuns(ctx, &api.CompareRunsRequest{RunIds: []string{runDetail.UUID, "run2"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to authorize the request")
+}
+
+func TestSuspendAndResumeRun(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	runServer := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	_, err := runServer.SuspendRun(context.Background(), &api.SuspendRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	run, err := runServer.GetRun(context.Background(), &api.GetRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, "Suspended", run.Run.Status)
+
+	_, err = runServer.ResumeRun(context.Background(), &api.ResumeRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	run, err = runServer.GetRun(context.Background(), &api.GetRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", run.Run.Status)
+
+	_, err = runServer.ResumeRun(context.Background(), &api.ResumeRunRequest{RunId: runDetail.UUID})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestCloneRun(t *testing.T) {
+	clientManager, resourceManager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.FakeUUIDOne, nil))
+	resourceManager = resource.NewResourceManager(clientManager)
+	runServer := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	clone, err := runServer.CloneRun(context.Background(), &api.CloneRunRequest{
+		RunId:      runDetail.UUID,
+		Parameters: []*api.Parameter{{Name: "param1", Value: "again"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, resource.FakeUUIDOne, clone.Run.Id)
+	assert.Equal(t, "Clone of run1", clone.Run.Name)
+	assert.Equal(t, []*api.Parameter{{Name: "param1", Value: "again"}}, clone.Run.PipelineSpec.Parameters)
+	assert.Equal(t, runDetail.WorkflowSpecManifest, clone.Run.PipelineSpec.WorkflowManifest)
+	assert.Equal(t, []*api.ResourceReference{
+		{
+			Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: runDetail.ExperimentUUID},
+			Name:         "exp1",
+			Relationship: api.Relationship_OWNER,
+		},
+	}, clone.Run.ResourceReferences)
+
+	// The cloned run is unchanged.
+	run, err := runServer.GetRun(context.Background(), &api.GetRunRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.Parameter{{Name: "param1", Value: "world"}}, run.Run.PipelineSpec.Parameters)
+}
+
+func TestCloneRun_RunNotFound(t *testing.T) {
+	clientManager, resourceManager, _ := initWithExperiment(t)
+	defer clientManager.Close()
+	runServer := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	_, err := runServer.CloneRun(context.Background(), &api.CloneRunRequest{RunId: "does-not-exist"})
+	AssertUserError(t, err, codes.NotFound)
+}
@@ -341,11 +341,28 @@ func getPipelineVersionIdFromResourceReferences(resourceManager *resource.Resour
 	return pipelineVersionId
 }
 
+// getPipelineOwner returns the owner of a pipeline created by a request in a
+// namespace: the name of the user of the request in multi-user mode. The
+// pipelines created in single-user mode or in no namespace, whose requests are
+// not authenticated, have no owner.
+func getPipelineOwner(resourceManager *resource.ResourceManager, ctx context.Context, namespace string) (string, error) {
+	if !common.IsMultiUserMode() || namespace == "" {
+		return "", nil
+	}
+	user, err := resourceManager.AuthenticateRequest(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
 // isAuthorized verifies whether the user identity, which is contained in the context object,
 // can perform some action (verb) on a resource (resourceType/resourceName) living in the
 // target namespace. If the returned error is nil, the authorization passes. Otherwise,
 // authorization fails with a non-nil error.
 func isAuthorized(resourceManager *resource.ResourceManager, ctx context.Context, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	auditCall := auditCallFromContext(ctx)
+	auditCall.setNamespace(resourceAttributes.Namespace)
 	if common.IsMultiUserMode() == false {
 		// Skip authz if not multi-user mode.
 		return nil
@@ -358,23 +375,24 @@ func isAuthorized(resourceManager *resource.ResourceManager, ctx context.Context
 	}
 
 	glog.Info("Getting user identity...")
-	userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+	user, err := resourceManager.AuthenticateRequest(ctx)
+	auditCall.setUser(user)
 	if err != nil {
 		return err
 	}
 
-	if len(userIdentity) == 0 {
+	if len(user.Name) == 0 {
 		return util.NewUnauthenticatedError(errors.New("Request header error: user identity is empty."), "Request header error: user identity is empty.")
 	}
 
-	glog.Infof("User: %s, ResourceAttributes: %+v", userIdentity, resourceAttributes)
+	glog.Infof("User: %s, Groups: %v, ResourceAttributes: %+v", user.Name, user.Groups, resourceAttributes)
 	glog.Info("Authorizing request...")
-	err = resourceManager.IsRequestAuthorized(ctx, userIdentity, resourceAttributes)
+	err = resourceManager.IsRequestAuthorized(ctx, user, resourceAttributes)
 	if err != nil {
 		glog.Info(err.Error())
 		return err
 	}
 
-	glog.Infof("Authorized user '%s': %+v", userIdentity, resourceAttributes)
+	glog.Infof("Authorized user '%s': %+v", user.Name, resourceAttributes)
 	return nil
 }
@@ -0,0 +1,91 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// AuditEventSinkInterface is a destination of the audit events besides the
+// database, e.g. a log file that is shipped to a SIEM.
+type AuditEventSinkInterface interface {
+	WriteAuditEvent(event *model.AuditEvent) error
+}
+
+// JSONLinesAuditEventSink writes each audit event as a line of JSON, with the
+// field names of the API.
+type JSONLinesAuditEventSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// auditEventLine is the JSON representation of an audit event.
+type auditEventLine struct {
+	ID           string `json:"id"`
+	CreatedAt    string `json:"created_at"`
+	User         string `json:"user"`
+	Method       string `json:"method"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	Namespace    string `json:"namespace"`
+	Outcome      string `json:"outcome"`
+	Error        string `json:"error,omitempty"`
+}
+
+func NewJSONLinesAuditEventSink(writer io.Writer) *JSONLinesAuditEventSink {
+	return &JSONLinesAuditEventSink{writer: writer}
+}
+
+// NewJSONLinesAuditEventFileSink creates a sink that appends the audit events
+// to a file, which is created if it does not exist.
+func NewJSONLinesAuditEventFileSink(path string) (*JSONLinesAuditEventSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to open the audit log file %s", path)
+	}
+	return NewJSONLinesAuditEventSink(file), nil
+}
+
+func (s *JSONLinesAuditEventSink) WriteAuditEvent(event *model.AuditEvent) error {
+	line, err := json.Marshal(auditEventLine{
+		ID:           event.UUID,
+		CreatedAt:    time.Unix(event.CreatedAtInSec, 0).UTC().Format(time.RFC3339),
+		User:         event.UserIdentity,
+		Method:       event.Method,
+		ResourceType: event.ResourceType,
+		ResourceID:   event.ResourceUUID,
+		Namespace:    event.Namespace,
+		Outcome:      event.Outcome,
+		Error:        event.Error,
+	})
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to marshal the audit event %s", event.UUID)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	// Write the line at once, so that concurrent writers of the file do not
+	// interleave lines.
+	if _, err := s.writer.Write(append(line, '\n')); err != nil {
+		return util.NewInternalServerError(err, "Failed to write the audit event %s", event.UUID)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLinesAuditEventSink(t *testing.T) {
+	var buffer bytes.Buffer
+	sink := NewJSONLinesAuditEventSink(&buffer)
+
+	require.Nil(t, sink.WriteAuditEvent(&model.AuditEvent{
+		UUID:           fakeID,
+		CreatedAtInSec: 1,
+		UserIdentity:   "alice",
+		Method:         "/api.RunService/CreateRun",
+		ResourceType:   "Run",
+		ResourceUUID:   "run1",
+		Namespace:      "ns1",
+		Outcome:        "OK",
+	}))
+	require.Nil(t, sink.WriteAuditEvent(&model.AuditEvent{
+		UUID:           fakeIDTwo,
+		CreatedAtInSec: 2,
+		UserIdentity:   "bob",
+		Method:         "/api.RunService/DeleteRun",
+		ResourceType:   "Run",
+		ResourceUUID:   "run1",
+		Namespace:      "ns1",
+		Outcome:        "PermissionDenied",
+		Error:          "denied",
+	}))
+
+	expected := `{"id":"` + fakeID + `","created_at":"1970-01-01T00:00:01Z","user":"alice","method":"/api.RunService/CreateRun","resource_type":"Run","resource_id":"run1","namespace":"ns1","outcome":"OK"}
+{"id":"` + fakeIDTwo + `","created_at":"1970-01-01T00:00:02Z","user":"bob","method":"/api.RunService/DeleteRun","resource_type":"Run","resource_id":"run1","namespace":"ns1","outcome":"PermissionDenied","error":"denied"}
+`
+	assert.Equal(t, expected, buffer.String())
+}
+
+func TestJSONLinesAuditEventFileSink_Appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.Nil(t, ioutil.WriteFile(path, []byte("{}\n"), 0644))
+
+	sink, err := NewJSONLinesAuditEventFileSink(path)
+	require.Nil(t, err)
+	require.Nil(t, sink.WriteAuditEvent(&model.AuditEvent{UUID: fakeID, Outcome: "OK"}))
+
+	content, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, `{}
+{"id":"`+fakeID+`","created_at":"1970-01-01T00:00:00Z","user":"","method":"","resource_type":"","resource_id":"","namespace":"","outcome":"OK"}
+`, string(content))
+}
+
+func TestNewJSONLinesAuditEventFileSink_Error(t *testing.T) {
+	_, err := NewJSONLinesAuditEventFileSink(filepath.Join(t.TempDir(), "missing", "audit.log"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to open the audit log file")
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var auditEventColumns = []string{
+	"UUID",
+	"CreatedAtInSec",
+	"UserIdentity",
+	"Method",
+	"ResourceType",
+	"ResourceUUID",
+	"Namespace",
+	"Outcome",
+	"Error",
+}
+
+type AuditEventStoreInterface interface {
+	// CreateAuditEvent stores an audit event, the store sets its ID and time.
+	CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error)
+
+	ListAuditEvents(filterContext *common.FilterContext, opts *list.Options) ([]*model.AuditEvent, int, string, error)
+}
+
+type AuditEventStore struct {
+	db   *DB
+	time util.TimeInterface
+	uuid util.UUIDGeneratorInterface
+}
+
+// NewAuditEventStore creates a new AuditEventStore.
+func NewAuditEventStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *AuditEventStore {
+	return &AuditEventStore{db: db, time: time, uuid: uuid}
+}
+
+func (s *AuditEventStore) CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error) {
+	newEvent := *event
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create an audit event id.")
+	}
+	newEvent.UUID = id.String()
+	newEvent.CreatedAtInSec = s.time.Now().Unix()
+
+	sql, args, err := sq.
+		Insert("audit_events").
+		SetMap(sq.Eq{
+			"UUID":           newEvent.UUID,
+			"CreatedAtInSec": newEvent.CreatedAtInSec,
+			"UserIdentity":   newEvent.UserIdentity,
+			"Method":         newEvent.Method,
+			"ResourceType":   newEvent.ResourceType,
+			"ResourceUUID":   newEvent.ResourceUUID,
+			"Namespace":      newEvent.Namespace,
+			"Outcome":        newEvent.Outcome,
+			"Error":          newEvent.Error,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert audit event to audit_events table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add audit event to audit_events table: %v",
+			err.Error())
+	}
+	return &newEvent, nil
+}
+
+func (s *AuditEventStore) scanRows(rows *sql.Rows) ([]*model.AuditEvent, error) {
+	var events []*model.AuditEvent
+	for rows.Next() {
+		var event model.AuditEvent
+		err := rows.Scan(&event.UUID, &event.CreatedAtInSec, &event.UserIdentity, &event.Method, &event.ResourceType,
+			&event.ResourceUUID, &event.Namespace, &event.Outcome, &event.Error)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+// ListAuditEvents runs two SQL queries in a transaction to return a list of
+// matching audit events, as well as their total_size. The total_size does not
+// reflect the page size.
+func (s *AuditEventStore) ListAuditEvents(filterContext *common.FilterContext, opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	errorF := func(err error) ([]*model.AuditEvent, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list audit events: %v", err)
+	}
+
+	// SQL for getting the filtered and paginated rows
+	sqlBuilder := sq.Select(auditEventColumns...).From("audit_events")
+	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
+	}
+	sqlBuilder = opts.AddFilterToSelect(sqlBuilder, s.db)
+
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// SQL for getting total size. This matches the query to get all the rows above, in order
+	// to do the same filter, but counts instead of scanning the rows.
+	sqlBuilder = sq.Select("count(*)").From("audit_events")
+	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
+	}
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder, s.db).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		glog.Errorf("Failed to start transaction to list audit events")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	events, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	totalSize, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		glog.Errorf("Failed to commit transaction to list audit events")
+		return errorF(err)
+	}
+
+	if len(events) <= opts.PageSize {
+		return events, totalSize, "", nil
+	}
+
+	npt, err := opts.NextPageToken(events[opts.PageSize])
+	return events[:opts.PageSize], totalSize, npt, err
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAuditEvents(t *testing.T, auditEventStore *AuditEventStore) {
+	events := []struct {
+		id    string
+		event *model.AuditEvent
+	}{
+		{fakeID, &model.AuditEvent{UserIdentity: "alice", Method: "/api.RunService/CreateRun", ResourceType: "Run", ResourceUUID: "run1", Namespace: "ns1", Outcome: "OK"}},
+		{fakeIDTwo, &model.AuditEvent{UserIdentity: "bob", Method: "/api.RunService/DeleteRun", ResourceType: "Run", ResourceUUID: "run1", Namespace: "ns1", Outcome: "PermissionDenied", Error: "denied"}},
+		{fakeIDThree, &model.AuditEvent{UserIdentity: "bob", Method: "/api.JobService/CreateJob", ResourceType: "Job", ResourceUUID: "job1", Namespace: "ns2", Outcome: "OK"}},
+		{fakeIDFour, &model.AuditEvent{UserIdentity: "alice", Method: "/api.RunService/DeleteRun", ResourceType: "Run", ResourceUUID: "run1", Namespace: "ns1", Outcome: "OK"}},
+	}
+	for _, e := range events {
+		auditEventStore.uuid = util.NewFakeUUIDGeneratorOrFatal(e.id, nil)
+		_, err := auditEventStore.CreateAuditEvent(e.event)
+		require.Nil(t, err)
+	}
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditEventStore := NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+
+	event, err := auditEventStore.CreateAuditEvent(&model.AuditEvent{
+		UserIdentity: "alice",
+		Method:       "/api.RunService/CreateRun",
+		ResourceType: "Run",
+		ResourceUUID: "run1",
+		Namespace:    "ns1",
+		Outcome:      "OK",
+	})
+	assert.Nil(t, err)
+	expected := &model.AuditEvent{
+		UUID:           fakeID,
+		CreatedAtInSec: 1,
+		UserIdentity:   "alice",
+		Method:         "/api.RunService/CreateRun",
+		ResourceType:   "Run",
+		ResourceUUID:   "run1",
+		Namespace:      "ns1",
+		Outcome:        "OK",
+	}
+	assert.Equal(t, expected, event)
+
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, _, err := auditEventStore.ListAuditEvents(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.AuditEvent{expected}, events)
+}
+
+func TestCreateAuditEvent_DBError(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditEventStore := NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	db.Close()
+
+	_, err := auditEventStore.CreateAuditEvent(&model.AuditEvent{Method: "/api.RunService/CreateRun"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Failed to add audit event to audit_events table")
+}
+
+func TestListAuditEvents_FilterAndPagination(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditEventStore := NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	createAuditEvents(t, auditEventStore)
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "resource_id",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "run1"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.AuditEvent{}, 2, "created_at desc", filterProto)
+	assert.Nil(t, err)
+	filterContext := &common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns1"}}
+
+	events, totalSize, nextPageToken, err := auditEventStore.ListAuditEvents(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+	require.Len(t, events, 2)
+	assert.Equal(t, fakeIDFour, events[0].UUID)
+	assert.Equal(t, fakeIDTwo, events[1].UUID)
+	assert.Equal(t, "PermissionDenied", events[1].Outcome)
+	assert.Equal(t, "denied", events[1].Error)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err = auditEventStore.ListAuditEvents(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Empty(t, nextPageToken)
+	require.Len(t, events, 1)
+	assert.Equal(t, fakeID, events[0].UUID)
+}
+
+func TestListAuditEvents_FilterByUser(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	auditEventStore := NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	createAuditEvents(t, auditEventStore)
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "user",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "bob"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.AuditEvent{}, 10, "", filterProto)
+	assert.Nil(t, err)
+
+	events, totalSize, nextPageToken, err := auditEventStore.ListAuditEvents(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Empty(t, nextPageToken)
+	require.Len(t, events, 2)
+	assert.Equal(t, "/api.RunService/DeleteRun", events[0].Method)
+	assert.Equal(t, "/api.JobService/CreateJob", events[1].Method)
+	assert.Equal(t, "ns2", events[1].Namespace)
+}
@@ -22,12 +22,13 @@ import (
 
 	"github.com/VividCortex/mysqlerr"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
 )
 
 // DB a struct wrapping plain sql library with SQL dialect, to solve any feature
-// difference between MySQL, which is used in production, and Sqlite, which is used
-// for unit testing.
+// difference between MySQL and PostgreSQL, which are used in production, and
+// Sqlite, which is used for unit testing.
 type DB struct {
 	*sql.DB
 	SQLDialect
@@ -38,9 +39,65 @@ func NewDB(db *sql.DB, dialect SQLDialect) *DB {
 	return &DB{db, dialect}
 }
 
+// isPostgreSQL returns true if the DB uses the postgres dialect.
+func (d *DB) isPostgreSQL() bool {
+	_, ok := d.SQLDialect.(PostgreSQLDialect)
+	return ok
+}
+
+// Query executes a query after rewriting it for the SQL dialect of the DB.
+func (d *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.DB.Query(d.Rebind(query), args...)
+}
+
+// QueryRow executes a query that returns at most one row after rewriting it for
+// the SQL dialect of the DB.
+func (d *DB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return d.DB.QueryRow(d.Rebind(query), args...)
+}
+
+// Exec executes a statement after rewriting it for the SQL dialect of the DB.
+func (d *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.DB.Exec(d.Rebind(query), args...)
+}
+
+// Begin starts a transaction whose statements are rewritten for the SQL dialect
+// of the DB.
+func (d *DB) Begin() (*Tx, error) {
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{tx, d.SQLDialect}, nil
+}
+
+// Tx a struct wrapping plain sql transaction with SQL dialect.
+type Tx struct {
+	*sql.Tx
+	SQLDialect
+}
+
+// Query executes a query in the transaction after rewriting it for the SQL
+// dialect.
+func (tx *Tx) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.Query(tx.Rebind(query), args...)
+}
+
+// QueryRow executes a query that returns at most one row in the transaction
+// after rewriting it for the SQL dialect.
+func (tx *Tx) QueryRow(query string, args ...interface{}) *sql.Row {
+	return tx.Tx.QueryRow(tx.Rebind(query), args...)
+}
+
+// Exec executes a statement in the transaction after rewriting it for the SQL
+// dialect.
+func (tx *Tx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return tx.Tx.Exec(tx.Rebind(query), args...)
+}
+
 // SQLDialect abstracts common sql queries which vary in different dialect.
-// It is used to bridge the difference between mysql (production) and sqlite
-// (test).
+// It is used to bridge the difference between mysql/postgres (production) and
+// sqlite (test).
 type SQLDialect interface {
 	// GroupConcat builds query to group concatenate `expr` in each row and use `separator`
 	// to join rows in a group.
@@ -56,6 +113,24 @@ type SQLDialect interface {
 	// Modifies the SELECT clause in query to return one that locks the selected
 	// row for update.
 	SelectForUpdate(query string) string
+
+	// Rebind rewrites the "?" bind variables in query, which are generated by
+	// squirrel and used in hand-written queries, to the ones of the dialect.
+	Rebind(query string) string
+
+	// Like builds a condition that expr matches the LIKE pattern of a "?" bind
+	// variable, case insensitively like with the default collation of MySQL.
+	Like(expr string) string
+
+	// Regexp builds a condition that expr matches the POSIX extended regular
+	// expression of a "?" bind variable, case insensitively like Like.
+	Regexp(expr string) string
+
+	// ParameterValue builds an expression of the value of the parameter name in
+	// column, which stores the parameters as a JSON array of objects with a name
+	// and a value, like the parameters of the runs. The expression is NULL if
+	// there is no such parameter. name must be safe to quote in the query.
+	ParameterValue(column string, name string) string
 }
 
 // MySQLDialect implements SQLDialect with mysql dialect implementation.
@@ -85,6 +160,30 @@ func (d MySQLDialect) IsDuplicateError(err error) bool {
 	return ok && sqlError.Number == mysqlerr.ER_DUP_ENTRY
 }
 
+func (d MySQLDialect) Rebind(query string) string {
+	return query
+}
+
+func (d MySQLDialect) Like(expr string) string {
+	return expr + " LIKE ?"
+}
+
+func (d MySQLDialect) Regexp(expr string) string {
+	return expr + " REGEXP ?"
+}
+
+// jsonSearchEscaper escapes the wildcards of the JSON_SEARCH patterns of MySQL
+// with "!".
+var jsonSearchEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// ParameterValue finds the path of the name of the parameter, e.g. $[1].name,
+// and extracts the value next to it, since MySQL 5.7 has no JSON_TABLE.
+func (d MySQLDialect) ParameterValue(column string, name string) string {
+	doc := fmt.Sprintf("NULLIF(%s, '')", column)
+	namePath := fmt.Sprintf("JSON_UNQUOTE(JSON_SEARCH(%s, 'one', '%s', '!', '$[*].name'))", doc, jsonSearchEscaper.Replace(name))
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, REPLACE(%s, '.name', '.value')))", doc, namePath)
+}
+
 // SQLiteDialect implements SQLDialect with sqlite dialect implementation.
 type SQLiteDialect struct{}
 
@@ -120,6 +219,107 @@ func (d SQLiteDialect) IsDuplicateError(err error) bool {
 	return ok && sqlError.Code == sqlite3.ErrConstraint
 }
 
+func (d SQLiteDialect) Rebind(query string) string {
+	return query
+}
+
+// Like is case insensitive for ASCII characters, like MySQL.
+func (d SQLiteDialect) Like(expr string) string {
+	return expr + " LIKE ?"
+}
+
+// Regexp calls the regexp function that the fake DB registers, since SQLite
+// has no REGEXP implementation of its own.
+func (d SQLiteDialect) Regexp(expr string) string {
+	return expr + " REGEXP ?"
+}
+
+// ParameterValue calls the functions that the fake DB registers, since the
+// SQLite of the tests has no JSON functions.
+func (d SQLiteDialect) ParameterValue(column string, name string) string {
+	return fmt.Sprintf("(CASE WHEN has_parameter(%[1]s, '%[2]s') THEN parameter_value(%[1]s, '%[2]s') END)", column, name)
+}
+
+// PostgreSQLDialect implements SQLDialect with postgres dialect implementation.
+type PostgreSQLDialect struct{}
+
+// postgresUniqueViolation is the SQLSTATE of unique constraint violations.
+// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+const postgresUniqueViolation = "23505"
+
+func (d PostgreSQLDialect) GroupConcat(expr string, separator string) string {
+	var buffer bytes.Buffer
+	buffer.WriteString("STRING_AGG(")
+	buffer.WriteString(expr)
+	// Unlike MySQL and SQLite, string_agg has no default separator.
+	if separator == "" {
+		separator = ","
+	}
+	buffer.WriteString(fmt.Sprintf(", '%s'", separator))
+	buffer.WriteString(")")
+	return buffer.String()
+}
+
+func (d PostgreSQLDialect) Concat(exprs []string, separator string) string {
+	// CONCAT() ignores NULL arguments in postgres, while the || operator returns
+	// NULL like CONCAT() does in MySQL.
+	separatorSQL := "||"
+	if separator != "" {
+		separatorSQL = fmt.Sprintf(`||'%s'||`, separator)
+	}
+	return strings.Join(exprs, separatorSQL)
+}
+
+func (d PostgreSQLDialect) IsDuplicateError(err error) bool {
+	sqlError, ok := err.(*pq.Error)
+	return ok && sqlError.Code == postgresUniqueViolation
+}
+
+func (d PostgreSQLDialect) SelectForUpdate(query string) string {
+	return query + " FOR UPDATE"
+}
+
+// Rebind replaces each "?" outside of quoted strings and identifiers with a
+// numbered "$N" bind variable.
+func (d PostgreSQLDialect) Rebind(query string) string {
+	var buffer bytes.Buffer
+	var quote byte
+	n := 0
+	// Multi-byte UTF-8 characters have no ASCII bytes, so the query can be
+	// scanned byte by byte.
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '?':
+			n++
+			buffer.WriteString(fmt.Sprintf("$%d", n))
+			continue
+		}
+		buffer.WriteByte(c)
+	}
+	return buffer.String()
+}
+
+// Like uses ILIKE, as LIKE is case sensitive in postgres.
+func (d PostgreSQLDialect) Like(expr string) string {
+	return expr + " ILIKE ?"
+}
+
+// Regexp uses ~*, the case insensitive match operator of postgres.
+func (d PostgreSQLDialect) Regexp(expr string) string {
+	return expr + " ~* ?"
+}
+
+func (d PostgreSQLDialect) ParameterValue(column string, name string) string {
+	return fmt.Sprintf("(SELECT p->>'value' FROM json_array_elements(NULLIF(%s, '')::json) AS p WHERE p->>'name' = '%s' LIMIT 1)", column, name)
+}
+
 func NewMySQLDialect() MySQLDialect {
 	return MySQLDialect{}
 }
@@ -127,3 +327,7 @@ func NewMySQLDialect() MySQLDialect {
 func NewSQLiteDialect() SQLiteDialect {
 	return SQLiteDialect{}
 }
+
+func NewPostgreSQLDialect() PostgreSQLDialect {
+	return PostgreSQLDialect{}
+}
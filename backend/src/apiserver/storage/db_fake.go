@@ -15,36 +15,165 @@
 package storage
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
+	sqlite3 "github.com/mattn/go-sqlite3"
 )
 
+// fakeSQLiteDriverName is the SQLite driver of the fake DB, which adds the
+// regexp function that the REGEXP operator of the list filters calls, and the
+// parameter functions of SQLiteDialect.ParameterValue.
+const fakeSQLiteDriverName = "sqlite3_with_functions"
+
+func init() {
+	sql.Register(fakeSQLiteDriverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			if err := conn.RegisterFunc("regexp", sqliteRegexp, true); err != nil {
+				return err
+			}
+			if err := conn.RegisterFunc("has_parameter", sqliteHasParameter, true); err != nil {
+				return err
+			}
+			return conn.RegisterFunc("parameter_value", sqliteParameterValue, true)
+		},
+	})
+}
+
+// sqliteRegexp implements "value REGEXP pattern", which is NULL, i.e. false,
+// if value is NULL. Like REGEXP in MySQL, it is case insensitive.
+func sqliteRegexp(pattern string, value interface{}) (bool, error) {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return false, nil
+	}
+	return regexp.MatchString("(?i)"+pattern, s)
+}
+
+// sqliteParameter returns the value of the parameter name in the JSON array of
+// parameters, and false if there is no such parameter.
+func sqliteParameter(parameters interface{}, name string) (string, bool) {
+	var s string
+	switch v := parameters.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return "", false
+	}
+	var params []struct {
+		Name  string  `json:"name"`
+		Value *string `json:"value"`
+	}
+	if json.Unmarshal([]byte(s), &params) != nil {
+		return "", false
+	}
+	for _, param := range params {
+		if param.Name == name {
+			if param.Value == nil {
+				return "", false
+			}
+			return *param.Value, true
+		}
+	}
+	return "", false
+}
+
+func sqliteHasParameter(parameters interface{}, name string) bool {
+	_, ok := sqliteParameter(parameters, name)
+	return ok
+}
+
+func sqliteParameterValue(parameters interface{}, name string) string {
+	value, _ := sqliteParameter(parameters, name)
+	return value
+}
+
+// postgresTestDSNEnv is the environment variable with the connection string of
+// a PostgreSQL server to run the storage tests against instead of an in-memory
+// SQLite database, for example a local container started with
+//
+//	docker run -d -p 5432:5432 -e POSTGRES_PASSWORD=password postgres:12
+//	KFP_TEST_POSTGRES_DSN="host=localhost port=5432 user=postgres password=password sslmode=disable" go test ./...
+const postgresTestDSNEnv = "KFP_TEST_POSTGRES_DSN"
+
+var fakeModels = []interface{}{
+	&model.Experiment{},
+	&model.Job{},
+	&model.Pipeline{},
+	&model.PipelineVersion{},
+	&model.ResourceReference{},
+	&model.RunDetail{},
+	&model.RunMetric{},
+	&model.Task{},
+	&model.DBStatus{},
+	&model.DefaultExperiment{},
+	&model.AuditEvent{},
+	&model.RunEvent{},
+}
+
 func NewFakeDb() (*DB, error) {
+	if dsn := os.Getenv(postgresTestDSNEnv); dsn != "" {
+		return newFakePostgresDb(dsn)
+	}
 	// Initialize GORM
-	db, err := gorm.Open("sqlite3", ":memory:")
+	sqlDB, err := sql.Open(fakeSQLiteDriverName, ":memory:")
+	if err != nil {
+		return nil, fmt.Errorf("Could not open the SQLite database: %v", err)
+	}
+	db, err := gorm.Open("sqlite3", sqlDB)
 	if err != nil {
 		return nil, fmt.Errorf("Could not create the GORM database: %v", err)
 	}
 	// Create tables
-	db.AutoMigrate(
-		&model.Experiment{},
-		&model.Job{},
-		&model.Pipeline{},
-		&model.PipelineVersion{},
-		&model.ResourceReference{},
-		&model.RunDetail{},
-		&model.RunMetric{},
-		&model.Task{},
-		&model.DBStatus{},
-		&model.DefaultExperiment{})
+	db.AutoMigrate(fakeModels...)
 
 	return NewDB(db.DB(), NewSQLiteDialect()), nil
 }
 
+// newFakePostgresDb creates the tables in a new schema, so that every fake DB
+// starts empty like the in-memory SQLite one does.
+func newFakePostgresDb(dsn string) (*DB, error) {
+	admin, err := sql.Open(postgres.DriverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to the postgres database: %v", err)
+	}
+	defer admin.Close()
+	schema := "kfp_test_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	if _, err = admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		return nil, fmt.Errorf("Could not create the postgres schema: %v", err)
+	}
+
+	sqlDB, err := sql.Open(postgres.DriverName, dsn+" search_path="+schema)
+	if err != nil {
+		return nil, fmt.Errorf("Could not connect to the postgres database: %v", err)
+	}
+	db, err := gorm.Open(postgres.GormDialectName, sqlDB)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create the GORM database: %v", err)
+	}
+	if err = db.AutoMigrate(fakeModels...).Error; err != nil {
+		return nil, fmt.Errorf("Could not create the postgres tables: %v", err)
+	}
+
+	return NewDB(sqlDB, NewPostgreSQLDialect()), nil
+}
+
 func NewFakeDbOrFatal() *DB {
 	db, err := NewFakeDb()
 	if err != nil {
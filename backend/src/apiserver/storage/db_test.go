@@ -15,8 +15,11 @@
 package storage
 
 import (
+	"errors"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -91,3 +94,112 @@ func TestSQLiteDialect_Concat_WithoutSeparator(t *testing.T) {
 	expectedQuery := `col1||col2`
 	assert.Equal(t, expectedQuery, actualQuery)
 }
+
+func TestMySQLDialect_ParameterValue(t *testing.T) {
+	mysqlDialect := NewMySQLDialect()
+
+	actualQuery := mysqlDialect.ParameterValue("run_details.Parameters", "learning_rate")
+
+	expectedQuery := `JSON_UNQUOTE(JSON_EXTRACT(NULLIF(run_details.Parameters, ''), ` +
+		`REPLACE(JSON_UNQUOTE(JSON_SEARCH(NULLIF(run_details.Parameters, ''), 'one', 'learning!_rate', '!', '$[*].name')), '.name', '.value')))`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_GroupConcat_WithSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.GroupConcat(`col1||','||col2`, ";")
+
+	expectedQuery := `STRING_AGG(col1||','||col2, ';')`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_GroupConcat_WithoutSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.GroupConcat(`col1||','||col2`, "")
+
+	expectedQuery := `STRING_AGG(col1||','||col2, ',')`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_Concat_WithSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.Concat([]string{"col1", "col2"}, ",")
+
+	expectedQuery := `col1||','||col2`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_Concat_WithoutSeparator(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.Concat([]string{"col1", "col2"}, "")
+
+	expectedQuery := `col1||col2`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_ParameterValue(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.ParameterValue("run_details.Parameters", "learning_rate")
+
+	expectedQuery := `(SELECT p->>'value' FROM json_array_elements(NULLIF(run_details.Parameters, '')::json) AS p ` +
+		`WHERE p->>'name' = 'learning_rate' LIMIT 1)`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_IsDuplicateError(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	assert.True(t, postgresDialect.IsDuplicateError(&pq.Error{Code: "23505"}))
+	assert.False(t, postgresDialect.IsDuplicateError(&pq.Error{Code: "23503"}))
+	assert.False(t, postgresDialect.IsDuplicateError(errors.New("duplicate")))
+}
+
+func TestPostgreSQLDialect_Rebind(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+
+	actualQuery := postgresDialect.Rebind(
+		`SELECT * FROM run_details WHERE UUID = ? AND Name <> '?' AND Conditions IN (?,?)`)
+
+	expectedQuery := `SELECT * FROM run_details WHERE UUID = $1 AND Name <> '?' AND Conditions IN ($2,$3)`
+	assert.Equal(t, expectedQuery, actualQuery)
+}
+
+func TestPostgreSQLDialect_Rebind_SquirrelSubquery(t *testing.T) {
+	postgresDialect := NewPostgreSQLDialect()
+	query, args, err := sq.Select("*").
+		FromSelect(sq.Select("*").From("run_details").Where(sq.Eq{"Namespace": "ns"}), "rd").
+		Where(sq.Gt{"CreatedAtInSec": 1}).
+		Limit(2).
+		ToSql()
+	assert.Nil(t, err)
+
+	expectedQuery := `SELECT * FROM (SELECT * FROM run_details WHERE Namespace = $1) AS rd WHERE CreatedAtInSec > $2 LIMIT 2`
+	assert.Equal(t, expectedQuery, postgresDialect.Rebind(query))
+	assert.Equal(t, []interface{}{"ns", 1}, args)
+}
+
+func TestMySQLDialect_Rebind(t *testing.T) {
+	mysqlDialect := NewMySQLDialect()
+
+	query := `SELECT * FROM run_details WHERE UUID = ?`
+	assert.Equal(t, query, mysqlDialect.Rebind(query))
+}
+
+func TestDialect_Like(t *testing.T) {
+	assert.Equal(t, "Name LIKE ?", NewMySQLDialect().Like("Name"))
+	assert.Equal(t, "Name LIKE ?", NewSQLiteDialect().Like("Name"))
+	// LIKE is case sensitive in postgres.
+	assert.Equal(t, "Name ILIKE ?", NewPostgreSQLDialect().Like("Name"))
+}
+
+func TestDialect_Regexp(t *testing.T) {
+	assert.Equal(t, "Name REGEXP ?", NewMySQLDialect().Regexp("Name"))
+	assert.Equal(t, "Name REGEXP ?", NewSQLiteDialect().Regexp("Name"))
+	// The regular expressions are case insensitive, like in MySQL.
+	assert.Equal(t, "Name ~* ?", NewPostgreSQLDialect().Regexp("Name"))
+}
@@ -15,8 +15,6 @@
 package storage
 
 import (
-	"database/sql"
-
 	sq "github.com/Masterminds/squirrel"
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -123,7 +121,7 @@ func (s *DefaultExperimentStore) GetDefaultExperimentId() (string, error) {
 // needed as input.
 // Update is used instead of delete so that we don't need to first check that the experiment ID is
 // there.
-func (s *DefaultExperimentStore) UnsetDefaultExperimentIdIfIdMatches(tx *sql.Tx, id string) error {
+func (s *DefaultExperimentStore) UnsetDefaultExperimentIdIfIdMatches(tx *Tx, id string) error {
 	sql, args, err := sq.
 		Update("default_experiments").
 		SetMap(sq.Eq{"DefaultExperimentId": ""}).
@@ -54,7 +54,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
 	}
-	sqlBuilder = opts.AddFilterToSelect(sqlBuilder)
+	sqlBuilder = opts.AddFilterToSelect(sqlBuilder, s.db)
 
 	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
 	if err != nil {
@@ -67,7 +67,7 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
 	}
-	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder).ToSql()
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder, s.db).ToSql()
 	if err != nil {
 		return errorF(err)
 	}
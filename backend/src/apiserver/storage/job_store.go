@@ -27,14 +27,17 @@ import (
 )
 
 var jobColumns = []string{"UUID", "DisplayName", "Name", "Namespace", "ServiceAccount", "Description", "MaxConcurrency",
-	"NoCatchup", "CreatedAtInSec", "UpdatedAtInSec", "Enabled", "CronScheduleStartTimeInSec", "CronScheduleEndTimeInSec",
-	"Schedule", "PeriodicScheduleStartTimeInSec", "PeriodicScheduleEndTimeInSec", "IntervalSecond",
+	"NoCatchup", "ConcurrencyPolicy", "CreatedAtInSec", "UpdatedAtInSec", "Enabled", "CronScheduleStartTimeInSec", "CronScheduleEndTimeInSec",
+	"Schedule", "CronScheduleTimeZone", "PeriodicScheduleStartTimeInSec", "PeriodicScheduleEndTimeInSec", "IntervalSecond",
+	"BucketObjectTriggerBucket", "BucketObjectTriggerPattern", "BucketObjectTriggerParameterName",
+	"RunDependencyTriggerUpstreamType", "RunDependencyTriggerUpstreamId", "RunDependencyTriggerParameterName",
 	"PipelineId", "PipelineName", "PipelineSpecManifest", "WorkflowSpecManifest", "Parameters", "Conditions",
 }
 
 type JobStoreInterface interface {
 	ListJobs(filterContext *common.FilterContext, opts *list.Options) ([]*model.Job, int, string, error)
 	GetJob(id string) (*model.Job, error)
+	ListDependentJobs(upstreams []*common.ReferenceKey, includeDisabled bool) ([]*model.Job, error)
 	CreateJob(*model.Job) (*model.Job, error)
 	DeleteJob(id string) error
 	EnableJob(id string, enabled bool) error
@@ -128,7 +131,7 @@ func (s *JobStore) buildSelectJobsQuery(selectCount bool, opts *list.Options,
 		return "", nil, util.NewInternalServerError(err, "Failed to list jobs: %v", err)
 	}
 
-	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder)
+	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder, s.db)
 
 	// If we're not just counting, then also add select columns and perform a left join
 	// to get resource reference information. Also add pagination.
@@ -170,7 +173,45 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 	return jobs[0], nil
 }
 
+// ListDependentJobs returns the jobs with a run dependency trigger on one of
+// the upstream jobs or pipeline versions. The disabled jobs are only returned
+// if includeDisabled is true.
+func (s *JobStore) ListDependentJobs(upstreams []*common.ReferenceKey, includeDisabled bool) ([]*model.Job, error) {
+	if len(upstreams) == 0 {
+		return nil, nil
+	}
+	upstreamConditions := sq.Or{}
+	for _, upstream := range upstreams {
+		upstreamConditions = append(upstreamConditions, sq.Eq{
+			"RunDependencyTriggerUpstreamType": string(upstream.Type),
+			"RunDependencyTriggerUpstreamId":   upstream.ID,
+		})
+	}
+	selectBuilder := sq.Select(jobColumns...).From("jobs").Where(upstreamConditions)
+	if !includeDisabled {
+		selectBuilder = selectBuilder.Where(sq.Eq{"Enabled": true})
+	}
+	sql, args, err := s.addResourceReferences(selectBuilder).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list dependent jobs: %v",
+			err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list dependent jobs: %v", err.Error())
+	}
+	defer rows.Close()
+	jobs, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list dependent jobs: %v", err.Error())
+	}
+	return jobs, nil
+}
+
 func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
+	if s.db.isPostgreSQL() {
+		return s.addResourceReferencesWithSubquery(filteredSelectBuilder)
+	}
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("r.Payload", ","), `"]"`}, "")
 	return sq.
 		Select("jobs.*", resourceRefConcatQuery+" AS refs").
@@ -180,21 +221,38 @@ func (s *JobStore) addResourceReferences(filteredSelectBuilder sq.SelectBuilder)
 		GroupBy("jobs.UUID")
 }
 
+// addResourceReferencesWithSubquery is addResourceReferences for postgres,
+// which doesn't allow selecting the columns of a derived table that are not
+// grouped by. The resource references are aggregated by a correlated subquery
+// instead of a join with GROUP BY.
+func (s *JobStore) addResourceReferencesWithSubquery(filteredSelectBuilder sq.SelectBuilder) sq.SelectBuilder {
+	resourceRefConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("r.Payload", ","), `']'`}, "")
+	return sq.
+		Select("jobs.*", fmt.Sprintf(
+			"(SELECT %s FROM resource_references AS r WHERE r.ResourceType='Job' AND jobs.UUID=r.ResourceUUID) AS refs",
+			resourceRefConcatQuery)).
+		FromSelect(filteredSelectBuilder, "jobs")
+}
+
 func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 	var jobs []*model.Job
 	for r.Next() {
 		var uuid, displayName, name, namespace, pipelineId, pipelineName, conditions, serviceAccount,
-			description, parameters, pipelineSpecManifest, workflowSpecManifest string
+			description, parameters, pipelineSpecManifest, workflowSpecManifest, concurrencyPolicy string
 		var cronScheduleStartTimeInSec, cronScheduleEndTimeInSec,
 			periodicScheduleStartTimeInSec, periodicScheduleEndTimeInSec, intervalSecond sql.NullInt64
-		var cron, resourceReferencesInString sql.NullString
+		var cron, cronScheduleTimeZone, resourceReferencesInString sql.NullString
+		var bucketObjectTriggerBucket, bucketObjectTriggerPattern, bucketObjectTriggerParameterName sql.NullString
+		var runDependencyTriggerUpstreamType, runDependencyTriggerUpstreamId, runDependencyTriggerParameterName sql.NullString
 		var enabled, noCatchup bool
 		var createdAtInSec, updatedAtInSec, maxConcurrency int64
 		err := r.Scan(
 			&uuid, &displayName, &name, &namespace, &serviceAccount, &description,
-			&maxConcurrency, &noCatchup, &createdAtInSec, &updatedAtInSec, &enabled,
-			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron,
+			&maxConcurrency, &noCatchup, &concurrencyPolicy, &createdAtInSec, &updatedAtInSec, &enabled,
+			&cronScheduleStartTimeInSec, &cronScheduleEndTimeInSec, &cron, &cronScheduleTimeZone,
 			&periodicScheduleStartTimeInSec, &periodicScheduleEndTimeInSec, &intervalSecond,
+			&bucketObjectTriggerBucket, &bucketObjectTriggerPattern, &bucketObjectTriggerParameterName,
+			&runDependencyTriggerUpstreamType, &runDependencyTriggerUpstreamId, &runDependencyTriggerParameterName,
 			&pipelineId, &pipelineName, &pipelineSpecManifest, &workflowSpecManifest, &parameters, &conditions, &resourceReferencesInString)
 		if err != nil {
 			return nil, err
@@ -211,18 +269,30 @@ func (s *JobStore) scanRows(r *sql.Rows) ([]*model.Job, error) {
 			Conditions:         conditions,
 			MaxConcurrency:     maxConcurrency,
 			NoCatchup:          noCatchup,
+			ConcurrencyPolicy:  concurrencyPolicy,
 			ResourceReferences: resourceReferences,
 			Trigger: model.Trigger{
 				CronSchedule: model.CronSchedule{
 					CronScheduleStartTimeInSec: NullInt64ToPointer(cronScheduleStartTimeInSec),
 					CronScheduleEndTimeInSec:   NullInt64ToPointer(cronScheduleEndTimeInSec),
 					Cron:                       NullStringToPointer(cron),
+					CronScheduleTimeZone:       NullStringToPointer(cronScheduleTimeZone),
 				},
 				PeriodicSchedule: model.PeriodicSchedule{
 					PeriodicScheduleStartTimeInSec: NullInt64ToPointer(periodicScheduleStartTimeInSec),
 					PeriodicScheduleEndTimeInSec:   NullInt64ToPointer(periodicScheduleEndTimeInSec),
 					IntervalSecond:                 NullInt64ToPointer(intervalSecond),
 				},
+				BucketObjectTrigger: model.BucketObjectTrigger{
+					BucketObjectTriggerBucket:        NullStringToPointer(bucketObjectTriggerBucket),
+					BucketObjectTriggerPattern:       NullStringToPointer(bucketObjectTriggerPattern),
+					BucketObjectTriggerParameterName: NullStringToPointer(bucketObjectTriggerParameterName),
+				},
+				RunDependencyTrigger: model.RunDependencyTrigger{
+					RunDependencyTriggerUpstreamType:  NullStringToPointer(runDependencyTriggerUpstreamType),
+					RunDependencyTriggerUpstreamId:    NullStringToPointer(runDependencyTriggerUpstreamId),
+					RunDependencyTriggerParameterName: NullStringToPointer(runDependencyTriggerParameterName),
+				},
 			},
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           pipelineId,
@@ -271,29 +341,37 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 	jobSql, jobArgs, err := sq.
 		Insert("jobs").
 		SetMap(sq.Eq{
-			"UUID":                           j.UUID,
-			"DisplayName":                    j.DisplayName,
-			"Name":                           j.Name,
-			"Namespace":                      j.Namespace,
-			"ServiceAccount":                 j.ServiceAccount,
-			"Description":                    j.Description,
-			"MaxConcurrency":                 j.MaxConcurrency,
-			"NoCatchup":                      j.NoCatchup,
-			"Enabled":                        j.Enabled,
-			"Conditions":                     j.Conditions,
-			"CronScheduleStartTimeInSec":     PointerToNullInt64(j.CronScheduleStartTimeInSec),
-			"CronScheduleEndTimeInSec":       PointerToNullInt64(j.CronScheduleEndTimeInSec),
-			"Schedule":                       PointerToNullString(j.Cron),
-			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
-			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
-			"IntervalSecond":                 PointerToNullInt64(j.IntervalSecond),
-			"CreatedAtInSec":                 j.CreatedAtInSec,
-			"UpdatedAtInSec":                 j.UpdatedAtInSec,
-			"PipelineId":                     j.PipelineId,
-			"PipelineName":                   j.PipelineName,
-			"PipelineSpecManifest":           j.PipelineSpecManifest,
-			"WorkflowSpecManifest":           j.WorkflowSpecManifest,
-			"Parameters":                     j.Parameters,
+			"UUID":                              j.UUID,
+			"DisplayName":                       j.DisplayName,
+			"Name":                              j.Name,
+			"Namespace":                         j.Namespace,
+			"ServiceAccount":                    j.ServiceAccount,
+			"Description":                       j.Description,
+			"MaxConcurrency":                    j.MaxConcurrency,
+			"NoCatchup":                         j.NoCatchup,
+			"ConcurrencyPolicy":                 j.ConcurrencyPolicy,
+			"Enabled":                           j.Enabled,
+			"Conditions":                        j.Conditions,
+			"CronScheduleStartTimeInSec":        PointerToNullInt64(j.CronScheduleStartTimeInSec),
+			"CronScheduleEndTimeInSec":          PointerToNullInt64(j.CronScheduleEndTimeInSec),
+			"Schedule":                          PointerToNullString(j.Cron),
+			"CronScheduleTimeZone":              PointerToNullString(j.CronScheduleTimeZone),
+			"PeriodicScheduleStartTimeInSec":    PointerToNullInt64(j.PeriodicScheduleStartTimeInSec),
+			"PeriodicScheduleEndTimeInSec":      PointerToNullInt64(j.PeriodicScheduleEndTimeInSec),
+			"IntervalSecond":                    PointerToNullInt64(j.IntervalSecond),
+			"BucketObjectTriggerBucket":         PointerToNullString(j.BucketObjectTriggerBucket),
+			"BucketObjectTriggerPattern":        PointerToNullString(j.BucketObjectTriggerPattern),
+			"BucketObjectTriggerParameterName":  PointerToNullString(j.BucketObjectTriggerParameterName),
+			"RunDependencyTriggerUpstreamType":  PointerToNullString(j.RunDependencyTriggerUpstreamType),
+			"RunDependencyTriggerUpstreamId":    PointerToNullString(j.RunDependencyTriggerUpstreamId),
+			"RunDependencyTriggerParameterName": PointerToNullString(j.RunDependencyTriggerParameterName),
+			"CreatedAtInSec":                    j.CreatedAtInSec,
+			"UpdatedAtInSec":                    j.UpdatedAtInSec,
+			"PipelineId":                        j.PipelineId,
+			"PipelineName":                      j.PipelineName,
+			"PipelineSpecManifest":              j.PipelineSpecManifest,
+			"WorkflowSpecManifest":              j.WorkflowSpecManifest,
+			"Parameters":                        j.Parameters,
 		}).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "Failed to create query to add job to job table: %v",
@@ -354,20 +432,28 @@ func (s *JobStore) UpdateJob(swf *util.ScheduledWorkflow) error {
 	sql, args, err := sq.
 		Update("jobs").
 		SetMap(sq.Eq{
-			"Name":                           swf.Name,
-			"Namespace":                      swf.Namespace,
-			"Enabled":                        swf.Spec.Enabled,
-			"Conditions":                     swf.ConditionSummary(),
-			"MaxConcurrency":                 swf.MaxConcurrencyOr0(),
-			"NoCatchup":                      swf.NoCatchupOrFalse(),
-			"Parameters":                     parameters,
-			"UpdatedAtInSec":                 now,
-			"CronScheduleStartTimeInSec":     PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
-			"CronScheduleEndTimeInSec":       PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
-			"Schedule":                       swf.CronOrEmpty(),
-			"PeriodicScheduleStartTimeInSec": PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
-			"PeriodicScheduleEndTimeInSec":   PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
-			"IntervalSecond":                 swf.IntervalSecondOr0()}).
+			"Name":                              swf.Name,
+			"Namespace":                         swf.Namespace,
+			"Enabled":                           swf.Spec.Enabled,
+			"Conditions":                        swf.ConditionSummary(),
+			"MaxConcurrency":                    swf.MaxConcurrencyOr0(),
+			"NoCatchup":                         swf.NoCatchupOrFalse(),
+			"ConcurrencyPolicy":                 string(swf.Spec.ConcurrencyPolicy),
+			"Parameters":                        parameters,
+			"UpdatedAtInSec":                    now,
+			"CronScheduleStartTimeInSec":        PointerToNullInt64(swf.CronScheduleStartTimeInSecOrNull()),
+			"CronScheduleEndTimeInSec":          PointerToNullInt64(swf.CronScheduleEndTimeInSecOrNull()),
+			"Schedule":                          swf.CronOrEmpty(),
+			"CronScheduleTimeZone":              PointerToNullString(swf.CronScheduleTimeZoneOrNull()),
+			"PeriodicScheduleStartTimeInSec":    PointerToNullInt64(swf.PeriodicScheduleStartTimeInSecOrNull()),
+			"PeriodicScheduleEndTimeInSec":      PointerToNullInt64(swf.PeriodicScheduleEndTimeInSecOrNull()),
+			"IntervalSecond":                    swf.IntervalSecondOr0(),
+			"BucketObjectTriggerBucket":         PointerToNullString(swf.BucketObjectTriggerBucketOrNull()),
+			"BucketObjectTriggerPattern":        PointerToNullString(swf.BucketObjectTriggerPatternOrNull()),
+			"BucketObjectTriggerParameterName":  PointerToNullString(swf.BucketObjectTriggerParameterNameOrNull()),
+			"RunDependencyTriggerUpstreamType":  PointerToNullString(swf.RunDependencyTriggerUpstreamTypeOrNull()),
+			"RunDependencyTriggerUpstreamId":    PointerToNullString(swf.RunDependencyTriggerUpstreamIdOrNull()),
+			"RunDependencyTriggerParameterName": PointerToNullString(swf.RunDependencyTriggerParameterNameOrNull())}).
 		Where(sq.Eq{"UUID": string(swf.UID)}).
 		ToSql()
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubernetes/pkg/apis/core"
@@ -799,6 +800,189 @@ func TestUpdateJob_Success(t *testing.T) {
 	assert.Equal(t, jobExpected, *job)
 }
 
+func TestJobStore_CronScheduleTimeZone(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:      "tz",
+		Name:      "tz",
+		Namespace: "n1",
+		Enabled:   true,
+		Trigger: model.Trigger{
+			CronSchedule: model.CronSchedule{
+				Cron:                 util.StringPointer("0 0 2 * * *"),
+				CronScheduleTimeZone: util.StringPointer("Europe/Stockholm"),
+			},
+		},
+	})
+	require.Nil(t, err)
+	job, err := jobStore.GetJob("tz")
+	require.Nil(t, err)
+	assert.Equal(t, util.StringPointer("Europe/Stockholm"), job.CronScheduleTimeZone)
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tz", Namespace: "n1", UID: "tz"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{Cron: "0 0 2 * * *", TimeZone: "America/New_York"},
+			},
+		},
+	})
+	require.Nil(t, jobStore.UpdateJob(swf))
+	job, err = jobStore.GetJob("tz")
+	require.Nil(t, err)
+	assert.Equal(t, util.StringPointer("America/New_York"), job.CronScheduleTimeZone)
+}
+
+func TestJobStore_BucketObjectTrigger(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:      "bucket",
+		Name:      "bucket",
+		Namespace: "n1",
+		Enabled:   true,
+		Trigger: model.Trigger{
+			BucketObjectTrigger: model.BucketObjectTrigger{
+				BucketObjectTriggerBucket:        util.StringPointer("minio://mlpipeline/incoming"),
+				BucketObjectTriggerPattern:       util.StringPointer("*.csv"),
+				BucketObjectTriggerParameterName: util.StringPointer("data"),
+			},
+		},
+	})
+	require.Nil(t, err)
+	job, err := jobStore.GetJob("bucket")
+	require.Nil(t, err)
+	assert.Equal(t, model.BucketObjectTrigger{
+		BucketObjectTriggerBucket:        util.StringPointer("minio://mlpipeline/incoming"),
+		BucketObjectTriggerPattern:       util.StringPointer("*.csv"),
+		BucketObjectTriggerParameterName: util.StringPointer("data"),
+	}, job.BucketObjectTrigger)
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "bucket", Namespace: "n1", UID: "bucket"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{
+					Bucket:        "gs://my-bucket/data",
+					ParameterName: "input",
+				},
+			},
+		},
+	})
+	require.Nil(t, jobStore.UpdateJob(swf))
+	job, err = jobStore.GetJob("bucket")
+	require.Nil(t, err)
+	assert.Equal(t, model.BucketObjectTrigger{
+		BucketObjectTriggerBucket:        util.StringPointer("gs://my-bucket/data"),
+		BucketObjectTriggerParameterName: util.StringPointer("input"),
+	}, job.BucketObjectTrigger)
+}
+
+func TestJobStore_RunDependencyTrigger(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:      "dependent",
+		Name:      "dependent",
+		Namespace: "n1",
+		Enabled:   true,
+		Trigger: model.Trigger{
+			RunDependencyTrigger: model.RunDependencyTrigger{
+				RunDependencyTriggerUpstreamType:  util.StringPointer("Job"),
+				RunDependencyTriggerUpstreamId:    util.StringPointer("1"),
+				RunDependencyTriggerParameterName: util.StringPointer("upstream"),
+			},
+		},
+	})
+	require.Nil(t, err)
+	job, err := jobStore.GetJob("dependent")
+	require.Nil(t, err)
+	assert.Equal(t, model.RunDependencyTrigger{
+		RunDependencyTriggerUpstreamType:  util.StringPointer("Job"),
+		RunDependencyTriggerUpstreamId:    util.StringPointer("1"),
+		RunDependencyTriggerParameterName: util.StringPointer("upstream"),
+	}, job.RunDependencyTrigger)
+
+	jobs, err := jobStore.ListDependentJobs([]*common.ReferenceKey{
+		{Type: common.Job, ID: "1"},
+		{Type: common.PipelineVersion, ID: "version1"},
+	}, false)
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "dependent", jobs[0].UUID)
+
+	// The jobs of other upstreams don't depend on the run.
+	jobs, err = jobStore.ListDependentJobs([]*common.ReferenceKey{{Type: common.Job, ID: "2"}}, false)
+	require.Nil(t, err)
+	assert.Empty(t, jobs)
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "dependent", Namespace: "n1", UID: "dependent"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: false,
+			Trigger: swfapi.Trigger{
+				RunDependencyTrigger: &swfapi.RunDependencyTrigger{
+					UpstreamType:  "PipelineVersion",
+					UpstreamID:    "version1",
+					ParameterName: "input",
+				},
+			},
+		},
+	})
+	require.Nil(t, jobStore.UpdateJob(swf))
+	job, err = jobStore.GetJob("dependent")
+	require.Nil(t, err)
+	assert.Equal(t, model.RunDependencyTrigger{
+		RunDependencyTriggerUpstreamType:  util.StringPointer("PipelineVersion"),
+		RunDependencyTriggerUpstreamId:    util.StringPointer("version1"),
+		RunDependencyTriggerParameterName: util.StringPointer("input"),
+	}, job.RunDependencyTrigger)
+
+	// The disabled jobs aren't triggered.
+	jobs, err = jobStore.ListDependentJobs([]*common.ReferenceKey{{Type: common.PipelineVersion, ID: "version1"}}, false)
+	require.Nil(t, err)
+	assert.Empty(t, jobs)
+	jobs, err = jobStore.ListDependentJobs([]*common.ReferenceKey{{Type: common.PipelineVersion, ID: "version1"}}, true)
+	require.Nil(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "dependent", jobs[0].UUID)
+}
+
+func TestJobStore_ConcurrencyPolicy(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:              "policy",
+		Name:              "policy",
+		Namespace:         "n1",
+		Enabled:           true,
+		ConcurrencyPolicy: "Forbid",
+	})
+	require.Nil(t, err)
+	job, err := jobStore.GetJob("policy")
+	require.Nil(t, err)
+	assert.Equal(t, "Forbid", job.ConcurrencyPolicy)
+
+	swf := util.NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "n1", UID: "policy"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:           true,
+			ConcurrencyPolicy: swfapi.ReplaceConcurrent,
+		},
+	})
+	require.Nil(t, jobStore.UpdateJob(swf))
+	job, err = jobStore.GetJob("policy")
+	require.Nil(t, err)
+	assert.Equal(t, "Replace", job.ConcurrencyPolicy)
+}
+
 func TestUpdateJob_MostlyEmptySpec(t *testing.T) {
 	db, jobStore := initializeDbAndStore()
 	defer db.Close()
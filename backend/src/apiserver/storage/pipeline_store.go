@@ -35,6 +35,7 @@ var pipelineColumns = []string{
 	"pipelines.Parameters",
 	"pipelines.Status",
 	"pipelines.Namespace",
+	"pipelines.Owner",
 	"pipelines.DefaultVersionId",
 	"pipeline_versions.UUID",
 	"pipeline_versions.CreatedAtInSec",
@@ -123,7 +124,7 @@ func (s *PipelineStore) ListPipelines(filterContext *common.FilterContext, opts
 	}
 
 	buildQuery := func(sqlBuilder sq.SelectBuilder) sq.SelectBuilder {
-		query := opts.AddFilterToSelect(sqlBuilder).From("pipelines").
+		query := opts.AddFilterToSelect(sqlBuilder, s.db).From("pipelines").
 			LeftJoin("pipeline_versions ON pipelines.DefaultVersionId = pipeline_versions.UUID")
 		if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
 			query = query.Where(
@@ -202,7 +203,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 	var pipelines []*model.Pipeline
 	for rows.Next() {
 		var uuid, name, parameters, description string
-		var defaultVersionId, namespace sql.NullString
+		var defaultVersionId, namespace, owner sql.NullString
 		var createdAtInSec int64
 		var status model.PipelineStatus
 		var versionUUID, versionName, versionParameters, versionPipelineId, versionCodeSourceUrl, versionStatus, versionDescription sql.NullString
@@ -215,6 +216,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 			&parameters,
 			&status,
 			&namespace,
+			&owner,
 			&defaultVersionId,
 			&versionUUID,
 			&versionCreatedAtInSec,
@@ -235,6 +237,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 				Parameters:       parameters,
 				Status:           status,
 				Namespace:        namespace.String,
+				Owner:            owner.String,
 				DefaultVersionId: defaultVersionId.String,
 				DefaultVersion: &model.PipelineVersion{
 					UUID:           versionUUID.String,
@@ -255,6 +258,7 @@ func (s *PipelineStore) scanRows(rows *sql.Rows) ([]*model.Pipeline, error) {
 				Parameters:       parameters,
 				Status:           status,
 				Namespace:        namespace.String,
+				Owner:            owner.String,
 				DefaultVersionId: "",
 				DefaultVersion:   nil})
 		}
@@ -329,6 +333,7 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 				"Parameters":       newPipeline.Parameters,
 				"Status":           string(newPipeline.Status),
 				"Namespace":        newPipeline.Namespace,
+				"Owner":            newPipeline.Owner,
 				"DefaultVersionId": newPipeline.DefaultVersionId}).
 		ToSql()
 	if err != nil {
@@ -661,7 +666,7 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 	}
 
 	buildQuery := func(sqlBuilder sq.SelectBuilder) sq.SelectBuilder {
-		return opts.AddFilterToSelect(sqlBuilder).
+		return opts.AddFilterToSelect(sqlBuilder, s.db).
 			From("pipeline_versions").
 			Where(sq.And{sq.Eq{"PipelineId": pipelineId}, sq.Eq{"status": model.PipelineVersionReady}})
 	}
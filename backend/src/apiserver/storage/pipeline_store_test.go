@@ -150,6 +150,64 @@ func TestListPipelines_WithFilter(t *testing.T) {
 	assert.Equal(t, pipelinesExpected, pipelines)
 }
 
+func TestListPipelines_WithFilterGroups(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakePipelineId, nil))
+	pipelineStore.CreatePipeline(createPipeline("pipeline_foo"))
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(defaultFakePipelineIdTwo, nil)
+	pipelineStore.CreatePipeline(createPipeline("pipeline_bar"))
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(defaultFakePipelineIdThree, nil)
+	pipelineStore.CreatePipeline(createPipeline("pipeline_baz1"))
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(defaultFakePipelineIdFour, nil)
+
+	// name ends with a digit OR NOT (name starts with "pipeline_b").
+	filterProto := &api.Filter{
+		Groups: []*api.FilterGroup{
+			&api.FilterGroup{
+				Op: api.FilterGroup_OR,
+				Predicates: []*api.Predicate{
+					&api.Predicate{
+						Key:   "name",
+						Op:    api.Predicate_MATCHES_REGEX,
+						Value: &api.Predicate_StringValue{StringValue: "[0-9]$"},
+					},
+				},
+				Groups: []*api.FilterGroup{
+					&api.FilterGroup{
+						Op: api.FilterGroup_NOT,
+						Predicates: []*api.Predicate{
+							&api.Predicate{
+								Key:   "name",
+								Op:    api.Predicate_STARTS_WITH,
+								Value: &api.Predicate_StringValue{StringValue: "pipeline_b"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Pipeline{}, 1, "id", filterProto)
+	assert.Nil(t, err)
+
+	pipelines, totalSize, nextPageToken, err := pipelineStore.ListPipelines(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, nextPageToken)
+	assert.Equal(t, 2, totalSize)
+	assert.Len(t, pipelines, 1)
+	assert.Equal(t, "pipeline_foo", pipelines[0].Name)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	assert.Nil(t, err)
+	pipelines, totalSize, nextPageToken, err = pipelineStore.ListPipelines(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, 2, totalSize)
+	assert.Len(t, pipelines, 1)
+	assert.Equal(t, "pipeline_baz1", pipelines[0].Name)
+}
+
 func TestListPipelines_Pagination(t *testing.T) {
 	db := NewFakeDbOrFatal()
 	defer db.Close()
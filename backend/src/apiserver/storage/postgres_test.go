@@ -0,0 +1,115 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"os"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// The tests in this file check the behaviors that are specific to PostgreSQL,
+// against the server of postgresTestDSNEnv, e.g. the container started by
+// `make test_postgres` in the backend directory. They are skipped if it is not
+// set. The other storage tests also run against the server when it is set.
+
+func skipUnlessPostgreSQL(t *testing.T) {
+	if os.Getenv(postgresTestDSNEnv) == "" {
+		t.Skipf("%s is not set", postgresTestDSNEnv)
+	}
+}
+
+func TestPostgreSQL_ListRuns(t *testing.T) {
+	skipUnlessPostgreSQL(t)
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	require.True(t, db.isPostgreSQL())
+
+	// LIKE is case sensitive in postgres, the filters are not.
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "name",
+				Op:    api.Predicate_IS_SUBSTRING,
+				Value: &api.Predicate_StringValue{StringValue: "RUN"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Run{}, 2, "name", filterProto)
+	require.Nil(t, err)
+	runs, totalSize, nextPageToken, err := runStore.ListRuns(&common.FilterContext{}, opts)
+	require.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "1", runs[0].UUID)
+	assert.Equal(t, "2", runs[1].UUID)
+	// The metrics and the resource references are aggregated by subqueries.
+	require.Len(t, runs[1].Metrics, 1)
+	assert.Equal(t, 2.0, runs[1].Metrics[0].NumberValue)
+	require.Len(t, runs[1].ResourceReferences, 1)
+	assert.Equal(t, defaultFakeExpId, runs[1].ResourceReferences[0].ReferenceUUID)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	require.Nil(t, err)
+	runs, totalSize, nextPageToken, err = runStore.ListRuns(&common.FilterContext{}, opts)
+	require.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Empty(t, nextPageToken)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "3", runs[0].UUID)
+	assert.Empty(t, runs[0].Metrics)
+}
+
+func TestPostgreSQL_GetJob(t *testing.T) {
+	skipUnlessPostgreSQL(t)
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+	require.True(t, db.isPostgreSQL())
+
+	job, err := jobStore.GetJob("2")
+	require.Nil(t, err)
+	assert.Equal(t, "pp2", job.Name)
+	assert.Equal(t, []*model.ResourceReference{
+		{
+			ResourceUUID: "2", ResourceType: common.Job,
+			ReferenceUUID: defaultFakeExpIdTwo, ReferenceName: "e2", ReferenceType: common.Experiment,
+			Relationship: common.Owner,
+		},
+	}, job.ResourceReferences)
+}
+
+func TestPostgreSQL_CreateExperiment_DuplicateName(t *testing.T) {
+	skipUnlessPostgreSQL(t)
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	require.True(t, db.isPostgreSQL())
+
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	_, err := experimentStore.CreateExperiment(&model.Experiment{Name: "exp1"})
+	require.Nil(t, err)
+	experimentStore = NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil))
+	_, err = experimentStore.CreateExperiment(&model.Experiment{Name: "exp1"})
+	require.NotNil(t, err)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.AlreadyExists))
+}
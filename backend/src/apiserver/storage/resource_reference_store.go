@@ -17,8 +17,13 @@ var resourceReferenceColumns = []string{"ResourceUUID", "ResourceType", "Referen
 
 type ResourceReferenceStoreInterface interface {
 	// Retrieve the resource reference for a given resource id, type and a reference type.
+	// The references to imported pipeline versions are not retrieved.
 	GetResourceReference(resourceId string, resourceType model.ResourceType,
 		referenceType model.ResourceType) (*model.ResourceReference, error)
+
+	// Retrieve the resource references of a given resource type to a given reference id and type.
+	ListReferencesTo(referenceId string, referenceType model.ResourceType,
+		resourceType model.ResourceType) ([]model.ResourceReference, error)
 }
 
 type ResourceReferenceStore struct {
@@ -27,7 +32,7 @@ type ResourceReferenceStore struct {
 
 // Create a resource reference.
 // This is always in company with creating a parent resource so a transaction is needed as input.
-func (s *ResourceReferenceStore) CreateResourceReferences(tx *sql.Tx, refs []*model.ResourceReference) error {
+func (s *ResourceReferenceStore) CreateResourceReferences(tx *Tx, refs []*model.ResourceReference) error {
 	if len(refs) > 0 {
 		resourceRefSqlBuilder := sq.
 			Insert("resource_references").
@@ -55,7 +60,7 @@ func (s *ResourceReferenceStore) CreateResourceReferences(tx *sql.Tx, refs []*mo
 	return nil
 }
 
-func (s *ResourceReferenceStore) checkReferenceExist(tx *sql.Tx, referenceId string, referenceType model.ResourceType) bool {
+func (s *ResourceReferenceStore) checkReferenceExist(tx *Tx, referenceId string, referenceType model.ResourceType) bool {
 	var selectBuilder sq.SelectBuilder
 	switch referenceType {
 	case common.Job:
@@ -64,6 +69,8 @@ func (s *ResourceReferenceStore) checkReferenceExist(tx *sql.Tx, referenceId str
 		selectBuilder = sq.Select("1").From("experiments").Where(sq.Eq{"uuid": referenceId})
 	case common.PipelineVersion:
 		selectBuilder = sq.Select("1").From("pipeline_versions").Where(sq.Eq{"uuid": referenceId})
+	case common.Run:
+		selectBuilder = sq.Select("1").From("run_details").Where(sq.Eq{"uuid": referenceId})
 	case common.Namespace:
 		// This function is called to check the data validity when the data are transformed according to the DB schema.
 		// Since there is not a separate table to store the namespace data, thus always returning true.
@@ -85,7 +92,7 @@ func (s *ResourceReferenceStore) checkReferenceExist(tx *sql.Tx, referenceId str
 
 // Delete all resource references for a specific resource.
 // This is always in company with creating a parent resource so a transaction is needed as input.
-func (s *ResourceReferenceStore) DeleteResourceReferences(tx *sql.Tx, id string, resourceType model.ResourceType) error {
+func (s *ResourceReferenceStore) DeleteResourceReferences(tx *Tx, id string, resourceType model.ResourceType) error {
 	refSql, refArgs, err := sq.
 		Delete("resource_references").
 		Where(sq.Or{
@@ -107,6 +114,7 @@ func (s *ResourceReferenceStore) GetResourceReference(resourceId string, resourc
 			"ResourceUUID":  resourceId,
 			"ResourceType":  resourceType,
 			"ReferenceType": referenceType}).
+		Where(sq.NotEq{"Relationship": common.Imported}).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, util.NewInternalServerError(err,
@@ -131,6 +139,34 @@ func (s *ResourceReferenceStore) GetResourceReference(resourceId string, resourc
 	return &reference[0], nil
 }
 
+func (s *ResourceReferenceStore) ListReferencesTo(referenceId string, referenceType model.ResourceType,
+	resourceType model.ResourceType) ([]model.ResourceReference, error) {
+	sql, args, err := sq.Select(resourceReferenceColumns...).
+		From("resource_references").
+		Where(sq.Eq{
+			"ReferenceUUID": referenceId,
+			"ReferenceType": referenceType,
+			"ResourceType":  resourceType}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to list resource references. "+
+				"Reference ID: %s. Reference Type: %s. Resource Type: %s", referenceId, referenceType, resourceType)
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to list resource references. "+
+				"Reference ID: %s. Reference Type: %s. Resource Type: %s", referenceId, referenceType, resourceType)
+	}
+	defer rows.Close()
+	references, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list resource references: %v", err.Error())
+	}
+	return references, nil
+}
+
 func (s *ResourceReferenceStore) scanRows(r *sql.Rows) ([]model.ResourceReference, error) {
 	var references []model.ResourceReference
 	for r.Next() {
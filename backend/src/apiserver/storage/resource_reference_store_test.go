@@ -65,6 +65,15 @@ func TestResourceReferenceStore(t *testing.T) {
 		ReferenceUUID: defaultFakeExpId, ReferenceName: "e1", ReferenceType: common.Experiment,
 		Relationship: common.Creator, Payload: string(payload)}, experimentRef)
 
+	// List the job resource references to an experiment
+	jobRefs, err := store.ListReferencesTo(defaultFakeExpIdTwo, common.Experiment, common.Job)
+	assert.Nil(t, err)
+	payload, err = json.Marshal(testRefTwo)
+	assert.Equal(t, []model.ResourceReference{{
+		ResourceUUID: "j2", ResourceType: common.Job,
+		ReferenceUUID: defaultFakeExpIdTwo, ReferenceName: "e2", ReferenceType: common.Experiment,
+		Relationship: common.Owner, Payload: string(payload)}}, jobRefs)
+
 	// Delete resource references
 	tx, _ = db.Begin()
 	err = store.DeleteResourceReferences(tx, defaultFakeExpId, common.Experiment)
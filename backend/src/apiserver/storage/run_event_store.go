@@ -0,0 +1,137 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+var runEventColumns = []string{
+	"Seq",
+	"Type",
+	"RunUUID",
+	"EventAtInSec",
+	"Run",
+}
+
+type RunEventStoreInterface interface {
+	// CreateRunEvent stores a run event, the database assigns its sequence
+	// number.
+	CreateRunEvent(event *model.RunEvent) error
+
+	// LatestRunEventSeq returns the sequence number of the latest run event, or
+	// 0 if there is none.
+	LatestRunEventSeq() (int64, error)
+
+	// ListRunEventsAfter returns, in order, at most limit run events whose
+	// sequence number is greater than seq.
+	ListRunEventsAfter(seq int64, limit int) ([]*model.RunEvent, error)
+
+	// DeleteRunEventsBefore deletes the run events older than eventAtInSec.
+	DeleteRunEventsBefore(eventAtInSec int64) error
+}
+
+type RunEventStore struct {
+	db *DB
+}
+
+// NewRunEventStore creates a new RunEventStore.
+func NewRunEventStore(db *DB) *RunEventStore {
+	return &RunEventStore{db: db}
+}
+
+func (s *RunEventStore) CreateRunEvent(event *model.RunEvent) error {
+	sql, args, err := sq.
+		Insert("run_events").
+		SetMap(sq.Eq{
+			"Type":         event.Type,
+			"RunUUID":      event.RunUUID,
+			"EventAtInSec": event.EventAtInSec,
+			"Run":          event.Run,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to insert run event to run_events table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to add run event to run_events table: %v",
+			err.Error())
+	}
+	return nil
+}
+
+func (s *RunEventStore) LatestRunEventSeq() (int64, error) {
+	query, args, err := sq.Select("MAX(Seq)").From("run_events").ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to get the latest run event: %v", err.Error())
+	}
+	var seq sql.NullInt64
+	if err := s.db.QueryRow(query, args...).Scan(&seq); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the latest run event: %v", err.Error())
+	}
+	return seq.Int64, nil
+}
+
+func (s *RunEventStore) ListRunEventsAfter(seq int64, limit int) ([]*model.RunEvent, error) {
+	sql, args, err := sq.
+		Select(runEventColumns...).
+		From("run_events").
+		Where(sq.Gt{"Seq": seq}).
+		OrderBy("Seq").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list run events: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run events: %v", err.Error())
+	}
+	defer rows.Close()
+	events, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to parse the run events: %v", err.Error())
+	}
+	return events, nil
+}
+
+func (s *RunEventStore) scanRows(rows *sql.Rows) ([]*model.RunEvent, error) {
+	var events []*model.RunEvent
+	for rows.Next() {
+		var event model.RunEvent
+		if err := rows.Scan(&event.Seq, &event.Type, &event.RunUUID, &event.EventAtInSec, &event.Run); err != nil {
+			return events, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+func (s *RunEventStore) DeleteRunEventsBefore(eventAtInSec int64) error {
+	sql, args, err := sq.Delete("run_events").Where(sq.Lt{"EventAtInSec": eventAtInSec}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete run events: %v", err.Error())
+	}
+	if _, err := s.db.Exec(sql, args...); err != nil {
+		return util.NewInternalServerError(err, "Failed to delete run events: %v", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEventStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runEventStore := NewRunEventStore(db)
+
+	seq, err := runEventStore.LatestRunEventSeq()
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), seq)
+
+	for i, eventType := range []string{"CREATED", "UPDATED", "DELETED"} {
+		require.Nil(t, runEventStore.CreateRunEvent(&model.RunEvent{
+			Type:         eventType,
+			RunUUID:      "run1",
+			EventAtInSec: int64(i + 1),
+			Run:          `{"UUID":"run1"}`,
+		}))
+	}
+	seq, err = runEventStore.LatestRunEventSeq()
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), seq)
+
+	events, err := runEventStore.ListRunEventsAfter(1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, []*model.RunEvent{
+		{Seq: 2, Type: "UPDATED", RunUUID: "run1", EventAtInSec: 2, Run: `{"UUID":"run1"}`},
+	}, events)
+
+	require.Nil(t, runEventStore.DeleteRunEventsBefore(3))
+	events, err = runEventStore.ListRunEventsAfter(0, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, int64(3), events[0].Seq)
+	// The sequence numbers of the deleted events are not reused.
+	seq, err = runEventStore.LatestRunEventSeq()
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), seq)
+}
@@ -65,6 +65,20 @@ type RunStoreInterface interface {
 
 	// Terminate a run
 	TerminateRun(runId string) error
+
+	// Suspend a running run
+	SuspendRun(runId string) error
+
+	// Resume a suspended run
+	ResumeRun(runId string) error
+
+	// Set the conditions of a run back to previousConditions if they are still
+	// conditions, after the workflow of the run could not be changed
+	RevertRunConditions(runId string, conditions string, previousConditions string) error
+
+	// List the IDs of the runs of a job that didn't finish, except the archived
+	// ones, the oldest first
+	ListActiveRunIds(jobId string) ([]string, error)
 }
 
 type RunStore struct {
@@ -165,7 +179,7 @@ func (s *RunStore) buildSelectRunsQuery(selectCount bool, opts *list.Options,
 		return "", nil, util.NewInternalServerError(err, "Failed to list runs: %v", err)
 	}
 
-	sqlBuilder := opts.AddFilterToSelect(filteredSelectBuilder)
+	sqlBuilder := s.addFilterToSelect(filteredSelectBuilder, opts)
 
 	// If we're not just counting, then also add select columns and perform a left join
 	// to get resource reference information. Also add pagination.
@@ -224,6 +238,9 @@ func Map(vs []string, f func(string) string) []string {
 }
 
 func (s *RunStore) addMetricsAndResourceReferences(filteredSelectBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
+	if s.db.isPostgreSQL() {
+		return s.addMetricsAndResourceReferencesWithSubqueries(filteredSelectBuilder, opts)
+	}
 	var r model.Run
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("rr.Payload", ","), `"]"`}, "")
 	columnsAfterJoiningResourceReferences := append(
@@ -251,6 +268,34 @@ func (s *RunStore) addMetricsAndResourceReferences(filteredSelectBuilder sq.Sele
 		GroupBy("subq.UUID")
 }
 
+// addMetricsAndResourceReferencesWithSubqueries is addMetricsAndResourceReferences
+// for postgres, which doesn't allow selecting the columns of a derived table
+// that are not grouped by. The resource references and the metrics of each run
+// are aggregated by correlated subqueries instead of joins with GROUP BY.
+func (s *RunStore) addMetricsAndResourceReferencesWithSubqueries(filteredSelectBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
+	var r model.Run
+	resourceRefConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("rr.Payload", ","), `']'`}, "")
+	resourceRefSubQuery := fmt.Sprintf(
+		"(SELECT %s FROM resource_references AS rr WHERE rr.ResourceType='Run' AND rd.UUID=rr.ResourceUUID) AS refs",
+		resourceRefConcatQuery)
+
+	metricConcatQuery := s.db.Concat([]string{`'['`, s.db.GroupConcat("rm.Payload", ","), `']'`}, "")
+	metricSubQuery := fmt.Sprintf(
+		"(SELECT %s FROM run_metrics AS rm WHERE rd.UUID=rm.RunUUID) AS metrics",
+		metricConcatQuery)
+
+	columns := append(
+		Map(runColumns, func(column string) string { return "rd." + column }), // Add prefix "rd." to runColumns
+		resourceRefSubQuery,
+		metricSubQuery)
+	if opts != nil && !r.IsRegularField(opts.SortByFieldName) {
+		columns = append(columns, "rd."+opts.SortByFieldName)
+	}
+	return sq.
+		Select(columns...).
+		FromSelect(filteredSelectBuilder, "rd")
+}
+
 func (s *RunStore) scanRowsToRunDetails(rows *sql.Rows) ([]*model.RunDetail, error) {
 	var runs []*model.RunDetail
 	for rows.Next() {
@@ -411,7 +456,7 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 }
 
 func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error) {
-	tx, err := s.db.DB.Begin()
+	tx, err := s.db.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "transaction creation failed")
 	}
@@ -621,6 +666,122 @@ func (s *RunStore) TerminateRun(runId string) error {
 	return nil
 }
 
+func (s *RunStore) SuspendRun(runId string) error {
+	result, err := s.db.Exec(`
+		UPDATE run_details
+		SET Conditions = ?
+		WHERE UUID = ? AND (Conditions = ? OR Conditions = ? OR Conditions = ?)`,
+		model.RunSuspendedConditions, runId, string(workflowapi.NodeRunning), string(workflowapi.NodePending), "")
+
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to suspend run %s. error: '%v'", runId, err.Error())
+	}
+
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewInvalidInputError("Failed to suspend run %s. Row not found or the run isn't running.", runId)
+	}
+
+	return nil
+}
+
+func (s *RunStore) ResumeRun(runId string) error {
+	result, err := s.db.Exec(`
+		UPDATE run_details
+		SET Conditions = ?
+		WHERE UUID = ? AND Conditions = ?`,
+		string(workflowapi.NodeRunning), runId, model.RunSuspendedConditions)
+
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to resume run %s. error: '%v'", runId, err.Error())
+	}
+
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewInvalidInputError("Failed to resume run %s. Row not found or the run isn't suspended.", runId)
+	}
+
+	return nil
+}
+
+func (s *RunStore) RevertRunConditions(runId string, conditions string, previousConditions string) error {
+	result, err := s.db.Exec(`
+		UPDATE run_details
+		SET Conditions = ?
+		WHERE UUID = ? AND Conditions = ?`,
+		previousConditions, runId, conditions)
+
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to revert the conditions of run %s. error: '%v'", runId, err.Error())
+	}
+
+	if r, _ := result.RowsAffected(); r != 1 {
+		return util.NewInvalidInputError("Failed to revert the conditions of run %s. Row not found or the conditions changed.", runId)
+	}
+
+	return nil
+}
+
+func (s *RunStore) ListActiveRunIds(jobId string) ([]string, error) {
+	selectBuilder, err := list.FilterOnResourceReference("run_details", []string{"UUID"}, common.Run, false,
+		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Job, ID: jobId}})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create query to list the active runs of the job")
+	}
+	sql, args, err := selectBuilder.
+		Where(sq.Eq{"FinishedAtInSec": 0}).
+		Where(sq.NotEq{"StorageState": api.Run_STORAGESTATE_ARCHIVED.String()}).
+		OrderBy("CreatedAtInSec", "UUID").
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to create query to list the active runs of job %s. error: '%v'", jobId, err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err,
+			"Failed to list the active runs of job %s. error: '%v'", jobId, err.Error())
+	}
+	defer rows.Close()
+	var runIds []string
+	for rows.Next() {
+		var runId string
+		if err := rows.Scan(&runId); err != nil {
+			return nil, util.NewInternalServerError(err,
+				"Failed to list the active runs of job %s. error: '%v'", jobId, err.Error())
+		}
+		runIds = append(runIds, runId)
+	}
+	return runIds, nil
+}
+
+// addFilterToSelect adds the filter of opts to the query of the runs, with the
+// metric and the parameter keys of the filter replaced by subqueries of their
+// values. Each subquery returns one value per run, so the filter neither
+// duplicates runs nor changes the total size of the pages.
+func (s *RunStore) addFilterToSelect(sqlBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
+	if opts.Filter == nil {
+		return sqlBuilder
+	}
+	return opts.Filter.ReplaceKeys(s.filterKeyToSQL).AddToSelect(sqlBuilder, s.db)
+}
+
+// filterKeyToSQL returns the SQL expression of a key of the filter of the runs.
+// The largest value is used if several nodes reported a metric.
+func (s *RunStore) filterKeyToSQL(key string) string {
+	prefix, name, ok := model.ParseRunFilterKey(key)
+	if !ok {
+		return key
+	}
+	if prefix == model.RunMetricFilterKeyPrefix {
+		return fmt.Sprintf(
+			"(SELECT MAX(rm.NumberValue) FROM run_metrics AS rm WHERE rm.RunUUID = run_details.UUID AND rm.Name = '%s')",
+			name)
+	}
+	return s.db.ParameterValue("run_details.Parameters", name)
+}
+
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
@@ -891,6 +891,110 @@ func TestTerminateRun_RunHasAlreadyFinished(t *testing.T) {
 	assert.Contains(t, err.Error(), "Row not found")
 }
 
+func TestSuspendAndResumeRun(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.SuspendRun("1")
+	assert.Nil(t, err)
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Suspended", runDetail.Conditions)
+
+	// A suspended run can't be suspended again.
+	err = runStore.SuspendRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+
+	err = runStore.ResumeRun("1")
+	assert.Nil(t, err)
+	runDetail, err = runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Conditions)
+}
+
+func TestSuspendRun_RunHasAlreadyFinished(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.SuspendRun("2")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+}
+
+func TestResumeRun_RunIsNotSuspended(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.ResumeRun("1")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "isn't suspended")
+
+	err = runStore.ResumeRun("does-not-exist")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Row not found")
+}
+
+func TestRevertRunConditions(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	assert.Nil(t, runStore.SuspendRun("1"))
+	assert.Nil(t, runStore.RevertRunConditions("1", model.RunSuspendedConditions, "Running"))
+	runDetail, err := runStore.GetRun("1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Conditions)
+
+	// The conditions are only reverted if they didn't change in between.
+	err = runStore.RevertRunConditions("1", model.RunSuspendedConditions, "Pending")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the conditions changed")
+}
+
+func TestListActiveRunIds(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
+	_, err := jobStore.CreateJob(&model.Job{UUID: "job1", Name: "job1", DisplayName: "job1", Enabled: true})
+	assert.Nil(t, err)
+
+	createJobRun := func(uuid string, createdAtInSec int64, finishedAtInSec int64, storageState string) {
+		_, err := runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:            uuid,
+				ExperimentUUID:  defaultFakeExpId,
+				Name:            uuid,
+				DisplayName:     uuid,
+				StorageState:    storageState,
+				Namespace:       "n1",
+				CreatedAtInSec:  createdAtInSec,
+				FinishedAtInSec: finishedAtInSec,
+				ResourceReferences: []*model.ResourceReference{
+					{
+						ResourceUUID: uuid, ResourceType: common.Run,
+						ReferenceUUID: "job1", ReferenceName: "job1",
+						ReferenceType: common.Job, Relationship: common.Creator,
+					},
+				},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	createJobRun("job-run-1", 3, 0, api.Run_STORAGESTATE_AVAILABLE.String())
+	createJobRun("job-run-2", 2, 0, api.Run_STORAGESTATE_AVAILABLE.String())
+	createJobRun("job-run-3", 1, 5, api.Run_STORAGESTATE_AVAILABLE.String())
+	createJobRun("job-run-4", 1, 0, api.Run_STORAGESTATE_ARCHIVED.String())
+
+	// The finished and archived runs, and the runs of other jobs aren't active.
+	runIds, err := runStore.ListActiveRunIds("job1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"job-run-2", "job-run-1"}, runIds)
+
+	runIds, err = runStore.ListActiveRunIds("job2")
+	assert.Nil(t, err)
+	assert.Empty(t, runIds)
+}
+
 func TestReportMetric_Success(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -1062,6 +1166,144 @@ func TestListRuns_WithMetrics(t *testing.T) {
 	assert.Equal(t, expectedRuns, runs, "Unexpected Run listed.")
 }
 
+func initializeRunStoreWithMetricsAndParameters(t *testing.T) (*DB, *RunStore) {
+	db := NewFakeDbOrFatal()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	runs := []struct {
+		uuid       string
+		parameters string
+		accuracy   []float64
+	}{
+		{"a", `[{"name":"learning_rate","value":"0.01"},{"name":"epochs","value":"10"}]`, []float64{0.95}},
+		{"b", `[{"name":"learning_rate","value":"0.1"}]`, []float64{0.97, 0.5}},
+		{"c", `[{"name":"epochs","value":"5"},{"name":"learning_rate","value":"0.01"}]`, []float64{0.92}},
+		{"d", `[{"name":"learning_rate","value":"0.01"}]`, nil},
+		{"e", "", []float64{0.99}},
+	}
+	for i, r := range runs {
+		_, err := runStore.CreateRun(&model.RunDetail{
+			Run: model.Run{
+				UUID:           r.uuid,
+				ExperimentUUID: defaultFakeExpId,
+				Name:           "run" + r.uuid,
+				DisplayName:    "run" + r.uuid,
+				Namespace:      "n1",
+				CreatedAtInSec: int64(i + 1),
+				Conditions:     "Succeeded",
+				PipelineSpec:   model.PipelineSpec{Parameters: r.parameters},
+			},
+		})
+		assert.Nil(t, err)
+		for j, accuracy := range r.accuracy {
+			err := runStore.ReportMetric(&model.RunMetric{
+				RunUUID:     r.uuid,
+				NodeID:      fmt.Sprintf("node%d", j),
+				Name:        "accuracy",
+				NumberValue: accuracy,
+				Format:      "RAW",
+			})
+			assert.Nil(t, err)
+		}
+	}
+	return db, runStore
+}
+
+func listRunUUIDs(t *testing.T, runStore *RunStore, opts *list.Options) ([]string, int, string) {
+	runs, totalSize, nextPageToken, err := runStore.ListRuns(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	var uuids []string
+	for _, run := range runs {
+		uuids = append(uuids, run.UUID)
+	}
+	return uuids, totalSize, nextPageToken
+}
+
+func TestListRuns_FilterOnMetricsAndParameters(t *testing.T) {
+	db, runStore := initializeRunStoreWithMetricsAndParameters(t)
+	defer db.Close()
+
+	filterProto := &api.Filter{
+		Predicates: []*api.Predicate{
+			{
+				Key:   "metric.accuracy",
+				Op:    api.Predicate_GREATER_THAN,
+				Value: &api.Predicate_DoubleValue{DoubleValue: 0.9},
+			},
+			{
+				Key:   "parameter.learning_rate",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "0.01"},
+			},
+		},
+	}
+	opts, err := list.NewOptions(&model.Run{}, 1, "metric:accuracy desc", filterProto)
+	assert.Nil(t, err)
+
+	uuids, totalSize, nextPageToken := listRunUUIDs(t, runStore, opts)
+	assert.Equal(t, []string{"a"}, uuids)
+	assert.Equal(t, 2, totalSize)
+	assert.NotEmpty(t, nextPageToken)
+
+	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	assert.Nil(t, err)
+	uuids, totalSize, nextPageToken = listRunUUIDs(t, runStore, opts)
+	assert.Equal(t, []string{"c"}, uuids)
+	assert.Equal(t, 2, totalSize)
+	assert.Empty(t, nextPageToken)
+}
+
+func TestListRuns_FilterOnMetricsAndParameters_Operators(t *testing.T) {
+	db, runStore := initializeRunStoreWithMetricsAndParameters(t)
+	defer db.Close()
+
+	tests := []struct {
+		name      string
+		predicate *api.Predicate
+		want      []string
+	}{
+		{
+			"largest metric value",
+			&api.Predicate{Key: "metric.accuracy", Op: api.Predicate_GREATER_THAN_EQUALS, Value: &api.Predicate_DoubleValue{DoubleValue: 0.97}},
+			[]string{"b", "e"},
+		},
+		{
+			"integer value",
+			&api.Predicate{Key: "metric.accuracy", Op: api.Predicate_LESS_THAN, Value: &api.Predicate_IntValue{IntValue: 1}},
+			[]string{"a", "b", "c", "e"},
+		},
+		{
+			"missing metric",
+			&api.Predicate{Key: "metric.accuracy", Op: api.Predicate_IS_NULL},
+			[]string{"d"},
+		},
+		{
+			"parameter not in",
+			&api.Predicate{Key: "parameter.learning_rate", Op: api.Predicate_NOT_IN, Value: &api.Predicate_StringValues{StringValues: &api.StringValues{Values: []string{"0.1"}}}},
+			[]string{"a", "c", "d"},
+		},
+		{
+			"missing parameter",
+			&api.Predicate{Key: "parameter.epochs", Op: api.Predicate_IS_NULL},
+			[]string{"b", "d", "e"},
+		},
+		{
+			"unknown metric",
+			&api.Predicate{Key: "metric.loss", Op: api.Predicate_LESS_THAN, Value: &api.Predicate_DoubleValue{DoubleValue: 1}},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := list.NewOptions(&model.Run{}, 10, "id", &api.Filter{Predicates: []*api.Predicate{tt.predicate}})
+			assert.Nil(t, err)
+			uuids, totalSize, nextPageToken := listRunUUIDs(t, runStore, opts)
+			assert.Equal(t, tt.want, uuids)
+			assert.Equal(t, len(tt.want), totalSize)
+			assert.Empty(t, nextPageToken)
+		})
+	}
+}
+
 func TestArchiveRun(t *testing.T) {
 	db, runStore := initializeRunStore()
 	defer db.Close()
@@ -121,7 +121,7 @@ func (s *TaskStore) ListTasks(filterContext *common.FilterContext, opts *list.Op
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Pipeline {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"PipelineName": filterContext.ReferenceKey.ID})
 	}
-	sqlBuilder = opts.AddFilterToSelect(sqlBuilder)
+	sqlBuilder = opts.AddFilterToSelect(sqlBuilder, s.db)
 
 	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
 	if err != nil {
@@ -134,7 +134,7 @@ func (s *TaskStore) ListTasks(filterContext *common.FilterContext, opts *list.Op
 	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Pipeline {
 		sqlBuilder = sqlBuilder.Where(sq.Eq{"PipelineName": filterContext.ReferenceKey.ID})
 	}
-	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder).ToSql()
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder, s.db).ToSql()
 	if err != nil {
 		return errorF(err)
 	}
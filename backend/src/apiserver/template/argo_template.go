@@ -73,7 +73,7 @@ type Argo struct {
 	wf *util.Workflow
 }
 
-func (t *Argo) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledWorkflow, error) {
+func (t *Argo) ScheduledWorkflow(apiJob *api.Job, namespace string) (*scheduledworkflow.ScheduledWorkflow, error) {
 	workflow := util.NewWorkflow(t.wf.Workflow.DeepCopy())
 
 	parameters := toParametersMap(apiJob.GetPipelineSpec().GetParameters())
@@ -81,6 +81,13 @@ func (t *Argo) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledW
 	if err := workflow.VerifyParameters(parameters); err != nil {
 		return nil, util.Wrap(err, "Failed to verify parameters.")
 	}
+	// The trigger sets its parameter for each run, so it must be a parameter
+	// of the pipeline.
+	if name := triggerParameterName(apiJob.Trigger); name != "" {
+		if err := workflow.VerifyParameters(map[string]string{name: ""}); err != nil {
+			return nil, util.Wrapf(err, "Failed to verify the parameter %q of the trigger", name)
+		}
+	}
 	// Append provided parameter
 	workflow.OverrideParameters(parameters)
 	setDefaultServiceAccount(workflow, apiJob.GetServiceAccount())
@@ -100,7 +107,8 @@ func (t *Argo) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledW
 				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
 				Spec:       workflow.Spec,
 			},
-			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
+			NoCatchup:         util.BoolPointer(apiJob.NoCatchup),
+			ConcurrencyPolicy: toCRDConcurrencyPolicy(apiJob.ConcurrencyPolicy),
 		},
 	}
 
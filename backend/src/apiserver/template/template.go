@@ -31,6 +31,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
 	"google.golang.org/protobuf/encoding/protojson"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -107,6 +108,10 @@ func isArgoWorkflow(template []byte) bool {
 // isPipelineSpec returns whether template is in KFP api/v2alpha1/PipelineSpec format.
 func isPipelineSpec(template []byte) bool {
 	var spec pipelinespec.PipelineSpec
+	template, _, err := compiler.SplitPipelineYAML(template)
+	if err != nil {
+		return false
+	}
 	templateJson, err := yaml.YAMLToJSON(template)
 	if err != nil {
 		return false
@@ -132,12 +137,15 @@ type Template interface {
 	//Get workflow
 	RunWorkflow(apiRun *api.Run, options RunWorkflowOptions) (util.ExecutionSpec, error)
 
-	ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledWorkflow, error)
+	// namespace is the namespace the scheduled workflow is created in.
+	ScheduledWorkflow(apiJob *api.Job, namespace string) (*scheduledworkflow.ScheduledWorkflow, error)
 }
 
 type RunWorkflowOptions struct {
 	RunId string
 	RunAt int64
+	// The namespace the workflow is created in.
+	Namespace string
 }
 
 func New(bytes []byte) (Template, error) {
@@ -231,15 +239,36 @@ func toCRDTrigger(apiTrigger *api.Trigger) *scheduledworkflow.Trigger {
 	if apiTrigger.GetPeriodicSchedule() != nil {
 		crdTrigger.PeriodicSchedule = toCRDPeriodicSchedule(apiTrigger.GetPeriodicSchedule())
 	}
+	if apiTrigger.GetBucketObjectTrigger() != nil {
+		crdTrigger.BucketObjectTrigger = toCRDBucketObjectTrigger(apiTrigger.GetBucketObjectTrigger())
+	}
+	if apiTrigger.GetRunDependencyTrigger() != nil {
+		crdTrigger.RunDependencyTrigger = toCRDRunDependencyTrigger(apiTrigger.GetRunDependencyTrigger())
+	}
 	return &crdTrigger
 }
 
+func toCRDConcurrencyPolicy(policy api.Job_ConcurrencyPolicy) scheduledworkflow.ConcurrencyPolicy {
+	switch policy {
+	case api.Job_FORBID:
+		return scheduledworkflow.ForbidConcurrent
+	case api.Job_REPLACE:
+		return scheduledworkflow.ReplaceConcurrent
+	case api.Job_SKIP:
+		return scheduledworkflow.SkipConcurrent
+	default:
+		// Left empty, the controller defaults to Allow.
+		return ""
+	}
+}
+
 func toCRDCronSchedule(cronSchedule *api.CronSchedule) *scheduledworkflow.CronSchedule {
 	if cronSchedule == nil || cronSchedule.Cron == "" {
 		return nil
 	}
 	crdCronSchedule := scheduledworkflow.CronSchedule{}
 	crdCronSchedule.Cron = cronSchedule.Cron
+	crdCronSchedule.TimeZone = cronSchedule.TimeZone
 
 	if cronSchedule.StartTime != nil {
 		startTime := metav1.NewTime(time.Unix(cronSchedule.StartTime.Seconds, 0))
@@ -269,6 +298,44 @@ func toCRDPeriodicSchedule(periodicSchedule *api.PeriodicSchedule) *scheduledwor
 	return &crdPeriodicSchedule
 }
 
+// triggerParameterName returns the name of the pipeline parameter that the
+// trigger of a job sets for each run, or "" if the trigger doesn't set any.
+func triggerParameterName(apiTrigger *api.Trigger) string {
+	if apiTrigger.GetBucketObjectTrigger() != nil {
+		return apiTrigger.GetBucketObjectTrigger().GetParameterName()
+	}
+	if apiTrigger.GetRunDependencyTrigger() != nil {
+		return apiTrigger.GetRunDependencyTrigger().GetParameterName()
+	}
+	return ""
+}
+
+func toCRDBucketObjectTrigger(trigger *api.BucketObjectTrigger) *scheduledworkflow.BucketObjectTrigger {
+	if trigger == nil || trigger.Bucket == "" {
+		return nil
+	}
+	return &scheduledworkflow.BucketObjectTrigger{
+		Bucket:        trigger.Bucket,
+		Pattern:       trigger.Pattern,
+		ParameterName: trigger.ParameterName,
+	}
+}
+
+func toCRDRunDependencyTrigger(trigger *api.RunDependencyTrigger) *scheduledworkflow.RunDependencyTrigger {
+	if trigger == nil || trigger.GetUpstream().GetId() == "" {
+		return nil
+	}
+	upstreamType, err := common.ToModelResourceType(trigger.GetUpstream().GetType())
+	if err != nil {
+		return nil
+	}
+	return &scheduledworkflow.RunDependencyTrigger{
+		UpstreamType:  string(upstreamType),
+		UpstreamID:    trigger.GetUpstream().GetId(),
+		ParameterName: trigger.ParameterName,
+	}
+}
+
 func toCRDParameter(apiParams []*api.Parameter) []scheduledworkflow.Parameter {
 	var swParams []scheduledworkflow.Parameter
 	for _, apiParam := range apiParams {
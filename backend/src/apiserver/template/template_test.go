@@ -17,6 +17,7 @@ package template
 import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
@@ -25,8 +26,11 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/ghodss/yaml"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestFailValidation(t *testing.T) {
@@ -98,6 +102,9 @@ kind: CronWorkflow`,
 	}, {
 		template:     v2SpecHelloWorldYAML,
 		templateType: V2,
+	}, {
+		template:     v2SpecHelloWorldYAML + v2PlatformSpecHelloWorldYAML,
+		templateType: V2,
 	}}
 
 	for _, test := range tt {
@@ -280,6 +287,119 @@ schemaVersion: 2.0.0
 sdkVersion: kfp-1.6.5
 `
 
+var v2PlatformSpecHelloWorldYAML = `
+---
+platforms:
+  kubernetes:
+    deploymentSpec:
+      executors:
+        exec-hello-world:
+          secretAsEnv:
+          - secretName: my-secret
+            keyToEnv:
+            - secretKey: password
+              envVar: PASSWORD
+`
+
+func TestNewV2SpecTemplate_PlatformSpec(t *testing.T) {
+	tmpl, err := New([]byte(v2SpecHelloWorldYAML + v2PlatformSpecHelloWorldYAML))
+	assert.Nil(t, err)
+	v2Spec, ok := tmpl.(*V2Spec)
+	assert.True(t, ok)
+	assert.NotNil(t, v2Spec.platformSpec.GetPlatforms()["kubernetes"])
+
+	// The platform spec is kept when the template is stored and loaded again.
+	tmpl, err = New(tmpl.Bytes())
+	assert.Nil(t, err)
+	assert.NotNil(t, tmpl.(*V2Spec).platformSpec.GetPlatforms()["kubernetes"])
+
+	executionSpec, err := tmpl.RunWorkflow(&api.Run{
+		PipelineSpec: &api.PipelineSpec{
+			RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+				Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("hello")},
+			},
+		},
+	}, RunWorkflowOptions{RunId: "run1"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"secretAsEnv":[{"keyToEnv":[{"envVar":"PASSWORD","secretKey":"password"}],"secretName":"my-secret"}]}`,
+		executionSpec.(*commonutil.Workflow).Annotations["pipelines.kubeflow.org/kubernetes-exec-hello-world"])
+}
+
+func TestV2Spec_V2Config(t *testing.T) {
+	viper.Set(common.V2DriverImage, "gcr.io/ml-pipeline/kfp-driver:2.0.0")
+	viper.Set(common.V2ImageMirrors, map[string]interface{}{"gcr.io/ml-pipeline": "registry.local/ml-pipeline"})
+	viper.Set(common.V2NamespaceConfigs, map[string]interface{}{
+		"team-a": map[string]interface{}{
+			"imagePullPolicy":     "IfNotPresent",
+			"serviceAccount":      "team-a-runner",
+			"defaultPipelineRoot": "gs://team-a/artifacts",
+		},
+	})
+	defer func() {
+		for _, key := range []string{common.V2DriverImage, common.V2ImageMirrors, common.V2NamespaceConfigs} {
+			viper.Set(key, nil)
+		}
+	}()
+	tmpl, err := New([]byte(v2SpecHelloWorldYAML))
+	assert.Nil(t, err)
+	driver := func(spec *v1alpha1.WorkflowSpec) *v1alpha1.Template {
+		for i, template := range spec.Templates {
+			if template.Container != nil && template.Container.Image == "registry.local/ml-pipeline/kfp-driver:2.0.0" {
+				return &spec.Templates[i]
+			}
+		}
+		t.Fatalf("expect a driver template of the mirrored driver image, got %+v", spec.Templates)
+		return nil
+	}
+	runtimeConfig := &api.PipelineSpec_RuntimeConfig{
+		Parameters: map[string]*structpb.Value{"text": structpb.NewStringValue("hello")},
+	}
+
+	executionSpec, err := tmpl.RunWorkflow(&api.Run{PipelineSpec: &api.PipelineSpec{RuntimeConfig: runtimeConfig}},
+		RunWorkflowOptions{RunId: "run1", Namespace: "team-a"})
+	assert.Nil(t, err)
+	workflow := executionSpec.(*commonutil.Workflow)
+	assert.Equal(t, "team-a-runner", workflow.Spec.ServiceAccountName)
+	assert.Equal(t, corev1.PullIfNotPresent, driver(&workflow.Spec).Container.ImagePullPolicy)
+	bytes, err := yaml.Marshal(workflow.Spec)
+	assert.Nil(t, err)
+	assert.Contains(t, string(bytes), "gs://team-a/artifacts")
+
+	// The pipeline root and service account of the run take precedence.
+	executionSpec, err = tmpl.RunWorkflow(&api.Run{
+		ServiceAccount: "my-runner",
+		PipelineSpec: &api.PipelineSpec{RuntimeConfig: &api.PipelineSpec_RuntimeConfig{
+			Parameters:   runtimeConfig.Parameters,
+			PipelineRoot: "gs://my-bucket/artifacts",
+		}},
+	}, RunWorkflowOptions{RunId: "run2", Namespace: "team-a"})
+	assert.Nil(t, err)
+	workflow = executionSpec.(*commonutil.Workflow)
+	assert.Equal(t, "my-runner", workflow.Spec.ServiceAccountName)
+	bytes, err = yaml.Marshal(workflow.Spec)
+	assert.Nil(t, err)
+	assert.Contains(t, string(bytes), "gs://my-bucket/artifacts")
+	assert.NotContains(t, string(bytes), "gs://team-a/artifacts")
+
+	// Namespaces without a config get the cluster-wide config.
+	scheduledWorkflow, err := tmpl.ScheduledWorkflow(&api.Job{
+		Name:         "job1",
+		PipelineSpec: &api.PipelineSpec{RuntimeConfig: runtimeConfig},
+		Trigger:      &api.Trigger{},
+	}, "team-b")
+	assert.Nil(t, err)
+	assert.Equal(t, "pipeline-runner", scheduledWorkflow.Spec.Workflow.Spec.ServiceAccountName)
+	assert.Equal(t, corev1.PullPolicy(""), driver(&scheduledWorkflow.Spec.Workflow.Spec).Container.ImagePullPolicy)
+	bytes, err = yaml.Marshal(scheduledWorkflow.Spec.Workflow.Spec)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(bytes), "gs://team-a/artifacts")
+}
+
+func TestNewV2SpecTemplate_InvalidPlatformSpec(t *testing.T) {
+	_, err := New([]byte(v2SpecHelloWorldYAML + "\n---\nplatforms: 1\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid v2 platform spec")
+}
 
 func TestToSwfCRDResourceGeneratedName_SpecialCharsAndSpace(t *testing.T) {
 	name, err := toSWFCRDResourceGeneratedName("! HaVe ä £unky name")
@@ -320,6 +440,59 @@ func TestToCrdCronSchedule(t *testing.T) {
 	})
 }
 
+func TestToCrdCronSchedule_TimeZone(t *testing.T) {
+	actualCronSchedule := toCRDCronSchedule(&api.CronSchedule{
+		Cron:     "123",
+		TimeZone: "Europe/Stockholm",
+	})
+	assert.Equal(t, actualCronSchedule, &scheduledworkflow.CronSchedule{
+		Cron:     "123",
+		TimeZone: "Europe/Stockholm",
+	})
+}
+
+func TestToCrdBucketObjectTrigger(t *testing.T) {
+	actualTrigger := toCRDTrigger(&api.Trigger{
+		Trigger: &api.Trigger_BucketObjectTrigger{BucketObjectTrigger: &api.BucketObjectTrigger{
+			Bucket:        "minio://mlpipeline/incoming",
+			Pattern:       "*.csv",
+			ParameterName: "data",
+		}}})
+	assert.Equal(t, &scheduledworkflow.Trigger{
+		BucketObjectTrigger: &scheduledworkflow.BucketObjectTrigger{
+			Bucket:        "minio://mlpipeline/incoming",
+			Pattern:       "*.csv",
+			ParameterName: "data",
+		},
+	}, actualTrigger)
+
+	assert.Nil(t, toCRDBucketObjectTrigger(&api.BucketObjectTrigger{ParameterName: "data"}))
+}
+
+func TestToCrdRunDependencyTrigger(t *testing.T) {
+	actualTrigger := toCRDTrigger(&api.Trigger{
+		Trigger: &api.Trigger_RunDependencyTrigger{RunDependencyTrigger: &api.RunDependencyTrigger{
+			Upstream:      &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: "version1"},
+			ParameterName: "upstream",
+		}}})
+	assert.Equal(t, &scheduledworkflow.Trigger{
+		RunDependencyTrigger: &scheduledworkflow.RunDependencyTrigger{
+			UpstreamType:  "PipelineVersion",
+			UpstreamID:    "version1",
+			ParameterName: "upstream",
+		},
+	}, actualTrigger)
+
+	assert.Nil(t, toCRDRunDependencyTrigger(&api.RunDependencyTrigger{ParameterName: "upstream"}))
+}
+
+func TestToCrdConcurrencyPolicy(t *testing.T) {
+	assert.Equal(t, scheduledworkflow.ConcurrencyPolicy(""), toCRDConcurrencyPolicy(api.Job_ALLOW))
+	assert.Equal(t, scheduledworkflow.ForbidConcurrent, toCRDConcurrencyPolicy(api.Job_FORBID))
+	assert.Equal(t, scheduledworkflow.ReplaceConcurrent, toCRDConcurrencyPolicy(api.Job_REPLACE))
+	assert.Equal(t, scheduledworkflow.SkipConcurrent, toCRDConcurrencyPolicy(api.Job_SKIP))
+}
+
 func TestToCrdCronSchedule_NilCron(t *testing.T) {
 	actualCronSchedule := toCRDCronSchedule(&api.CronSchedule{
 		StartTime: &timestamp.Timestamp{Seconds: 123},
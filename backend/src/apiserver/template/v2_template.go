@@ -1,6 +1,7 @@
 package template
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 
@@ -9,44 +10,29 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
 	"google.golang.org/protobuf/encoding/protojson"
+	k8score "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type V2Spec struct {
 	spec *pipelinespec.PipelineSpec
+	// optional, platform specific configs of the pipeline
+	platformSpec *pipelinespec.PlatformSpec
 }
 
-func (t *V2Spec) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.ScheduledWorkflow, error) {
-	bytes, err := protojson.Marshal(t.spec)
-	if err != nil {
-		return nil, util.Wrap(err, "Failed marshal pipeline spec to json")
-	}
-	spec := &structpb.Struct{}
-	if err := protojson.Unmarshal(bytes, spec); err != nil {
-		return nil, util.Wrap(err, "Failed to parse pipeline spec")
-	}
-	job := &pipelinespec.PipelineJob{PipelineSpec: spec}
-	jobRuntimeConfig, err := toPipelineJobRuntimeConfig(apiJob.GetPipelineSpec().GetRuntimeConfig())
-	if err != nil {
-		return nil, util.Wrap(err, "Failed to convert to PipelineJob RuntimeConfig")
-	}
-	job.RuntimeConfig = jobRuntimeConfig
-	obj, err := argocompiler.Compile(job, nil)
+func (t *V2Spec) ScheduledWorkflow(apiJob *api.Job, namespace string) (*scheduledworkflow.ScheduledWorkflow, error) {
+	executionSpec, err := t.compile(apiJob.GetPipelineSpec().GetRuntimeConfig(), apiJob.GetServiceAccount(), namespace,
+		triggerParameterName(apiJob.Trigger))
 	if err != nil {
-		return nil, util.Wrap(err, "Failed to compile job")
+		return nil, err
 	}
-	// currently, there is only Argo implementation, so it's using `ArgoWorkflow` for now
-	// later on, if a new runtime support will be added, we need a way to switch/specify
-	// runtime. i.e using ENV var
-	executionSpec, err := util.NewExecutionSpecFromInterface(util.ArgoWorkflow, obj)
-	if err != nil {
-		return nil, util.NewInternalServerError(err, "not Workflow struct")
-	}
-	setDefaultServiceAccount(executionSpec, apiJob.GetServiceAccount())
 	// Disable istio sidecar injection if not specified
 	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
 	swfGeneratedName, err := toSWFCRDResourceGeneratedName(apiJob.Name)
@@ -70,7 +56,8 @@ func (t *V2Spec) ScheduledWorkflow(apiJob *api.Job) (*scheduledworkflow.Schedule
 				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
 				Spec:       workflow.Spec,
 			},
-			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
+			NoCatchup:         util.BoolPointer(apiJob.NoCatchup),
+			ConcurrencyPolicy: toCRDConcurrencyPolicy(apiJob.ConcurrencyPolicy),
 		},
 	}
 	return scheduledWorkflow, nil
@@ -82,6 +69,11 @@ func (t *V2Spec) GetTemplateType() TemplateType {
 
 func NewV2SpecTemplate(template []byte) (*V2Spec, error) {
 	var spec pipelinespec.PipelineSpec
+	// The platform spec is the optional second YAML document of the template.
+	template, platformTemplate, err := compiler.SplitPipelineYAML(template)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, fmt.Sprintf("invalid v2 pipeline spec: %s", err.Error()))
+	}
 	templateJson, err := yaml.YAMLToJSON(template)
 	if err != nil {
 		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, fmt.Sprintf("cannot convert v2 pipeline spec to json format: %s", err.Error()))
@@ -100,7 +92,14 @@ func NewV2SpecTemplate(template []byte) (*V2Spec, error) {
 	if spec.GetRoot() == nil {
 		return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, "invalid v2 pipeline spec: root component is empty")
 	}
-	return &V2Spec{spec: &spec}, nil
+	v2Spec := &V2Spec{spec: &spec}
+	if platformTemplate != nil {
+		v2Spec.platformSpec, err = compiler.ParsePlatformSpec(platformTemplate)
+		if err != nil {
+			return nil, util.NewInvalidInputErrorWithDetails(ErrorInvalidPipelineSpec, fmt.Sprintf("invalid v2 platform spec: %s", err.Error()))
+		}
+	}
+	return v2Spec, nil
 }
 
 func (t *V2Spec) Bytes() []byte {
@@ -112,7 +111,39 @@ func (t *V2Spec) Bytes() []byte {
 		// this is unexpected
 		return nil
 	}
-	return bytes
+	if t.platformSpec == nil {
+		return bytes
+	}
+	platformBytes, err := protojson.Marshal(t.platformSpec)
+	if err != nil {
+		// this is unexpected
+		return nil
+	}
+	// The platform spec is stored as the second YAML document.
+	return []byte(fmt.Sprintf("%s\n---\n%s", bytes, platformBytes))
+}
+
+// InlinePipelineVersions inlines the pipeline versions that components of the
+// pipeline import, see compiler.InlinePipelineVersions. versionID is the ID of
+// the pipeline version of the pipeline, if any. It returns the IDs of all the
+// imported pipeline versions.
+func (t *V2Spec) InlinePipelineVersions(versionID string, load func(id string) (*V2Spec, error)) ([]string, error) {
+	ids, err := compiler.InlinePipelineVersions(t.spec, versionID, func(id string) (*pipelinespec.PipelineSpec, error) {
+		v2Spec, err := load(id)
+		if err != nil {
+			return nil, err
+		}
+		return v2Spec.spec, nil
+	})
+	if err != nil {
+		var userError *util.UserError
+		if errors.As(err, &userError) {
+			// Keep the status of the errors of load, e.g. not found.
+			return nil, util.Wrapf(userError, "Failed to import pipeline versions: %v", err)
+		}
+		return nil, util.NewInvalidInputError("Failed to import pipeline versions: %v", err)
+	}
+	return ids, nil
 }
 
 func (t *V2Spec) IsV2() bool {
@@ -145,6 +176,31 @@ func (t *V2Spec) ParametersJSON() (string, error) {
 }
 
 func (t *V2Spec) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions) (util.ExecutionSpec, error) {
+	executionSpec, err := t.compile(apiRun.GetPipelineSpec().GetRuntimeConfig(), apiRun.GetServiceAccount(), options.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	// Disable istio sidecar injection if not specified
+	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
+	// Add label to the workflow so it can be persisted by persistent agent later.
+	executionSpec.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
+	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
+	executionSpec.SetAnnotations(util.AnnotationKeyRunName, apiRun.Name)
+	// Replace {{workflow.uid}} with runId
+	err = executionSpec.ReplaceUID(options.RunId)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to replace workflow ID")
+	}
+	executionSpec.SetPodMetadataLabels(util.LabelKeyWorkflowRunId, options.RunId)
+	return executionSpec, nil
+}
+
+// compile compiles the pipeline to a workflow in a namespace, with the images,
+// image pull policy, service account and pipeline root configured for v2
+// pipelines in the namespace. The trigger parameter, if any, is set by the
+// trigger of a job for each run, so it doesn't need a value.
+func (t *V2Spec) compile(apiRuntimeConfig *api.PipelineSpec_RuntimeConfig, serviceAccount, namespace string,
+	triggerParameter string) (util.ExecutionSpec, error) {
 	bytes, err := protojson.Marshal(t.spec)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed marshal pipeline spec to json")
@@ -154,31 +210,82 @@ func (t *V2Spec) RunWorkflow(apiRun *api.Run, options RunWorkflowOptions) (util.
 		return nil, util.Wrap(err, "Failed to parse pipeline spec")
 	}
 	job := &pipelinespec.PipelineJob{PipelineSpec: spec}
-	jobRuntimeConfig, err := toPipelineJobRuntimeConfig(apiRun.GetPipelineSpec().GetRuntimeConfig())
+	jobRuntimeConfig, err := toPipelineJobRuntimeConfig(apiRuntimeConfig)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to convert to PipelineJob RuntimeConfig")
 	}
 	job.RuntimeConfig = jobRuntimeConfig
-	obj, err := argocompiler.Compile(job, nil)
+	config, err := common.GetV2Config(namespace)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the v2 config of namespace %q", namespace)
+	}
+	coercion, err := parameter.ParseCoercionPolicy(config.ParameterCoercion)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Invalid v2 config of namespace %q", namespace)
+	}
+	// Validate the pipeline input parameters before the run starts, the
+	// root DAG driver validates them again with the same coercion policy.
+	if err := t.validateParameters(job.GetRuntimeConfig().GetParameterValues(), coercion, triggerParameter); err != nil {
+		return nil, err
+	}
+	opts := &argocompiler.Options{
+		DriverImage:       config.DriverImage,
+		LauncherImage:     config.LauncherImage,
+		ImagePullPolicy:   k8score.PullPolicy(config.ImagePullPolicy),
+		ImageMirrors:      config.ImageMirrors,
+		ParameterCoercion: coercion,
+	}
+	// The pipeline root of the run takes precedence over the default one.
+	if jobRuntimeConfig.GetGcsOutputDirectory() == "" {
+		opts.PipelineRoot = config.DefaultPipelineRoot
+	}
+	obj, err := argocompiler.Compile(job, compiler.GetKubernetesSpec(t.platformSpec), opts)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to compile job")
 	}
+	// currently, there is only Argo implementation, so it's using `ArgoWorkflow` for now
+	// later on, if a new runtime support will be added, we need a way to switch/specify
+	// runtime. i.e using ENV var
 	executionSpec, err := util.NewExecutionSpecFromInterface(util.ArgoWorkflow, obj)
 	if err != nil {
 		return nil, util.NewInternalServerError(err, "not Workflow struct")
 	}
-	setDefaultServiceAccount(executionSpec, apiRun.GetServiceAccount())
-	// Disable istio sidecar injection if not specified
-	executionSpec.SetAnnotationsToAllTemplatesIfKeyNotExist(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
-	// Add label to the workflow so it can be persisted by persistent agent later.
-	executionSpec.SetLabels(util.LabelKeyWorkflowRunId, options.RunId)
-	// Add run name annotation to the workflow so that it can be logged by the Metadata Writer.
-	executionSpec.SetAnnotations(util.AnnotationKeyRunName, apiRun.Name)
-	// Replace {{workflow.uid}} with runId
-	err = executionSpec.ReplaceUID(options.RunId)
-	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to replace workflow ID")
+	if serviceAccount == "" {
+		serviceAccount = config.ServiceAccount
 	}
-	executionSpec.SetPodMetadataLabels(util.LabelKeyWorkflowRunId, options.RunId)
+	setDefaultServiceAccount(executionSpec, serviceAccount)
 	return executionSpec, nil
 }
+
+// validateParameters validates the values of pipeline input parameters against
+// the input definitions of the root component. The trigger parameter, if any,
+// must be a string input parameter, which doesn't need a value.
+func (t *V2Spec) validateParameters(values map[string]*structpb.Value, coercion parameter.CoercionPolicy,
+	triggerParameter string) error {
+	specs := t.spec.GetRoot().GetInputDefinitions().GetParameters()
+	if unknown := parameter.Unknown(values, specs); len(unknown) > 0 {
+		return util.NewInvalidInputError("Unknown pipeline input parameters %q", unknown)
+	}
+	if triggerParameter != "" {
+		spec, ok := specs[triggerParameter]
+		if !ok {
+			return util.NewInvalidInputError("The parameter %q of the trigger is not a pipeline input parameter", triggerParameter)
+		}
+		parameterType := parameter.Type(spec)
+		if parameterType != pipelinespec.ParameterType_STRING &&
+			parameterType != pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED {
+			return util.NewInvalidInputError("The parameter %q of the trigger must be a string, not %v", triggerParameter, parameterType)
+		}
+		otherSpecs := make(map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec, len(specs))
+		for name, spec := range specs {
+			if name != triggerParameter {
+				otherSpecs[name] = spec
+			}
+		}
+		specs = otherSpecs
+	}
+	if _, err := parameter.Resolve(values, specs, coercion); err != nil {
+		return util.NewInvalidInputError("Invalid pipeline input parameters: %v", err)
+	}
+	return nil
+}
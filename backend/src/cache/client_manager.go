@@ -27,7 +27,9 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/cache/client"
 	"github.com/kubeflow/pipelines/backend/src/cache/model"
 	"github.com/kubeflow/pipelines/backend/src/cache/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/lib/pq"
 )
 
 const (
@@ -65,18 +67,24 @@ func (c *ClientManager) init(params WhSvrDBParameters, clientParams util.ClientP
 
 func initDBClient(params WhSvrDBParameters, initConnectionTimeout time.Duration) *storage.DB {
 	driverName := params.dbDriver
-	var arg string
+	var db *gorm.DB
+	var err error
 
 	switch driverName {
 	case mysqlDBDriverDefault:
-		arg = initMysql(params, initConnectionTimeout)
+		arg := initMysql(params, initConnectionTimeout)
+		// db is safe for concurrent use by multiple goroutines
+		// and maintains its own pool of idle connections.
+		db, err = gorm.Open(driverName, arg)
+	case postgres.DriverName:
+		arg := initPostgres(params, initConnectionTimeout)
+		var sqlDB *sql.DB
+		sqlDB, err = sql.Open(driverName, arg)
+		util.TerminateIfError(err)
+		db, err = gorm.Open(postgres.GormDialectName, sqlDB)
 	default:
 		glog.Fatalf("Driver %v is not supported", driverName)
 	}
-
-	// db is safe for concurrent use by multiple goroutines
-	// and maintains its own pool of idle connections.
-	db, err := gorm.Open(driverName, arg)
 	util.TerminateIfError(err)
 
 	// Create table
@@ -85,19 +93,23 @@ func initDBClient(params WhSvrDBParameters, initConnectionTimeout time.Duration)
 		glog.Fatalf("Failed to initialize the databases.")
 	}
 
-	response = db.Model(&model.ExecutionCache{}).ModifyColumn("ExecutionOutput", "longtext")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update the execution output type. Error: %s", response.Error)
-	}
-	response = db.Model(&model.ExecutionCache{}).ModifyColumn("ExecutionTemplate", "longtext not null")
-	if response.Error != nil {
-		glog.Fatalf("Failed to update the execution template type. Error: %s", response.Error)
-	}
+	// In postgres, string columns are already created as text, which has no
+	// length limit.
+	if driverName == mysqlDBDriverDefault {
+		response = db.Model(&model.ExecutionCache{}).ModifyColumn("ExecutionOutput", "longtext")
+		if response.Error != nil {
+			glog.Fatalf("Failed to update the execution output type. Error: %s", response.Error)
+		}
+		response = db.Model(&model.ExecutionCache{}).ModifyColumn("ExecutionTemplate", "longtext not null")
+		if response.Error != nil {
+			glog.Fatalf("Failed to update the execution template type. Error: %s", response.Error)
+		}
 
-	var tableNames []string
-	db.Raw(`show tables`).Pluck("Tables_in_caches", &tableNames)
-	for _, tableName := range tableNames {
-		log.Printf(tableName)
+		var tableNames []string
+		db.Raw(`show tables`).Pluck("Tables_in_caches", &tableNames)
+		for _, tableName := range tableNames {
+			log.Printf(tableName)
+		}
 	}
 
 	return storage.NewDB(db)
@@ -169,6 +181,53 @@ func initMysql(params WhSvrDBParameters, initConnectionTimeout time.Duration) st
 	return mysqlConfig.FormatDSN()
 }
 
+func initPostgres(params WhSvrDBParameters, initConnectionTimeout time.Duration) string {
+	var extraParams = map[string]string{}
+	data := []byte(params.dbExtraParams)
+	json.Unmarshal(data, &extraParams)
+
+	// Connect to the default database to create the cache database if needed.
+	var db *sql.DB
+	var err error
+	var operation = func() error {
+		db, err = sql.Open(params.dbDriver, postgres.CreateConnectionString(
+			params.dbUser, params.dbPwd, params.dbHost, params.dbPort, "postgres", extraParams))
+		if err != nil {
+			return err
+		}
+		return db.Ping()
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+
+	defer db.Close()
+	util.TerminateIfError(err)
+
+	// Create database if not exist. Postgres doesn't support
+	// CREATE DATABASE IF NOT EXISTS.
+	dbName := params.dbName
+	operation = func() error {
+		var exists bool
+		err = db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", dbName).Scan(&exists)
+		if err != nil || exists {
+			return err
+		}
+		_, err = db.Exec("CREATE DATABASE " + pq.QuoteIdentifier(dbName))
+		if err != nil {
+			return err
+		}
+		log.Printf("Database created")
+		return nil
+	}
+	b = backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+
+	util.TerminateIfError(err)
+	return postgres.CreateConnectionString(params.dbUser, params.dbPwd, params.dbHost, params.dbPort, dbName, extraParams)
+}
+
 func NewClientManager(params WhSvrDBParameters, clientParams util.ClientParameters) ClientManager {
 	clientManager := ClientManager{}
 	clientManager.init(params, clientParams)
@@ -22,6 +22,7 @@ import (
 	"path/filepath"
 
 	"github.com/kubeflow/pipelines/backend/src/cache/server"
+	"github.com/kubeflow/pipelines/backend/src/common/postgres"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 )
 
@@ -43,6 +44,9 @@ const (
 	mysqlDBHostDefault              = "mysql"
 	mysqlDBPortDefault              = "3306"
 	mysqlDBGroupConcatMaxLenDefault = "4194304"
+
+	postgresDBHostDefault = "postgres"
+	postgresDBPortDefault = "5432"
 )
 
 type WhSvrDBParameters struct {
@@ -63,9 +67,9 @@ func main() {
 	var certFile string
 	var keyFile string
 
-	flag.StringVar(&params.dbDriver, "db_driver", mysqlDBDriverDefault, "Database driver name, mysql is the default value")
-	flag.StringVar(&params.dbHost, "db_host", mysqlDBHostDefault, "Database host name.")
-	flag.StringVar(&params.dbPort, "db_port", mysqlDBPortDefault, "Database port number.")
+	flag.StringVar(&params.dbDriver, "db_driver", mysqlDBDriverDefault, "Database driver name, mysql (default) or postgres.")
+	flag.StringVar(&params.dbHost, "db_host", "", "Database host name. Defaults to mysql or postgres depending on the driver.")
+	flag.StringVar(&params.dbPort, "db_port", "", "Database port number. Defaults to 3306 or 5432 depending on the driver.")
 	flag.StringVar(&params.dbName, "db_name", "cachedb", "Database name.")
 	flag.StringVar(&params.dbUser, "db_user", "root", "Database user name.")
 	flag.StringVar(&params.dbPwd, "db_password", "", "Database password.")
@@ -83,6 +87,19 @@ func main() {
 
 	flag.Parse()
 
+	if params.dbHost == "" {
+		params.dbHost = mysqlDBHostDefault
+		if params.dbDriver == postgres.DriverName {
+			params.dbHost = postgresDBHostDefault
+		}
+	}
+	if params.dbPort == "" {
+		params.dbPort = mysqlDBPortDefault
+		if params.dbDriver == postgres.DriverName {
+			params.dbPort = postgresDBPortDefault
+		}
+	}
+
 	log.Println("Initing client manager....")
 	clientManager := NewClientManager(params, clientParams)
 	ctx := context.Background()
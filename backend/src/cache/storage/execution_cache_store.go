@@ -123,15 +123,15 @@ func (s *ExecutionCacheStore) CreateExecutionCache(executionCache *model.Executi
 	if !ok {
 		return nil, fmt.Errorf("Failed to create a new execution cache")
 	}
-	var rowInsert model.ExecutionCache
-	d := s.db.Create(&newExecutionCache).Scan(&rowInsert)
+	// The ID generated by the database is set on newExecutionCache by Create.
+	d := s.db.Create(&newExecutionCache)
 	if d.Error != nil {
 		return nil, d.Error
 	}
 	log.Println("Cache entry created with cache key: " + newExecutionCache.ExecutionCacheKey)
 	log.Println(newExecutionCache.ExecutionTemplate)
-	log.Println(rowInsert.ID)
-	return &rowInsert, nil
+	log.Println(newExecutionCache.ID)
+	return &newExecutionCache, nil
 }
 
 func (s *ExecutionCacheStore) DeleteExecutionCache(executionCacheID string) error {
@@ -0,0 +1,240 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres contains the helpers shared by the API server and the cache
+// server to store their data in PostgreSQL.
+package postgres
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	// Registers the "postgres" database/sql driver.
+	_ "github.com/lib/pq"
+)
+
+const (
+	// DriverName is the database/sql driver name used to connect to PostgreSQL.
+	DriverName = "postgres"
+	// GormDialectName is the name under which the gorm dialect of this package is
+	// registered. Use it with gorm.Open together with an opened *sql.DB.
+	GormDialectName = "kfp_postgres"
+)
+
+func init() {
+	gorm.RegisterDialect(GormDialectName, &gormDialect{})
+}
+
+// gormDialect is a PostgreSQL dialect for gorm that creates every table, column,
+// index and constraint with a lower case name.
+//
+// All queries in KFP refer to columns with unquoted CamelCase names (for
+// example UUID or CreatedAtInSec). MySQL and SQLite resolve those case
+// insensitively, while PostgreSQL folds unquoted identifiers to lower case.
+// The stock gorm postgres dialect quotes identifiers and therefore preserves
+// their case, which would make the tables unreachable by the existing queries.
+type gormDialect struct {
+	db gorm.SQLCommon
+	gorm.DefaultForeignKeyNamer
+}
+
+func (gormDialect) GetName() string {
+	return GormDialectName
+}
+
+func (s *gormDialect) SetDB(db gorm.SQLCommon) {
+	s.db = db
+}
+
+func (gormDialect) BindVar(i int) string {
+	return fmt.Sprintf("$%v", i)
+}
+
+func (gormDialect) Quote(key string) string {
+	return fmt.Sprintf(`"%s"`, strings.ToLower(key))
+}
+
+func (s *gormDialect) DataTypeOf(field *gorm.StructField) string {
+	var dataValue, sqlType, size, additionalType = gorm.ParseFieldStructForDialect(field, s)
+
+	if sqlType == "" {
+		switch dataValue.Kind() {
+		case reflect.Bool:
+			sqlType = "boolean"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uintptr:
+			if fieldCanAutoIncrement(field) {
+				field.TagSettings["AUTO_INCREMENT"] = "AUTO_INCREMENT"
+				sqlType = "serial"
+			} else {
+				sqlType = "integer"
+			}
+		case reflect.Int64, reflect.Uint32, reflect.Uint64:
+			if fieldCanAutoIncrement(field) {
+				field.TagSettings["AUTO_INCREMENT"] = "AUTO_INCREMENT"
+				sqlType = "bigserial"
+			} else {
+				sqlType = "bigint"
+			}
+		case reflect.Float32, reflect.Float64:
+			sqlType = "double precision"
+		case reflect.String:
+			if _, ok := field.TagSettings["SIZE"]; !ok {
+				size = 0
+			}
+			// Columns that are meant to be stored as longtext in MySQL are declared
+			// with a size of 65535, so anything that large becomes text.
+			if size > 0 && size < 65532 {
+				sqlType = fmt.Sprintf("varchar(%d)", size)
+			} else {
+				sqlType = "text"
+			}
+		case reflect.Struct:
+			if _, ok := dataValue.Interface().(time.Time); ok {
+				sqlType = "timestamp with time zone"
+			}
+		default:
+			if _, ok := dataValue.Interface().([]byte); ok {
+				sqlType = "bytea"
+			}
+		}
+	}
+
+	if sqlType == "" {
+		panic(fmt.Sprintf("invalid sql type %s (%s) for postgres", dataValue.Type().Name(), dataValue.Kind().String()))
+	}
+
+	if strings.TrimSpace(additionalType) == "" {
+		return sqlType
+	}
+	return fmt.Sprintf("%v %v", sqlType, additionalType)
+}
+
+func (s gormDialect) HasIndex(tableName string, indexName string) bool {
+	var count int
+	s.db.QueryRow("SELECT count(*) FROM pg_indexes WHERE tablename = $1 AND indexname = $2 AND schemaname = CURRENT_SCHEMA()",
+		strings.ToLower(tableName), strings.ToLower(indexName)).Scan(&count)
+	return count > 0
+}
+
+func (s gormDialect) HasForeignKey(tableName string, foreignKeyName string) bool {
+	var count int
+	s.db.QueryRow("SELECT count(con.conname) FROM pg_constraint con WHERE $1::regclass::oid = con.conrelid AND con.conname = $2 AND con.contype='f'",
+		strings.ToLower(tableName), strings.ToLower(foreignKeyName)).Scan(&count)
+	return count > 0
+}
+
+func (s gormDialect) RemoveIndex(tableName string, indexName string) error {
+	_, err := s.db.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %v", s.Quote(indexName)))
+	return err
+}
+
+func (s gormDialect) HasTable(tableName string) bool {
+	var count int
+	s.db.QueryRow("SELECT count(*) FROM INFORMATION_SCHEMA.tables WHERE table_name = $1 AND table_type = 'BASE TABLE' AND table_schema = CURRENT_SCHEMA()",
+		strings.ToLower(tableName)).Scan(&count)
+	return count > 0
+}
+
+func (s gormDialect) HasColumn(tableName string, columnName string) bool {
+	var count int
+	s.db.QueryRow("SELECT count(*) FROM INFORMATION_SCHEMA.columns WHERE table_name = $1 AND column_name = $2 AND table_schema = CURRENT_SCHEMA()",
+		strings.ToLower(tableName), strings.ToLower(columnName)).Scan(&count)
+	return count > 0
+}
+
+func (s gormDialect) ModifyColumn(tableName string, columnName string, typ string) error {
+	_, err := s.db.Exec(fmt.Sprintf("ALTER TABLE %v ALTER COLUMN %v TYPE %v", s.Quote(tableName), s.Quote(columnName), typ))
+	return err
+}
+
+func (gormDialect) LimitAndOffsetSQL(limit, offset interface{}) (sql string) {
+	if limit != nil {
+		sql += fmt.Sprintf(" LIMIT %v", limit)
+	}
+	if offset != nil {
+		sql += fmt.Sprintf(" OFFSET %v", offset)
+	}
+	return
+}
+
+func (gormDialect) SelectFromDummyTable() string {
+	return ""
+}
+
+func (s gormDialect) LastInsertIDReturningSuffix(tableName, key string) string {
+	return fmt.Sprintf("RETURNING %v.%v", tableName, key)
+}
+
+func (gormDialect) DefaultValueStr() string {
+	return "DEFAULT VALUES"
+}
+
+func (s gormDialect) CurrentDatabase() (name string) {
+	s.db.QueryRow("SELECT CURRENT_DATABASE()").Scan(&name)
+	return
+}
+
+func fieldCanAutoIncrement(field *gorm.StructField) bool {
+	if value, ok := field.TagSettings["AUTO_INCREMENT"]; ok {
+		return strings.ToLower(value) != "false"
+	}
+	return field.IsPrimaryKey
+}
+
+// CreateConnectionString builds a lib/pq keyword/value connection string.
+// Entries in extraParams (for example sslmode) take precedence over the
+// defaults.
+func CreateConnectionString(user, password, host, port, dbName string, extraParams map[string]string) string {
+	params := map[string]string{
+		"host":    host,
+		"port":    port,
+		"user":    user,
+		"sslmode": "disable",
+	}
+	if password != "" {
+		params["password"] = password
+	}
+	if dbName != "" {
+		params["dbname"] = dbName
+	}
+	for k, v := range extraParams {
+		params[k] = v
+	}
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, quoteConnectionValue(params[k])))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// quoteConnectionValue quotes a connection string value if needed, following
+// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING.
+func quoteConnectionValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGormDialect_Quote(t *testing.T) {
+	dialect := &gormDialect{}
+	assert.Equal(t, `"run_details"`, dialect.Quote("run_details"))
+	assert.Equal(t, `"createdatinsec"`, dialect.Quote("CreatedAtInSec"))
+}
+
+func TestGormDialect_BindVar(t *testing.T) {
+	dialect := &gormDialect{}
+	assert.Equal(t, "$1", dialect.BindVar(1))
+	assert.Equal(t, "$12", dialect.BindVar(12))
+}
+
+func TestGormDialect_LimitAndOffsetSQL(t *testing.T) {
+	dialect := &gormDialect{}
+	assert.Equal(t, " LIMIT 10 OFFSET 5", dialect.LimitAndOffsetSQL(10, 5))
+	assert.Equal(t, "", dialect.LimitAndOffsetSQL(nil, nil))
+}
+
+func TestCreateConnectionString(t *testing.T) {
+	tests := []struct {
+		name        string
+		password    string
+		dbName      string
+		extraParams map[string]string
+		want        string
+	}{
+		{
+			name: "default config",
+			want: "host=postgres port=5432 sslmode=disable user=root",
+		},
+		{
+			name:     "password and database",
+			password: "pass word",
+			dbName:   "mlpipeline",
+			want:     "dbname=mlpipeline host=postgres password='pass word' port=5432 sslmode=disable user=root",
+		},
+		{
+			name:        "extra parameters override defaults",
+			extraParams: map[string]string{"sslmode": "require", "connect_timeout": "10"},
+			want:        "connect_timeout=10 host=postgres port=5432 sslmode=require user=root",
+		},
+		{
+			name:     "escaped password",
+			password: `it's\secret`,
+			want:     `host=postgres password='it\'s\\secret' port=5432 sslmode=disable user=root`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CreateConnectionString("root", tt.password, "postgres", "5432", tt.dbName, tt.extraParams)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
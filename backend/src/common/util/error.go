@@ -202,6 +202,14 @@ func NewFailedPreconditionError(err error, externalFormat string, a ...interface
 		codes.FailedPrecondition)
 }
 
+func NewResourceExhaustedError(err error, externalFormat string, a ...interface{}) *UserError {
+	externalMessage := fmt.Sprintf(externalFormat, a...)
+	return newUserError(
+		errors.Wrapf(err, fmt.Sprintf("ResourceExhaustedError: %v", externalMessage)),
+		externalMessage,
+		codes.ResourceExhausted)
+}
+
 func NewUnauthenticatedError(err error, externalFormat string, a ...interface{}) *UserError {
 	externalMessage := fmt.Sprintf(externalFormat, a...)
 	return newUserError(
@@ -58,6 +58,13 @@ func (s *ScheduledWorkflow) CronOrEmpty() string {
 	return ""
 }
 
+func (s *ScheduledWorkflow) CronScheduleTimeZoneOrNull() *string {
+	if s.Spec.CronSchedule != nil && s.Spec.CronSchedule.TimeZone != "" {
+		return StringPointer(s.Spec.CronSchedule.TimeZone)
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) PeriodicScheduleStartTimeInSecOrNull() *int64 {
 	if s.Spec.PeriodicSchedule != nil && s.Spec.PeriodicSchedule.StartTime != nil {
 		return Int64Pointer(s.Spec.PeriodicSchedule.StartTime.Unix())
@@ -72,6 +79,48 @@ func (s *ScheduledWorkflow) PeriodicScheduleEndTimeInSecOrNull() *int64 {
 	return nil
 }
 
+func (s *ScheduledWorkflow) BucketObjectTriggerBucketOrNull() *string {
+	if s.Spec.BucketObjectTrigger != nil {
+		return StringPointer(s.Spec.BucketObjectTrigger.Bucket)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) BucketObjectTriggerPatternOrNull() *string {
+	if s.Spec.BucketObjectTrigger != nil && s.Spec.BucketObjectTrigger.Pattern != "" {
+		return StringPointer(s.Spec.BucketObjectTrigger.Pattern)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) BucketObjectTriggerParameterNameOrNull() *string {
+	if s.Spec.BucketObjectTrigger != nil {
+		return StringPointer(s.Spec.BucketObjectTrigger.ParameterName)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunDependencyTriggerUpstreamTypeOrNull() *string {
+	if s.Spec.RunDependencyTrigger != nil {
+		return StringPointer(s.Spec.RunDependencyTrigger.UpstreamType)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunDependencyTriggerUpstreamIdOrNull() *string {
+	if s.Spec.RunDependencyTrigger != nil {
+		return StringPointer(s.Spec.RunDependencyTrigger.UpstreamID)
+	}
+	return nil
+}
+
+func (s *ScheduledWorkflow) RunDependencyTriggerParameterNameOrNull() *string {
+	if s.Spec.RunDependencyTrigger != nil {
+		return StringPointer(s.Spec.RunDependencyTrigger.ParameterName)
+	}
+	return nil
+}
+
 func (s *ScheduledWorkflow) MaxConcurrencyOr0() int64 {
 	if s.Spec.MaxConcurrency != nil {
 		return *s.Spec.MaxConcurrency
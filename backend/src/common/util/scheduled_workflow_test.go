@@ -62,9 +62,53 @@ func TestScheduledWorkflow_Getters(t *testing.T) {
 	assert.Equal(t, (*int64)(nil), workflow.PeriodicScheduleStartTimeInSecOrNull())
 	assert.Equal(t, (*int64)(nil), workflow.PeriodicScheduleEndTimeInSecOrNull())
 	assert.Equal(t, int64(0), workflow.IntervalSecondOr0())
+	assert.Equal(t, (*string)(nil), workflow.BucketObjectTriggerBucketOrNull())
+	assert.Equal(t, (*string)(nil), workflow.BucketObjectTriggerPatternOrNull())
+	assert.Equal(t, (*string)(nil), workflow.BucketObjectTriggerParameterNameOrNull())
+	assert.Equal(t, (*string)(nil), workflow.RunDependencyTriggerUpstreamTypeOrNull())
+	assert.Equal(t, (*string)(nil), workflow.RunDependencyTriggerUpstreamIdOrNull())
+	assert.Equal(t, (*string)(nil), workflow.RunDependencyTriggerParameterNameOrNull())
 
 }
 
+func TestScheduledWorkflow_BucketObjectTriggerGetters(t *testing.T) {
+	workflow := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{
+					Bucket:        "minio://mlpipeline/incoming",
+					Pattern:       "*.csv",
+					ParameterName: "data",
+				},
+			},
+		},
+	})
+	assert.Equal(t, StringPointer("minio://mlpipeline/incoming"), workflow.BucketObjectTriggerBucketOrNull())
+	assert.Equal(t, StringPointer("*.csv"), workflow.BucketObjectTriggerPatternOrNull())
+	assert.Equal(t, StringPointer("data"), workflow.BucketObjectTriggerParameterNameOrNull())
+
+	// The empty pattern matches all the objects.
+	workflow.Spec.BucketObjectTrigger.Pattern = ""
+	assert.Equal(t, (*string)(nil), workflow.BucketObjectTriggerPatternOrNull())
+}
+
+func TestScheduledWorkflow_RunDependencyTriggerGetters(t *testing.T) {
+	workflow := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Trigger: swfapi.Trigger{
+				RunDependencyTrigger: &swfapi.RunDependencyTrigger{
+					UpstreamType:  "Job",
+					UpstreamID:    "job1",
+					ParameterName: "upstream",
+				},
+			},
+		},
+	})
+	assert.Equal(t, StringPointer("Job"), workflow.RunDependencyTriggerUpstreamTypeOrNull())
+	assert.Equal(t, StringPointer("job1"), workflow.RunDependencyTriggerUpstreamIdOrNull())
+	assert.Equal(t, StringPointer("upstream"), workflow.RunDependencyTriggerParameterNameOrNull())
+}
+
 func TestScheduledWorkflow_ConditionSummary(t *testing.T) {
 	// Base case
 	workflow := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
@@ -123,6 +123,105 @@ func (w *Workflow) VerifyParameters(desiredParams map[string]string) error {
 	return nil
 }
 
+// OverrideRuntimeConfigParameters overrides some of the pipeline input
+// parameters of a Workflow compiled from a v2 pipeline spec. They are not
+// parameters of the Workflow, but values of the runtime config passed to the
+// driver of the root DAG.
+func (w *Workflow) OverrideRuntimeConfigParameters(desiredParams map[string]string) error {
+	for i := range w.Spec.Templates {
+		if w.Spec.Templates[i].DAG == nil {
+			continue
+		}
+		for j := range w.Spec.Templates[i].DAG.Tasks {
+			params := w.Spec.Templates[i].DAG.Tasks[j].Arguments.Parameters
+			if !isRootDAGDriver(params) {
+				continue
+			}
+			for k := range params {
+				if params[k].Name != runtimeConfigParameter || params[k].Value == nil {
+					continue
+				}
+				runtimeConfig := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(params[k].Value.String()), &runtimeConfig); err != nil {
+					return NewInternalServerError(err, "Failed to unmarshal the runtime config of the workflow")
+				}
+				values, _ := runtimeConfig["parameterValues"].(map[string]interface{})
+				if values == nil {
+					values = make(map[string]interface{})
+				}
+				for name, value := range desiredParams {
+					values[name] = value
+				}
+				runtimeConfig["parameterValues"] = values
+				bytes, err := json.Marshal(runtimeConfig)
+				if err != nil {
+					return NewInternalServerError(err, "Failed to marshal the runtime config of the workflow")
+				}
+				params[k].Value = workflowapi.AnyStringPtr(string(bytes))
+			}
+		}
+	}
+	return nil
+}
+
+// RuntimeConfigParameters returns the pipeline input parameters of a Workflow
+// compiled from a v2 pipeline spec, as set in the runtime config of the root
+// DAG driver. The values that aren't strings are JSON. ok is false if the
+// Workflow has no root DAG driver, i.e. it isn't compiled from a v2 pipeline
+// spec.
+func (w *Workflow) RuntimeConfigParameters() (params map[string]string, ok bool, err error) {
+	for _, template := range w.Spec.Templates {
+		if template.DAG == nil {
+			continue
+		}
+		for _, task := range template.DAG.Tasks {
+			if !isRootDAGDriver(task.Arguments.Parameters) {
+				continue
+			}
+			params = make(map[string]string)
+			for _, param := range task.Arguments.Parameters {
+				if param.Name != runtimeConfigParameter || param.Value == nil {
+					continue
+				}
+				var runtimeConfig struct {
+					ParameterValues map[string]interface{} `json:"parameterValues"`
+				}
+				if err := json.Unmarshal([]byte(param.Value.String()), &runtimeConfig); err != nil {
+					return nil, false, NewInternalServerError(err, "Failed to unmarshal the runtime config of the workflow")
+				}
+				for name, value := range runtimeConfig.ParameterValues {
+					if s, isString := value.(string); isString {
+						params[name] = s
+						continue
+					}
+					bytes, err := json.Marshal(value)
+					if err != nil {
+						return nil, false, NewInternalServerError(err, "Failed to marshal parameter %v of the workflow", name)
+					}
+					params[name] = string(bytes)
+				}
+			}
+			return params, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+const (
+	runtimeConfigParameter = "runtime-config"
+	driverTypeParameter    = "driver-type"
+	rootDAGDriverType      = "ROOT_DAG"
+)
+
+func isRootDAGDriver(params []workflowapi.Parameter) bool {
+	for _, param := range params {
+		if param.Name == driverTypeParameter && param.Value != nil && param.Value.String() == rootDAGDriverType {
+			return true
+		}
+	}
+	return false
+}
+
 // Get converts this object to a workflowapi.Workflow.
 func (w *Workflow) Get() *workflowapi.Workflow {
 	return w.Workflow
@@ -343,6 +442,11 @@ func (w *Workflow) FindObjectStoreArtifactKeyOrEmpty(nodeID string, artifactName
 	return s3Key
 }
 
+// IsSucceeded returns whether the workflow succeeded.
+func (w *Workflow) IsSucceeded() bool {
+	return w.Status.Phase == workflowapi.WorkflowSucceeded
+}
+
 // IsInFinalState whether the workflow is in a final state.
 func (w *Workflow) IsInFinalState() bool {
 	// Workflows in the statuses other than pending or running are considered final.
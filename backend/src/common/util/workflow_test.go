@@ -544,6 +544,77 @@ func TestVerifyParameters_Failed(t *testing.T) {
 	assert.NotNil(t, workflow.VerifyParameters(map[string]string{"PARAM1": "V1", "NON_EXIST": "V2"}))
 }
 
+func TestWorkflow_OverrideRuntimeConfigParameters(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{
+			Templates: []workflowapi.Template{{
+				Name: "entrypoint",
+				DAG: &workflowapi.DAGTemplate{
+					Tasks: []workflowapi.DAGTask{
+						{
+							Name: "root-driver",
+							Arguments: workflowapi.Arguments{
+								Parameters: []workflowapi.Parameter{
+									{Name: "runtime-config", Value: workflowapi.AnyStringPtr(`{"parameterValues":{"PARAM1":"VALUE1","PARAM2":2}}`)},
+									{Name: "driver-type", Value: workflowapi.AnyStringPtr("ROOT_DAG")},
+								},
+							},
+						},
+						{
+							Name: "other-driver",
+							Arguments: workflowapi.Arguments{
+								Parameters: []workflowapi.Parameter{
+									{Name: "runtime-config", Value: workflowapi.AnyStringPtr(`{}`)},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	})
+
+	err := workflow.OverrideRuntimeConfigParameters(map[string]string{"PARAM1": "NEW_VALUE1", "PARAM3": "VALUE3"})
+	assert.Nil(t, err)
+	tasks := workflow.Spec.Templates[0].DAG.Tasks
+	assert.JSONEq(t, `{"parameterValues":{"PARAM1":"NEW_VALUE1","PARAM2":2,"PARAM3":"VALUE3"}}`,
+		tasks[0].Arguments.Parameters[0].Value.String())
+	assert.Equal(t, "ROOT_DAG", tasks[0].Arguments.Parameters[1].Value.String())
+	// Only the runtime config of the root DAG driver is overridden.
+	assert.Equal(t, `{}`, tasks[1].Arguments.Parameters[0].Value.String())
+}
+
+func TestWorkflow_RuntimeConfigParameters(t *testing.T) {
+	workflow := NewWorkflow(&workflowapi.Workflow{
+		Spec: workflowapi.WorkflowSpec{
+			Templates: []workflowapi.Template{{
+				Name: "entrypoint",
+				DAG: &workflowapi.DAGTemplate{
+					Tasks: []workflowapi.DAGTask{{
+						Name: "root-driver",
+						Arguments: workflowapi.Arguments{
+							Parameters: []workflowapi.Parameter{
+								{Name: "runtime-config", Value: workflowapi.AnyStringPtr(`{"parameterValues":{"PARAM1":"VALUE1","PARAM2":2,"PARAM3":[true]}}`)},
+								{Name: "driver-type", Value: workflowapi.AnyStringPtr("ROOT_DAG")},
+							},
+						},
+					}},
+				},
+			}},
+		},
+	})
+
+	params, ok, err := workflow.RuntimeConfigParameters()
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"PARAM1": "VALUE1", "PARAM2": "2", "PARAM3": "[true]"}, params)
+
+	// A workflow compiled from a v1 pipeline has no root DAG driver.
+	_, ok, err = NewWorkflow(&workflowapi.Workflow{}).RuntimeConfigParameters()
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
 func TestFindS3ArtifactKey_Succeed(t *testing.T) {
 	expectedPath := "expected/path"
 	workflow := NewWorkflow(&workflowapi.Workflow{
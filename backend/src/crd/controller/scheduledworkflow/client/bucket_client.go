@@ -0,0 +1,89 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/kubeflow/pipelines/backend/src/crd/controller/scheduledworkflow/util"
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	wraperror "github.com/pkg/errors"
+	"gocloud.dev/blob"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BucketClient is a client to list the objects of the buckets of the
+// BucketObjectTriggers.
+type BucketClient struct {
+	openBucket func(ctx context.Context, namespace string, config *objectstore.Config) (*blob.Bucket, error)
+}
+
+// NewBucketClient creates a new client to list the objects of buckets. The
+// credentials of MinIO buckets are read from the namespace of the
+// ScheduledWorkflow.
+func NewBucketClient(kubeClientSet kubernetes.Interface) *BucketClient {
+	return &BucketClient{
+		openBucket: func(ctx context.Context, namespace string, config *objectstore.Config) (*blob.Bucket, error) {
+			return objectstore.OpenBucket(ctx, kubeClientSet, namespace, config)
+		},
+	}
+}
+
+// List returns the objects of the bucket of a trigger that can match its
+// pattern, given the namespace of its ScheduledWorkflow. The keys of the
+// objects are relative to the prefix of the bucket. Only the objects under the
+// literal prefix of the pattern are listed, and not the ones of the
+// subdirectories when the pattern can't match a key with a "/".
+func (c *BucketClient) List(ctx context.Context, namespace string, trigger *swfapi.BucketObjectTrigger) (
+	[]util.BucketObject, error) {
+	config, err := objectstore.ParseBucketConfig(trigger.Bucket)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Error parsing the bucket (%v) of the trigger: %v", trigger.Bucket, err)
+	}
+	bucket, err := c.openBucket(ctx, namespace, config)
+	if err != nil {
+		return nil, wraperror.Wrapf(err, "Error opening the bucket (%v) in namespace (%v): %v", trigger.Bucket,
+			namespace, err)
+	}
+	defer bucket.Close()
+
+	prefix, topLevel := util.NewBucketObjectTrigger(trigger).ListPrefix()
+	opts := &blob.ListOptions{Prefix: prefix}
+	if topLevel {
+		opts.Delimiter = "/"
+	}
+	objects := make([]util.BucketObject, 0)
+	iter := bucket.List(opts)
+	for {
+		object, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, wraperror.Wrapf(err, "Error listing the objects of the bucket (%v): %v", trigger.Bucket, err)
+		}
+		if object.IsDir {
+			continue
+		}
+		objects = append(objects, util.BucketObject{
+			Key:     object.Key,
+			URI:     config.UriFromKey(object.Key),
+			ModTime: object.ModTime,
+		})
+	}
+	return objects, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+)
+
+// newFileBucketClient returns a client that lists the objects of a local
+// directory, whatever the bucket name.
+func newFileBucketClient(t *testing.T, dir string) *BucketClient {
+	return &BucketClient{
+		openBucket: func(ctx context.Context, namespace string, config *objectstore.Config) (*blob.Bucket, error) {
+			assert.Equal(t, "NAMESPACE", namespace)
+			bucket, err := fileblob.OpenBucket(dir, nil)
+			if err != nil {
+				return nil, err
+			}
+			return blob.PrefixedBucket(bucket, config.Prefix), nil
+		},
+	}
+}
+
+func TestBucketClient_List(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bucket")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	for _, file := range []string{"incoming/a.csv", "incoming/nested/b.csv", "other/c.csv"} {
+		require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0755))
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, file), []byte("data"), 0644))
+	}
+
+	client := newFileBucketClient(t, dir)
+	objects, err := client.List(context.Background(), "NAMESPACE", &swfapi.BucketObjectTrigger{
+		Bucket: "minio://mlpipeline/incoming",
+	})
+	require.Nil(t, err)
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	require.Len(t, objects, 2)
+	assert.Equal(t, "a.csv", objects[0].Key)
+	assert.Equal(t, "minio://mlpipeline/incoming/a.csv", objects[0].URI)
+	assert.False(t, objects[0].ModTime.IsZero())
+	assert.Equal(t, "nested/b.csv", objects[1].Key)
+	assert.Equal(t, "minio://mlpipeline/incoming/nested/b.csv", objects[1].URI)
+}
+
+func TestBucketClient_List_Pattern(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bucket")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	for _, file := range []string{"incoming/a.csv", "incoming/nested/b.csv", "incoming/nested/c.txt", "incoming/other/d.csv"} {
+		require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), 0755))
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, file), []byte("data"), 0644))
+	}
+
+	tests := []struct {
+		pattern string
+		keys    []string
+	}{
+		// The objects of the subdirectories can't match.
+		{"*.csv", []string{"a.csv"}},
+		// Only the objects under the literal prefix of the pattern are listed.
+		{"nested/*", []string{"nested/b.csv", "nested/c.txt"}},
+		{"nested/b.csv", []string{"nested/b.csv"}},
+		{"*/*.csv", []string{"a.csv", "nested/b.csv", "nested/c.txt", "other/d.csv"}},
+	}
+	client := newFileBucketClient(t, dir)
+	for _, tt := range tests {
+		objects, err := client.List(context.Background(), "NAMESPACE", &swfapi.BucketObjectTrigger{
+			Bucket:  "minio://mlpipeline/incoming",
+			Pattern: tt.pattern,
+		})
+		require.Nil(t, err)
+		keys := make([]string, 0)
+		for _, object := range objects {
+			keys = append(keys, object.Key)
+		}
+		sort.Strings(keys)
+		assert.Equal(t, tt.keys, keys, tt.pattern)
+	}
+}
+
+func TestBucketClient_List_InvalidBucket(t *testing.T) {
+	client := newFileBucketClient(t, os.TempDir())
+	_, err := client.List(context.Background(), "NAMESPACE", &swfapi.BucketObjectTrigger{
+		Bucket: "not a bucket",
+	})
+	assert.NotNil(t, err)
+}
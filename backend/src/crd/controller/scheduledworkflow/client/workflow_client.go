@@ -27,6 +27,7 @@ import (
 	wraperror "github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/cache"
 )
@@ -144,6 +145,18 @@ func (p *WorkflowClient) Create(ctx context.Context, namespace string, workflow
 	return commonutil.NewWorkflow(result), nil
 }
 
+// Terminate terminates a workflow given a namespace and name, by setting its
+// activeDeadlineSeconds to 0.
+func (p *WorkflowClient) Terminate(ctx context.Context, namespace string, name string) error {
+	patch := []byte(`{"spec":{"activeDeadlineSeconds":0}}`)
+	_, err := p.clientSet.ArgoprojV1alpha1().Workflows(namespace).Patch(
+		ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return wraperror.Wrapf(err, "Error terminating workflow (%v) in namespace (%v): %v", name, namespace, err)
+	}
+	return nil
+}
+
 func getLabelSelectorToGetWorkflows(swfName string, completed bool, minIndex int64) *labels.Selector {
 	labelSelector := labels.NewSelector()
 	// The Argo workflow should be active or completed
@@ -15,10 +15,12 @@
 package client
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	workflowfake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	workflowcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
 	commonutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
@@ -217,3 +219,20 @@ func TestLabelSelectorToGetWorkflows(t *testing.T) {
 
 	assert.Equal(t, expected, *result)
 }
+
+func TestTerminate(t *testing.T) {
+	clientSet := workflowfake.NewSimpleClientset(&workflowapi.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "WORKFLOW_NAME", Namespace: "NAMESPACE"},
+	})
+	client := NewWorkflowClient(clientSet, nil)
+
+	err := client.Terminate(context.Background(), "NAMESPACE", "WORKFLOW_NAME")
+	assert.Nil(t, err)
+	workflow, err := clientSet.ArgoprojV1alpha1().Workflows("NAMESPACE").Get(
+		context.Background(), "WORKFLOW_NAME", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonutil.Int64Pointer(0), workflow.Spec.ActiveDeadlineSeconds)
+
+	err = client.Terminate(context.Background(), "NAMESPACE", "MISSING_WORKFLOW")
+	assert.NotNil(t, err)
+}
@@ -55,6 +55,9 @@ var (
 	DefaultJobBackOff = 10 * time.Second
 	// MaxJobBackOff is the max backoff period
 	MaxJobBackOff = 360 * time.Second
+	// BucketObjectPollInterval is the period at which the buckets of the
+	// bucket object triggers are listed for new objects
+	BucketObjectPollInterval = 60 * time.Second
 )
 
 // Controller is the controller implementation for ScheduledWorkflow resources
@@ -62,6 +65,7 @@ type Controller struct {
 	kubeClient     *client.KubeClient
 	swfClient      *client.ScheduledWorkflowClient
 	workflowClient *client.WorkflowClient
+	bucketClient   *client.BucketClient
 
 	// workqueue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -106,6 +110,7 @@ func NewController(
 		kubeClient:     client.NewKubeClient(kubeClientSet, recorder),
 		swfClient:      client.NewScheduledWorkflowClient(swfClientSet, swfInformer),
 		workflowClient: client.NewWorkflowClient(workflowClientSet, workflowInformer),
+		bucketClient:   client.NewBucketClient(kubeClientSet),
 		workqueue: workqueue.NewNamedRateLimitingQueue(
 			workqueue.NewItemExponentialFailureRateLimiter(DefaultJobBackOff, MaxJobBackOff), swfregister.Kind),
 		time:     time,
@@ -352,6 +357,10 @@ func (c *Controller) processNextWorkItem() bool {
 			// Success.
 			// Will resync after the SharedInformerFactory defaultResync delay.
 			c.workqueue.Forget(obj)
+			if swf != nil && swf.Spec.Enabled && swf.Spec.Trigger.BucketObjectTrigger != nil {
+				// Nothing notifies of the new objects of the bucket, poll it.
+				c.workqueue.AddAfter(obj, BucketObjectPollInterval)
+			}
 			if swf != nil {
 				c.kubeClient.RecordSyncSuccess(swf.Get(), "All done")
 			}
@@ -414,19 +423,43 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
 	}
 
-	submitted, nextScheduledEpoch, err := c.submitNextWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
+	backfilled, backfillEpoch, err := c.submitNextBackfillWorkflowIfNeeded(ctx, swf, len(active), nowEpoch)
 	if err != nil {
 		return false, true, swf,
-			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
+			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit backfill workflow: %v", name, err)
+	}
+
+	// A backfill workflow takes the place of the scheduled workflow in this sync.
+	submitted := false
+	var skipped *swfapi.SkippedTrigger
+	var object *util.BucketObject
+	nextScheduledEpoch, _ := swf.GetNextScheduledEpoch(int64(len(active)), nowEpoch, *c.location)
+	if swf.Spec.Trigger.BucketObjectTrigger != nil {
+		submitted, object, err = c.submitNextBucketObjectWorkflowIfNeeded(ctx, swf, active, nowEpoch)
+		if err != nil {
+			return false, true, swf,
+				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't submit workflow for bucket object: %v", name, err)
+		}
+		if submitted {
+			// The workflows of bucket objects are triggered now.
+			nextScheduledEpoch = nowEpoch
+		}
+	} else if !backfilled {
+		submitted, skipped, nextScheduledEpoch, err = c.submitNextWorkflowIfNeeded(ctx, swf, active, nowEpoch)
+		if err != nil {
+			return false, true, swf,
+				wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't fetch completed workflows: %v", name, err)
+		}
 	}
 
-	err = c.updateStatus(ctx, swf, submitted, active, completed, nextScheduledEpoch, nowEpoch)
+	err = c.updateStatus(ctx, swf, submitted, skipped, object, active, completed, nextScheduledEpoch, backfilled,
+		backfillEpoch, nowEpoch)
 	if err != nil {
 		return false, true, swf,
 			wraperror.Wrapf(err, "Syncing ScheduledWorkflow (%v): transient failure, can't update swf status: %v", name, err)
 	}
 
-	if submitted {
+	if submitted || skipped != nil || backfilled {
 		// Success. Since we created a new workflow, sync again soon since there might be one more
 		// resource to create.
 		log.WithFields(log.Fields{
@@ -443,25 +476,47 @@ func (c *Controller) syncHandler(ctx context.Context, key string) (
 }
 
 // Submits the next workflow if a workflow is due to execute. Returns the submitted workflow,
-// an error (if any), and a boolean indicating (in case of an error) whether handling the
-// ScheduledWorkflow should be attempted again at a later time.
+// the skipped scheduled time (if the concurrency policy skipped it), an error (if any), and
+// a boolean indicating (in case of an error) whether handling the ScheduledWorkflow should
+// be attempted again at a later time.
 func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
-	activeWorkflowCount int, nowEpoch int64) (
-	submitted bool, nextScheduledEpoch int64, err error) {
+	active []swfapi.WorkflowStatus, nowEpoch int64) (
+	submitted bool, skipped *swfapi.SkippedTrigger, nextScheduledEpoch int64, err error) {
 	// Compute the next scheduled time.
 	nextScheduledEpoch, shouldRunNow := swf.GetNextScheduledEpoch(
-		int64(activeWorkflowCount), nowEpoch, *c.location)
+		int64(len(active)), nowEpoch, *c.location)
 
 	if !shouldRunNow {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
 		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit (next scheduled at: %v)",
 			swf.Name, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-		return false, nextScheduledEpoch, nil
+		return false, nil, nextScheduledEpoch, nil
+	}
+
+	switch swf.ConcurrencyPolicy() {
+	case swfapi.SkipConcurrent:
+		if len(active) > 0 {
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Infof("Submitting workflow for ScheduledWorkflow (%v): skipped because %v workflow(s) still active (scheduled at: %v)",
+				swf.Name, len(active), commonutil.FormatTimeForLogging(nextScheduledEpoch))
+			return false, util.NewConcurrentSkippedTrigger(nextScheduledEpoch, active), nextScheduledEpoch, nil
+		}
+	case swfapi.ReplaceConcurrent:
+		err = c.terminateActiveWorkflows(ctx, swf, active)
+		if err != nil {
+			log.WithFields(log.Fields{
+				ScheduledWorkflow: swf.Name,
+			}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while replacing active workflows: %v",
+				swf.Name, err)
+			return false, nil, nextScheduledEpoch, err
+		}
 	}
 
 	var workflowName string
-	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf, nextScheduledEpoch, nowEpoch)
+	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf,
+		func() (*commonutil.Workflow, error) { return swf.NewWorkflow(nextScheduledEpoch, nowEpoch) })
 	if err != nil {
 		log.WithFields(log.Fields{
 			ScheduledWorkflow: swf.Name,
@@ -469,19 +524,119 @@ func (c *Controller) submitNextWorkflowIfNeeded(ctx context.Context, swf *util.S
 			swf.Name, err)
 		// There was an error submitting a new workflow.
 		// We should attempt to handle the schedule again at a later time.
-		return false, nextScheduledEpoch, err
+		return false, nil, nextScheduledEpoch, err
 	}
 	log.WithFields(log.Fields{
 		ScheduledWorkflow: swf.Name,
 		Workflow:          workflowName,
 	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
 		swf.Name, workflowName, commonutil.FormatTimeForLogging(nextScheduledEpoch))
-	return submitted, nextScheduledEpoch, nil
+	return submitted, nil, nextScheduledEpoch, nil
+}
+
+// Terminates the active workflows of the ScheduledWorkflow, so that the next workflow
+// replaces them. The next workflow itself is left alone, in case it was already submitted
+// by a previous iteration of this controller.
+func (c *Controller) terminateActiveWorkflows(ctx context.Context, swf *util.ScheduledWorkflow,
+	active []swfapi.WorkflowStatus) error {
+	nextWorkflowName := swf.NextResourceName()
+	for _, workflow := range active {
+		if workflow.Name == nextWorkflowName {
+			continue
+		}
+		err := c.workflowClient.Terminate(ctx, workflow.Namespace, workflow.Name)
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+			Workflow:          workflow.Name,
+		}).Infof("Replacing workflow for ScheduledWorkflow (%v): workflow (%v) terminated", swf.Name, workflow.Name)
+	}
+	return nil
+}
+
+// Submits the next backfill workflow if one is due. Returns whether a workflow was submitted
+// and the scheduled time of the next backfill workflow.
+func (c *Controller) submitNextBackfillWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
+	activeWorkflowCount int, nowEpoch int64) (
+	backfilled bool, backfillEpoch int64, err error) {
+	backfillEpoch, shouldRunNow := swf.GetNextBackfillEpoch(
+		int64(activeWorkflowCount), nowEpoch, *c.location)
+
+	if !shouldRunNow {
+		return false, backfillEpoch, nil
+	}
+
+	var workflowName string
+	backfilled, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf,
+		func() (*commonutil.Workflow, error) { return swf.NewWorkflow(backfillEpoch, nowEpoch) })
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting backfill workflow for ScheduledWorkflow (%v): transient error while submitting workflow: %v",
+			swf.Name, err)
+		return false, backfillEpoch, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflowName,
+	}).Infof("Submitting backfill workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted (scheduled at: %v)",
+		swf.Name, workflowName, commonutil.FormatTimeForLogging(backfillEpoch))
+	return backfilled, backfillEpoch, nil
+}
+
+// Submits a workflow for the bucket object trigger if a matching object of the bucket didn't
+// create a workflow yet. Returns whether a workflow was submitted and the object it was
+// submitted for.
+func (c *Controller) submitNextBucketObjectWorkflowIfNeeded(ctx context.Context, swf *util.ScheduledWorkflow,
+	active []swfapi.WorkflowStatus, nowEpoch int64) (
+	submitted bool, object *util.BucketObject, err error) {
+	if !swf.Spec.Enabled {
+		return false, nil, nil
+	}
+
+	objects, err := c.bucketClient.List(ctx, swf.Namespace, swf.Spec.Trigger.BucketObjectTrigger)
+	if err != nil {
+		return false, nil, err
+	}
+	object, shouldRunNow := swf.GetNextBucketObject(objects, int64(len(active)))
+	if !shouldRunNow {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Infof("Submitting workflow for ScheduledWorkflow (%v): nothing to submit for the bucket objects",
+			swf.Name)
+		return false, nil, nil
+	}
+
+	if swf.ConcurrencyPolicy() == swfapi.ReplaceConcurrent {
+		err = c.terminateActiveWorkflows(ctx, swf, active)
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
+	var workflowName string
+	submitted, workflowName, err = c.submitNewWorkflowIfNotAlreadySubmitted(ctx, swf,
+		func() (*commonutil.Workflow, error) { return swf.NewBucketObjectWorkflow(object, nowEpoch) })
+	if err != nil {
+		log.WithFields(log.Fields{
+			ScheduledWorkflow: swf.Name,
+		}).Errorf("Submitting workflow for ScheduledWorkflow (%v): transient error while submitting workflow for object (%v): %v",
+			swf.Name, object.URI, err)
+		return false, nil, err
+	}
+	log.WithFields(log.Fields{
+		ScheduledWorkflow: swf.Name,
+		Workflow:          workflowName,
+	}).Infof("Submitting workflow for ScheduledWorkflow (%v): workflow (%v) successfully submitted for object (%v)",
+		swf.Name, workflowName, object.URI)
+	return submitted, object, nil
 }
 
 func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	ctx context.Context,
-	swf *util.ScheduledWorkflow, nextScheduledEpoch int64, nowEpoch int64) (
+	swf *util.ScheduledWorkflow, newWorkflowFunc func() (*commonutil.Workflow, error)) (
 	bool, string, error) {
 
 	workflowName := swf.NextResourceName()
@@ -504,7 +659,10 @@ func (c *Controller) submitNewWorkflowIfNotAlreadySubmitted(
 	}
 
 	// If the workflow is not found, we need to create it.
-	newWorkflow, err := swf.NewWorkflow(nextScheduledEpoch, nowEpoch)
+	newWorkflow, err := newWorkflowFunc()
+	if err != nil {
+		return false, "", err
+	}
 	createdWorkflow, err := c.workflowClient.Create(ctx, swf.Namespace, newWorkflow)
 	if err != nil {
 		return false, "", err
@@ -516,15 +674,22 @@ func (c *Controller) updateStatus(
 	ctx context.Context,
 	swf *util.ScheduledWorkflow,
 	submitted bool,
+	skipped *swfapi.SkippedTrigger,
+	object *util.BucketObject,
 	active []swfapi.WorkflowStatus,
 	completed []swfapi.WorkflowStatus,
 	nextScheduledEpoch int64,
+	backfilled bool,
+	backfillEpoch int64,
 	nowEpoch int64) error {
 	// NEVER modify objects from the store. It's a read-only, local cache.
 	// You can use DeepCopy() to make a deep copy of original object and modify this copy
 	// Or create a copy manually for better performance
 	swfCopy := util.NewScheduledWorkflow(swf.Get().DeepCopy())
 	swfCopy.UpdateStatus(nowEpoch, submitted, nextScheduledEpoch, active, completed, c.location)
+	swfCopy.UpdateSkippedStatus(skipped, c.location)
+	swfCopy.UpdateBucketObjectStatus(object)
+	swfCopy.UpdateBackfillStatus(backfilled, backfillEpoch, c.location)
 
 	// Until #38113 is merged, we must use Update instead of UpdateStatus to
 	// update the Status block of the ScheduledWorkflow. UpdateStatus will not
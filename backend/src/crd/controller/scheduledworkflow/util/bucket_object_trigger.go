@@ -0,0 +1,115 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	log "github.com/sirupsen/logrus"
+)
+
+// BucketObject is an object of the bucket of a BucketObjectTrigger.
+type BucketObject struct {
+	// Key of the object, relative to the prefix of the bucket.
+	Key string
+
+	// URI of the object, such as "minio://mlpipeline/incoming/data.csv".
+	URI string
+
+	// Time at which the object was last modified.
+	ModTime time.Time
+}
+
+// BucketObjectTrigger is a type to help manipulate BucketObjectTrigger objects.
+type BucketObjectTrigger struct {
+	*swfapi.BucketObjectTrigger
+}
+
+// NewBucketObjectTrigger creates a new BucketObjectTrigger.
+func NewBucketObjectTrigger(trigger *swfapi.BucketObjectTrigger) *BucketObjectTrigger {
+	if trigger == nil {
+		log.Fatalf("The bucketObjectTrigger should never be nil")
+	}
+
+	return &BucketObjectTrigger{
+		trigger,
+	}
+}
+
+// Matches returns whether the key of an object matches the pattern of the
+// trigger.
+func (t *BucketObjectTrigger) Matches(key string) bool {
+	if t.Pattern == "" {
+		return true
+	}
+	matched, err := path.Match(t.Pattern, key)
+	return err == nil && matched
+}
+
+// ListPrefix returns the prefix of the keys of the objects that can match the
+// pattern of the trigger, relative to the prefix of the bucket, and whether
+// these objects are all at the top level of the prefix, i.e. whether the
+// rest of their keys doesn't contain "/".
+func (t *BucketObjectTrigger) ListPrefix() (prefix string, topLevel bool) {
+	if t.Pattern == "" {
+		// All the objects under the prefix of the bucket match.
+		return "", false
+	}
+	i := strings.IndexAny(t.Pattern, `*?[\`)
+	if i < 0 {
+		// The pattern is the key of an object.
+		return t.Pattern, !strings.Contains(t.Pattern, "/")
+	}
+	return t.Pattern[:i], !strings.Contains(t.Pattern[i:], "/")
+}
+
+// GetNextObject returns the object for which the next workflow should be
+// created: the least recently modified object that matches the pattern and
+// that comes after the last object that created a workflow according to the
+// status. Objects modified at the same second are ordered by key. Objects
+// modified before minTime are ignored. Returns nil if there is no such object.
+func (t *BucketObjectTrigger) GetNextObject(objects []BucketObject,
+	status *swfapi.BucketObjectTriggerStatus, minTime time.Time) *BucketObject {
+	var next *BucketObject
+	for i := range objects {
+		object := &objects[i]
+		// The modification times are compared to the second, like the
+		// creation time of the ScheduledWorkflow and the modification time
+		// of the last object in the status.
+		if !t.Matches(object.Key) || object.ModTime.Unix() < minTime.Unix() || !isAfterLastObject(object, status) {
+			continue
+		}
+		if next == nil || isBefore(object.ModTime.Unix(), object.Key, next.ModTime.Unix(), next.Key) {
+			next = object
+		}
+	}
+	return next
+}
+
+func isAfterLastObject(object *BucketObject, status *swfapi.BucketObjectTriggerStatus) bool {
+	if status == nil || status.LastObjectModTime == nil {
+		return true
+	}
+	return isBefore(status.LastObjectModTime.Unix(), status.LastObjectKey, object.ModTime.Unix(), object.Key)
+}
+
+// isBefore returns whether the object modified at epoch1 with key1 comes
+// before the object modified at epoch2 with key2.
+func isBefore(epoch1 int64, key1 string, epoch2 int64, key2 string) bool {
+	return epoch1 < epoch2 || (epoch1 == epoch2 && key1 < key2)
+}
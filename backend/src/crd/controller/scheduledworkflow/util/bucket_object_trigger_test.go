@@ -0,0 +1,127 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	swfapi "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createBucketObject(key string, modEpoch int64) BucketObject {
+	return BucketObject{
+		Key:     key,
+		URI:     "minio://mlpipeline/incoming/" + key,
+		ModTime: time.Unix(modEpoch, 0),
+	}
+}
+
+func createBucketObjectStatus(object BucketObject) *swfapi.BucketObjectTriggerStatus {
+	modTime := metav1.NewTime(time.Unix(object.ModTime.Unix(), 0).UTC())
+	return &swfapi.BucketObjectTriggerStatus{
+		LastObjectModTime: &modTime,
+		LastObjectKey:     object.Key,
+		LastObjectURI:     object.URI,
+	}
+}
+
+func TestBucketObjectTrigger_Matches(t *testing.T) {
+	trigger := NewBucketObjectTrigger(&swfapi.BucketObjectTrigger{})
+	assert.True(t, trigger.Matches("data.csv"))
+	assert.True(t, trigger.Matches("nested/data.csv"))
+
+	trigger.Pattern = "*.csv"
+	assert.True(t, trigger.Matches("data.csv"))
+	assert.False(t, trigger.Matches("data.json"))
+	assert.False(t, trigger.Matches("nested/data.csv"))
+
+	trigger.Pattern = "*/*.csv"
+	assert.True(t, trigger.Matches("nested/data.csv"))
+
+	// A malformed pattern matches nothing.
+	trigger.Pattern = "["
+	assert.False(t, trigger.Matches("data.csv"))
+}
+
+func TestBucketObjectTrigger_GetNextObject(t *testing.T) {
+	trigger := NewBucketObjectTrigger(&swfapi.BucketObjectTrigger{Pattern: "*.csv"})
+	objects := []BucketObject{
+		createBucketObject("old.csv", 5*minute),
+		createBucketObject("c.csv", 20*minute),
+		createBucketObject("b.csv", 10*minute),
+		createBucketObject("a.csv", 10*minute),
+		createBucketObject("a.json", 1*minute),
+	}
+	minTime := time.Unix(10*minute, 0)
+
+	// The least recently modified object first, ordered by key.
+	assert.Equal(t, &objects[3], trigger.GetNextObject(objects, nil, minTime))
+
+	status := createBucketObjectStatus(objects[3])
+	assert.Equal(t, &objects[2], trigger.GetNextObject(objects, status, minTime))
+
+	status = createBucketObjectStatus(objects[2])
+	assert.Equal(t, &objects[1], trigger.GetNextObject(objects, status, minTime))
+
+	status = createBucketObjectStatus(objects[1])
+	assert.Nil(t, trigger.GetNextObject(objects, status, minTime))
+
+	// An overwritten object creates another workflow.
+	objects[3].ModTime = time.Unix(30*minute, 0)
+	assert.Equal(t, &objects[3], trigger.GetNextObject(objects, status, minTime))
+}
+
+func TestBucketObjectTrigger_GetNextObject_SubSecondLastObject(t *testing.T) {
+	trigger := NewBucketObjectTrigger(&swfapi.BucketObjectTrigger{})
+	objects := []BucketObject{{Key: "a.csv", ModTime: time.Unix(10*minute, 500)}}
+
+	// The modification time of the last object is only kept to the second.
+	status := createBucketObjectStatus(objects[0])
+	assert.Nil(t, trigger.GetNextObject(objects, status, time.Unix(0, 0)))
+}
+
+func TestBucketObjectTrigger_GetNextObject_SubSecondModTime(t *testing.T) {
+	trigger := NewBucketObjectTrigger(&swfapi.BucketObjectTrigger{})
+	objects := []BucketObject{{Key: "a.csv", ModTime: time.Unix(10*minute, 500)}}
+
+	// The creation time of the schedule is only kept to the second.
+	assert.Equal(t, &objects[0], trigger.GetNextObject(objects, nil, time.Unix(10*minute, 0)))
+}
+
+func TestBucketObjectTrigger_ListPrefix(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		prefix   string
+		topLevel bool
+	}{
+		{"", "", false},
+		{"*.csv", "", true},
+		{"*/*.csv", "", false},
+		{"data-*.csv", "data-", true},
+		{"2022/data-?.csv", "2022/data-", true},
+		{"2022/*/data.csv", "2022/", false},
+		{"data.csv", "data.csv", true},
+		{"2022/data.csv", "2022/data.csv", false},
+		{`data\*.csv`, "data", true},
+	}
+	for _, tt := range tests {
+		prefix, topLevel := NewBucketObjectTrigger(&swfapi.BucketObjectTrigger{Pattern: tt.pattern}).ListPrefix()
+		assert.Equal(t, tt.prefix, prefix, tt.pattern)
+		assert.Equal(t, tt.topLevel, topLevel, tt.pattern)
+	}
+}
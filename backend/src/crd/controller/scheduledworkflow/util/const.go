@@ -23,6 +23,9 @@ import (
 const (
 	ControllerAgentName string = "scheduled-workflow-controller" // ControllerAgentName is the name of the controller.
 	TimeZone            string = "CRON_SCHEDULE_TIMEZONE"        // TimeZone is the name of the cron schedule timezone env parameter
+
+	// SkippedReasonSkipConcurrent is the reason of the scheduled times skipped by the Skip concurrency policy.
+	SkippedReasonSkipConcurrent string = "SkipConcurrent"
 )
 
 func GetLocation() (*time.Location, error) {
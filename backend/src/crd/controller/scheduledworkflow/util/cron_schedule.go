@@ -80,7 +80,24 @@ func (s *CronSchedule) getNextScheduledTime(lastJobTime time.Time, location *tim
 		true /* nowEpoch doesn't matter when catchup=true */, time.Unix(0, 0), location)
 }
 
+// GetLocation returns the location of the time zone of the schedule, or
+// defaultLocation if the schedule doesn't have a time zone.
+func (s *CronSchedule) GetLocation(defaultLocation *time.Location) *time.Location {
+	if s.TimeZone == "" {
+		return defaultLocation
+	}
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		// This should never happen, validation should have caught this at resource creation.
+		log.Errorf("%+v", wraperror.Errorf(
+			"Found invalid time zone (%v): %v", s.TimeZone, err))
+		return defaultLocation
+	}
+	return location
+}
+
 func (s *CronSchedule) getNextScheduledTimeImp(lastJobTime time.Time, catchup bool, nowTime time.Time, location *time.Location) time.Time {
+	location = s.GetLocation(location)
 	schedule, err := cron.Parse(s.Cron)
 	if err != nil {
 		// This should never happen, validation should have caught this at resource creation.
@@ -198,3 +198,36 @@ func TestCronSchedule_GetNextScheduledTimeNoCatchup(t *testing.T) {
 	assert.Equal(t, time.Unix(10*hour+15*minute+minute, 0).UTC(),
 		schedule.GetNextScheduledTimeNoCatchup(nil, defaultStartTime, time.Unix(0, 0), location))
 }
+
+func TestCronSchedule_GetNextScheduledTime_TimeZone(t *testing.T) {
+	schedule := NewCronSchedule(&swfapi.CronSchedule{
+		Cron:     "0 0 2 * * *", // trigger 02:00 every day
+		TimeZone: "Europe/Stockholm",
+	})
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	assert.Nil(t, err)
+
+	// The time zone of the schedule takes precedence over the default location.
+	// Winter time, UTC+1.
+	lastJobTime := v1.NewTime(time.Date(2023, 1, 10, 12, 0, 0, 0, time.UTC))
+	next := schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 1, 11, 1, 0, 0, 0, time.UTC).Unix(), next.Unix())
+	assert.Equal(t, stockholm, next.Location())
+
+	// Summer time, UTC+2.
+	lastJobTime = v1.NewTime(time.Date(2023, 3, 27, 12, 0, 0, 0, time.UTC))
+	next = schedule.GetNextScheduledTime(&lastJobTime, time.Unix(0, 0), time.UTC)
+	assert.Equal(t, time.Date(2023, 3, 28, 0, 0, 0, 0, time.UTC).Unix(), next.Unix())
+}
+
+func TestCronSchedule_GetLocation(t *testing.T) {
+	schedule := NewCronSchedule(&swfapi.CronSchedule{Cron: "0 0 2 * * *"})
+	assert.Equal(t, time.UTC, schedule.GetLocation(time.UTC))
+
+	schedule.TimeZone = "America/New_York"
+	assert.Equal(t, "America/New_York", schedule.GetLocation(time.UTC).String())
+
+	// Invalid time zones fall back on the default location.
+	schedule.TimeZone = "Invalid/Zone"
+	assert.Equal(t, time.UTC, schedule.GetLocation(time.UTC))
+}
@@ -67,6 +67,27 @@ func (s *PeriodicSchedule) getNextScheduledEpoch(lastJobEpoch int64) int64 {
 	return result
 }
 
+// getNextAlignedEpoch returns the first epoch after afterEpoch, and not after
+// the end time, among the epochs anchorEpoch + k * interval, for any integer
+// k. The workflows of the schedule are scheduled at these epochs, anchored at
+// the start time of the schedule or at the creation of the scheduled workflow.
+func (s *PeriodicSchedule) getNextAlignedEpoch(anchorEpoch int64, afterEpoch int64) int64 {
+	interval := s.getInterval()
+	// The number of intervals from the anchor to afterEpoch, rounded down.
+	intervals := (afterEpoch - anchorEpoch) / interval
+	if (afterEpoch-anchorEpoch)%interval < 0 {
+		intervals--
+	}
+	result := anchorEpoch + (intervals+1)*interval
+
+	if s.EndTime != nil &&
+		s.EndTime.Unix() < result {
+		return math.MaxInt64
+	}
+
+	return result
+}
+
 func (s *PeriodicSchedule) getInterval() int64 {
 	interval := s.IntervalSecond
 	if interval == 0 {
@@ -162,3 +162,19 @@ func TestPeriodicSchedule_TestStarttime(t *testing.T) {
 	val := schedule.getNextScheduledEpoch(lastJobEpoch)
 	assert.Equal(t, t1.Unix(), val)
 }
+
+func TestPeriodicSchedule_GetNextAlignedEpoch(t *testing.T) {
+	schedule := NewPeriodicSchedule(&swfapi.PeriodicSchedule{
+		EndTime:        commonutil.Metav1TimePointer(v1.NewTime(time.Unix(11*hour, 0).UTC())),
+		IntervalSecond: hour,
+	})
+	anchorEpoch := int64(5*hour + 15*minute)
+
+	// After and before the anchor.
+	assert.Equal(t, int64(7*hour+15*minute), schedule.getNextAlignedEpoch(anchorEpoch, 6*hour+15*minute))
+	assert.Equal(t, int64(7*hour+15*minute), schedule.getNextAlignedEpoch(anchorEpoch, 7*hour))
+	assert.Equal(t, int64(2*hour+15*minute), schedule.getNextAlignedEpoch(anchorEpoch, 2*hour))
+	assert.Equal(t, int64(2*hour+15*minute), schedule.getNextAlignedEpoch(anchorEpoch, hour+15*minute))
+	// After the end time.
+	assert.Equal(t, int64(math.MaxInt64), schedule.getNextAlignedEpoch(anchorEpoch, 10*hour+15*minute))
+}
@@ -20,6 +20,7 @@ import (
 	"math"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	workflowapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -64,6 +65,17 @@ func (s *ScheduledWorkflow) enabled() bool {
 	return s.Spec.Enabled
 }
 
+// ConcurrencyPolicy returns the concurrency policy of the schedule, which is
+// Allow if it isn't specified.
+func (s *ScheduledWorkflow) ConcurrencyPolicy() swfapi.ConcurrencyPolicy {
+	switch s.Spec.ConcurrencyPolicy {
+	case swfapi.ForbidConcurrent, swfapi.SkipConcurrent, swfapi.ReplaceConcurrent:
+		return s.Spec.ConcurrencyPolicy
+	default:
+		return swfapi.AllowConcurrent
+	}
+}
+
 func (s *ScheduledWorkflow) maxConcurrency() int64 {
 	if s.Spec.MaxConcurrency == nil {
 		return defaultMaxConcurrency
@@ -124,7 +136,9 @@ func (s *ScheduledWorkflow) MinIndex() int64 {
 
 func (s *ScheduledWorkflow) isOneOffRun() bool {
 	return s.Spec.Trigger.CronSchedule == nil &&
-		s.Spec.Trigger.PeriodicSchedule == nil
+		s.Spec.Trigger.PeriodicSchedule == nil &&
+		s.Spec.Trigger.BucketObjectTrigger == nil &&
+		s.Spec.Trigger.RunDependencyTrigger == nil
 }
 
 func (s *ScheduledWorkflow) nextResourceID() string {
@@ -153,6 +167,20 @@ func (s *ScheduledWorkflow) getWorkflowParametersAsMap() map[string]string {
 // the Schedule resource that 'owns' it.
 func (s *ScheduledWorkflow) NewWorkflow(
 	nextScheduledEpoch int64, nowEpoch int64) (*commonutil.Workflow, error) {
+	return s.newWorkflow(nextScheduledEpoch, nowEpoch, nil)
+}
+
+// NewBucketObjectWorkflow creates a workflow for an object of the bucket of
+// the BucketObjectTrigger. The URI of the object is passed to the workflow as
+// the parameter of the trigger, and the modification time of the object is
+// the scheduled time of the workflow.
+func (s *ScheduledWorkflow) NewBucketObjectWorkflow(
+	object *BucketObject, nowEpoch int64) (*commonutil.Workflow, error) {
+	return s.newWorkflow(object.ModTime.Unix(), nowEpoch, object)
+}
+
+func (s *ScheduledWorkflow) newWorkflow(
+	nextScheduledEpoch int64, nowEpoch int64, object *BucketObject) (*commonutil.Workflow, error) {
 
 	const (
 		workflowKind       = "Workflow"
@@ -181,6 +209,17 @@ func (s *ScheduledWorkflow) NewWorkflow(
 
 	// Set the parameters.
 	result.OverrideParameters(formattedParams)
+	if object != nil {
+		// The parameters of v2 pipelines are in the runtime config of the
+		// workflow instead.
+		objectParams := map[string]string{
+			s.Spec.Trigger.BucketObjectTrigger.ParameterName: object.URI,
+		}
+		result.OverrideParameters(objectParams)
+		if err := result.OverrideRuntimeConfigParameters(objectParams); err != nil {
+			return nil, err
+		}
+	}
 
 	result.SetCannonicalLabels(s.Name, nextScheduledEpoch, s.nextIndex())
 	result.SetLabels(commonutil.LabelKeyWorkflowRunId, uuid.String())
@@ -198,7 +237,9 @@ func (s *ScheduledWorkflow) NewWorkflow(
 }
 
 // GetNextScheduledEpoch returns the next epoch at which a workflow should be scheduled,
-// and whether it should be run now.
+// and whether it should be run now. With the Skip and Replace concurrency policies, the
+// workflow should run now regardless of the active workflows, which the caller then skips
+// or replaces.
 func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, nowEpoch int64, location time.Location) (
 	nextScheduleEpoch int64, shouldRunNow bool) {
 
@@ -211,8 +252,15 @@ func (s *ScheduledWorkflow) GetNextScheduledEpoch(activeWorkflowCount int64, now
 	}
 
 	// If the maxConcurrency is exceeded, return.
-	if activeWorkflowCount >= s.maxConcurrency() {
-		return nextScheduledEpoch, false
+	switch s.ConcurrencyPolicy() {
+	case swfapi.AllowConcurrent:
+		if activeWorkflowCount >= s.maxConcurrency() {
+			return nextScheduledEpoch, false
+		}
+	case swfapi.ForbidConcurrent:
+		if activeWorkflowCount > 0 {
+			return nextScheduledEpoch, false
+		}
 	}
 
 	// If it is not yet time to schedule the next workflow...
@@ -256,9 +304,134 @@ func (s *ScheduledWorkflow) getNextScheduledEpoch(nowEpoch int64, location time.
 			time.Unix(s.creationEpoch(), 0).In(&location), nowTime, &location).Unix()
 	}
 
+	// The workflows of a bucket object trigger or of a run dependency trigger
+	// aren't scheduled in time.
+	if s.Spec.Trigger.BucketObjectTrigger != nil || s.Spec.Trigger.RunDependencyTrigger != nil {
+		return math.MaxInt64
+	}
+
 	return s.getNextScheduledEpochForOneTimeRun()
 }
 
+// GetNextBucketObject returns the object of the bucket of the BucketObjectTrigger
+// for which the next workflow should be created, and whether it should be
+// created now. Objects are never skipped: unless the concurrency policy is
+// Replace, the workflow of an object waits for the active workflows, and the
+// Skip policy waits like the Forbid one. Objects modified before the creation
+// of the schedule don't create any workflow.
+func (s *ScheduledWorkflow) GetNextBucketObject(objects []BucketObject, activeWorkflowCount int64) (
+	object *BucketObject, shouldRunNow bool) {
+	if s.Spec.Trigger.BucketObjectTrigger == nil {
+		return nil, false
+	}
+
+	object = NewBucketObjectTrigger(s.Spec.Trigger.BucketObjectTrigger).GetNextObject(
+		objects, s.Status.Trigger.BucketObject, time.Unix(s.creationEpoch(), 0))
+	if object == nil || !s.enabled() {
+		return object, false
+	}
+
+	switch s.ConcurrencyPolicy() {
+	case swfapi.ForbidConcurrent, swfapi.SkipConcurrent:
+		if activeWorkflowCount > 0 {
+			return object, false
+		}
+	case swfapi.AllowConcurrent:
+		if activeWorkflowCount >= s.maxConcurrency() {
+			return object, false
+		}
+	}
+	return object, true
+}
+
+// GetNextBackfillEpoch returns the scheduled epoch of the next workflow to
+// create for the backfill of the schedule, and whether it should be created
+// now. Unlike the scheduled workflows, the backfill isn't paused when the
+// schedule is disabled.
+func (s *ScheduledWorkflow) GetNextBackfillEpoch(activeWorkflowCount int64, nowEpoch int64, location time.Location) (
+	nextBackfillEpoch int64, shouldRunNow bool) {
+	if s.Spec.Backfill == nil {
+		return math.MaxInt64, false
+	}
+
+	nextBackfillEpoch = s.getNextBackfillEpoch(s.currentBackfillStatus(), location)
+	if nextBackfillEpoch == math.MaxInt64 {
+		return nextBackfillEpoch, false
+	}
+
+	// If the maxConcurrency is exceeded, return.
+	if activeWorkflowCount >= s.maxConcurrency() {
+		return nextBackfillEpoch, false
+	}
+
+	// If it is not yet time to backfill the next workflow...
+	if nextBackfillEpoch > nowEpoch {
+		return nextBackfillEpoch, false
+	}
+
+	return nextBackfillEpoch, true
+}
+
+// currentBackfillStatus returns a copy of the status of the backfill of the
+// spec. The status starts over when the backfill of the spec changes.
+func (s *ScheduledWorkflow) currentBackfillStatus() *swfapi.BackfillStatus {
+	backfill := s.Spec.Backfill
+	status := s.Status.Trigger.Backfill
+	if status != nil &&
+		status.StartTime.Equal(&backfill.StartTime) &&
+		status.EndTime.Equal(&backfill.EndTime) {
+		return status.DeepCopy()
+	}
+	return &swfapi.BackfillStatus{
+		StartTime: backfill.StartTime,
+		EndTime:   backfill.EndTime,
+	}
+}
+
+func (s *ScheduledWorkflow) getNextBackfillEpoch(status *swfapi.BackfillStatus, location time.Location) int64 {
+	if status.Completed {
+		return math.MaxInt64
+	}
+
+	// The start time itself is backfilled.
+	lastEpoch := status.StartTime.Unix() - 1
+	if status.LastTriggeredTime != nil {
+		lastEpoch = status.LastTriggeredTime.Unix()
+	}
+	endTime := status.EndTime
+
+	// Periodic schedule, the backfilled workflows are scheduled at the same
+	// epochs as the workflows of the schedule.
+	if s.Spec.Trigger.PeriodicSchedule != nil {
+		anchorEpoch := s.creationEpoch()
+		if s.Spec.Trigger.PeriodicSchedule.StartTime != nil {
+			anchorEpoch = s.Spec.Trigger.PeriodicSchedule.StartTime.Unix()
+		}
+		schedule := NewPeriodicSchedule(&swfapi.PeriodicSchedule{
+			EndTime:        &endTime,
+			IntervalSecond: s.Spec.Trigger.PeriodicSchedule.IntervalSecond,
+		})
+		return schedule.getNextAlignedEpoch(anchorEpoch, lastEpoch)
+	}
+
+	// Cron schedule
+	if s.Spec.Trigger.CronSchedule != nil {
+		schedule := NewCronSchedule(&swfapi.CronSchedule{
+			EndTime:  &endTime,
+			Cron:     s.Spec.Trigger.CronSchedule.Cron,
+			TimeZone: s.Spec.Trigger.CronSchedule.TimeZone,
+		})
+		next := schedule.getNextScheduledTime(time.Unix(lastEpoch, 0), &location)
+		if next.Equal(maxTime) {
+			return math.MaxInt64
+		}
+		return next.Unix()
+	}
+
+	// A one-off run has nothing to backfill.
+	return math.MaxInt64
+}
+
 func (s *ScheduledWorkflow) getNextScheduledEpochForOneTimeRun() int64 {
 	if s.Status.Trigger.LastTriggeredTime != nil {
 		return math.MaxInt64
@@ -319,23 +492,105 @@ func (s *ScheduledWorkflow) UpdateStatus(updatedEpoch int64, submitted bool,
 		s.updateLastTriggeredTime(scheduledEpoch)
 		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
 		nextTriggerTime := s.getNextScheduledEpoch(0, *location)
-		s.updateNextTriggeredTime(nextTriggerTime)
+		s.updateNextTriggeredTime(nextTriggerTime, location)
 	} else {
 		// LastTriggeredTime is unchanged.
-		s.updateNextTriggeredTime(scheduledEpoch)
+		s.updateNextTriggeredTime(scheduledEpoch, location)
 		// LastIndex is unchanged
 	}
 }
 
+// NewConcurrentSkippedTrigger returns the record of a scheduled time that is
+// skipped by the Skip concurrency policy because of the active workflows.
+func NewConcurrentSkippedTrigger(scheduledEpoch int64, active []swfapi.WorkflowStatus) *swfapi.SkippedTrigger {
+	names := make([]string, 0, len(active))
+	for _, workflow := range active {
+		names = append(names, workflow.Name)
+	}
+	return &swfapi.SkippedTrigger{
+		ScheduledAt: metav1.NewTime(time.Unix(scheduledEpoch, 0).UTC()),
+		Reason:      SkippedReasonSkipConcurrent,
+		Message: fmt.Sprintf("The concurrency policy is Skip and workflows are still active: %v.",
+			strings.Join(names, ", ")),
+	}
+}
+
+// UpdateSkippedStatus records that no workflow was created for the scheduled
+// time of skipped, so that the schedule moves on to the next scheduled time.
+func (s *ScheduledWorkflow) UpdateSkippedStatus(skipped *swfapi.SkippedTrigger, location *time.Location) {
+	if skipped == nil {
+		return
+	}
+
+	s.updateLastTriggeredTime(skipped.ScheduledAt.Unix())
+	s.Status.Trigger.Skipped = append([]swfapi.SkippedTrigger{*skipped}, s.Status.Trigger.Skipped...)
+	if int64(len(s.Status.Trigger.Skipped)) > s.maxHistory() {
+		s.Status.Trigger.Skipped = s.Status.Trigger.Skipped[:s.maxHistory()]
+	}
+	s.updateNextTriggeredTime(s.getNextScheduledEpoch(0, *location), location)
+}
+
+// UpdateBucketObjectStatus records that a workflow was created for the object
+// of the bucket of the BucketObjectTrigger, so that neither the object nor the
+// objects modified before it create any other workflow. Only the last object is
+// kept, so that the status doesn't grow with the objects of the bucket.
+func (s *ScheduledWorkflow) UpdateBucketObjectStatus(object *BucketObject) {
+	if object == nil {
+		return
+	}
+
+	status := s.Status.Trigger.BucketObject
+	if status == nil {
+		status = &swfapi.BucketObjectTriggerStatus{}
+	}
+	modTime := metav1.NewTime(time.Unix(object.ModTime.Unix(), 0).UTC())
+	status.LastObjectModTime = &modTime
+	status.LastObjectKey = object.Key
+	status.LastObjectURI = object.URI
+	status.TriggeredCount++
+	s.Status.Trigger.BucketObject = status
+}
+
+// UpdateBackfillStatus updates the progress of the backfill of the schedule.
+// If backfilled is true, the workflow scheduled at backfillEpoch was created.
+func (s *ScheduledWorkflow) UpdateBackfillStatus(backfilled bool, backfillEpoch int64, location *time.Location) {
+	if s.Spec.Backfill == nil {
+		return
+	}
+
+	status := s.currentBackfillStatus()
+	if backfilled {
+		status.LastTriggeredTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(time.Unix(backfillEpoch, 0).UTC()))
+		status.TriggeredCount++
+		s.Status.Trigger.LastIndex = commonutil.Int64Pointer(s.nextIndex())
+	}
+
+	nextBackfillEpoch := s.getNextBackfillEpoch(status, *location)
+	if nextBackfillEpoch == math.MaxInt64 {
+		status.Completed = true
+		status.NextTriggeredTime = nil
+	} else {
+		status.NextTriggeredTime = commonutil.Metav1TimePointer(
+			metav1.NewTime(time.Unix(nextBackfillEpoch, 0).UTC()))
+	}
+	s.Status.Trigger.Backfill = status
+}
+
 func (s *ScheduledWorkflow) updateLastTriggeredTime(epoch int64) {
 	s.Status.Trigger.LastTriggeredTime = commonutil.Metav1TimePointer(
 		metav1.NewTime(time.Unix(epoch, 0).UTC()))
 }
 
-func (s *ScheduledWorkflow) updateNextTriggeredTime(epoch int64) {
+func (s *ScheduledWorkflow) updateNextTriggeredTime(epoch int64, location *time.Location) {
+	s.Status.Trigger.NextTriggeredTimeInZone = ""
 	if epoch < maxTime.Unix() {
 		s.Status.Trigger.NextTriggeredTime = commonutil.Metav1TimePointer(
 			metav1.NewTime(time.Unix(epoch, 0).UTC()))
+		if cronSchedule := s.Spec.Trigger.CronSchedule; cronSchedule != nil && cronSchedule.TimeZone != "" {
+			s.Status.Trigger.NextTriggeredTimeInZone = time.Unix(epoch, 0).
+				In(NewCronSchedule(cronSchedule).GetLocation(location)).Format(time.RFC3339)
+		}
 	} else {
 		s.Status.Trigger.NextTriggeredTime = nil
 	}
@@ -15,6 +15,8 @@
 package util
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"strconv"
 	"testing"
@@ -51,6 +53,112 @@ func TestScheduledWorkflow_maxConcurrency(t *testing.T) {
 	assert.Equal(t, int64(10), schedule.maxConcurrency())
 }
 
+func TestScheduledWorkflow_ConcurrencyPolicy(t *testing.T) {
+	// nil
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{})
+	assert.Equal(t, swfapi.AllowConcurrent, schedule.ConcurrencyPolicy())
+
+	// unknown
+	schedule.Spec.ConcurrencyPolicy = "Unknown"
+	assert.Equal(t, swfapi.AllowConcurrent, schedule.ConcurrencyPolicy())
+
+	schedule.Spec.ConcurrencyPolicy = swfapi.ForbidConcurrent
+	assert.Equal(t, swfapi.ForbidConcurrent, schedule.ConcurrencyPolicy())
+
+	schedule.Spec.ConcurrencyPolicy = swfapi.SkipConcurrent
+	assert.Equal(t, swfapi.SkipConcurrent, schedule.ConcurrencyPolicy())
+
+	schedule.Spec.ConcurrencyPolicy = swfapi.ReplaceConcurrent
+	assert.Equal(t, swfapi.ReplaceConcurrent, schedule.ConcurrencyPolicy())
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_ConcurrencyPolicy(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(1)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(60),
+				},
+			},
+		},
+	})
+
+	// Allow waits for the active workflow.
+	nextScheduledEpoch, mustRunNow := schedule.GetNextScheduledEpoch(
+		int64(1) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+
+	// Forbid waits for the active workflow, even below MaxConcurrency.
+	schedule.Spec.MaxConcurrency = commonutil.Int64Pointer(int64(10))
+	schedule.Spec.ConcurrencyPolicy = swfapi.ForbidConcurrent
+	nextScheduledEpoch, mustRunNow = schedule.GetNextScheduledEpoch(
+		int64(1) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+	nextScheduledEpoch, mustRunNow = schedule.GetNextScheduledEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+
+	// Skip and Replace leave the active workflows to the caller.
+	for _, policy := range []swfapi.ConcurrencyPolicy{swfapi.SkipConcurrent, swfapi.ReplaceConcurrent} {
+		schedule.Spec.ConcurrencyPolicy = policy
+		nextScheduledEpoch, mustRunNow = schedule.GetNextScheduledEpoch(
+			int64(1) /* active workflow count */, nowEpoch, time.Location{})
+		assert.Equal(t, true, mustRunNow)
+		assert.Equal(t, int64(9*hour+minute), nextScheduledEpoch)
+	}
+}
+
+func TestScheduledWorkflow_UpdateSkippedStatus(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:           true,
+			MaxHistory:        commonutil.Int64Pointer(int64(1)),
+			ConcurrencyPolicy: swfapi.SkipConcurrent,
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(60),
+				},
+			},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastIndex: commonutil.Int64Pointer(3),
+			},
+		},
+	})
+	active := []swfapi.WorkflowStatus{*createStatus("WORKFLOW1", 5), *createStatus("WORKFLOW2", 6)}
+
+	schedule.UpdateSkippedStatus(nil, &time.Location{})
+	assert.Nil(t, schedule.Status.Trigger.LastTriggeredTime)
+
+	schedule.UpdateSkippedStatus(NewConcurrentSkippedTrigger(9*hour+minute, active), &time.Location{})
+	schedule.UpdateSkippedStatus(NewConcurrentSkippedTrigger(9*hour+2*minute, active), &time.Location{})
+	assert.Equal(t, swfapi.TriggerStatus{
+		LastTriggeredTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour+2*minute, 0).UTC())),
+		NextTriggeredTime: commonutil.Metav1TimePointer(metav1.NewTime(time.Unix(9*hour+3*minute, 0).UTC())),
+		// The index is unchanged, since no workflow was created.
+		LastIndex: commonutil.Int64Pointer(3),
+		// Only MaxHistory skipped scheduled times are kept.
+		Skipped: []swfapi.SkippedTrigger{{
+			ScheduledAt: metav1.NewTime(time.Unix(9*hour+2*minute, 0).UTC()),
+			Reason:      SkippedReasonSkipConcurrent,
+			Message:     "The concurrency policy is Skip and workflows are still active: WORKFLOW1, WORKFLOW2.",
+		}},
+	}, schedule.Status.Trigger)
+}
+
 func TestScheduledWorkflow_maxHistory(t *testing.T) {
 	// nil
 	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{})
@@ -663,6 +771,217 @@ func TestScheduledWorkflow_GetNextScheduledEpoch_UpdateStatus_WithWorkflow(t *te
 	assert.Equal(t, expected, schedule.Get())
 }
 
+func TestScheduledWorkflow_UpdateStatus_CronScheduleTimeZone(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron:     "0 0 2 * * *", // trigger 02:00 every day
+					TimeZone: "Europe/Stockholm",
+				},
+			},
+		},
+	})
+	scheduledTime := time.Date(2023, 1, 11, 1, 0, 0, 0, time.UTC)
+
+	schedule.UpdateStatus(scheduledTime.Unix()-hour, false, /* no workflow created during this run */
+		scheduledTime.Unix(), []swfapi.WorkflowStatus{}, []swfapi.WorkflowStatus{}, time.UTC)
+	assert.Equal(t, commonutil.Metav1TimePointer(metav1.NewTime(scheduledTime)),
+		schedule.Status.Trigger.NextTriggeredTime)
+	assert.Equal(t, "2023-01-11T02:00:00+01:00", schedule.Status.Trigger.NextTriggeredTimeInZone)
+
+	// Not set without the time zone.
+	schedule.Spec.Trigger.CronSchedule.TimeZone = ""
+	schedule.UpdateStatus(scheduledTime.Unix()-hour, false, /* no workflow created during this run */
+		scheduledTime.Unix(), []swfapi.WorkflowStatus{}, []swfapi.WorkflowStatus{}, time.UTC)
+	assert.Equal(t, "", schedule.Status.Trigger.NextTriggeredTimeInZone)
+}
+
+func TestScheduledWorkflow_GetNextBackfillEpoch_PeriodicSchedule(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        false,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(hour),
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(3*hour, 0).UTC()),
+			},
+		},
+	})
+
+	// Must run now, even though the schedule is disabled
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(9) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+
+	// Cannot run because of concurrency
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(10) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(2*hour), nextBackfillEpoch)
+
+	// The end time is inclusive
+	schedule.UpdateBackfillStatus(true, 2*hour, &time.Location{})
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(9) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(3*hour), nextBackfillEpoch)
+
+	// Done
+	schedule.UpdateBackfillStatus(true, 3*hour, &time.Location{})
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextBackfillEpoch)
+}
+
+func TestScheduledWorkflow_GetNextBackfillEpoch_PeriodicScheduleAlignment(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour+15*minute, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(hour),
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(4*hour, 0).UTC()),
+			},
+		},
+	})
+
+	// Without a start time, the schedule is anchored at the creation time.
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour+15*minute), nextBackfillEpoch)
+
+	schedule.UpdateBackfillStatus(true, 2*hour+15*minute, &time.Location{})
+	nextBackfillEpoch, _ = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, int64(3*hour+15*minute), nextBackfillEpoch)
+
+	// The next epoch is after the end time.
+	schedule.UpdateBackfillStatus(true, 3*hour+15*minute, &time.Location{})
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextBackfillEpoch)
+
+	// The start time of the schedule anchors it, even if it is after the
+	// backfill.
+	startTime := metav1.NewTime(time.Unix(5*hour+30*minute, 0).UTC())
+	schedule.Spec.Trigger.PeriodicSchedule.StartTime = &startTime
+	schedule.Spec.Backfill.StartTime = metav1.NewTime(time.Unix(2*hour+30*minute, 0).UTC())
+	nextBackfillEpoch, _ = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, time.Location{})
+	assert.Equal(t, int64(2*hour+30*minute), nextBackfillEpoch)
+}
+
+func TestScheduledWorkflow_GetNextBackfillEpoch_CronSchedule(t *testing.T) {
+	nowEpoch := int64(10 * hour)
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(10)),
+			Trigger: swfapi.Trigger{
+				CronSchedule: &swfapi.CronSchedule{
+					Cron: "0 30 * * * *", // at minute 30 of every hour
+				},
+			},
+			Backfill: &swfapi.Backfill{
+				StartTime: metav1.NewTime(time.Unix(2*hour, 0).UTC()),
+				EndTime:   metav1.NewTime(time.Unix(12*hour, 0).UTC()),
+			},
+		},
+	})
+
+	nextBackfillEpoch, mustRunNow := schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, true, mustRunNow)
+	assert.Equal(t, int64(2*hour+30*minute), nextBackfillEpoch)
+
+	// Must run later
+	schedule.UpdateBackfillStatus(true, 9*hour+30*minute, time.UTC)
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(10*hour+30*minute), nextBackfillEpoch)
+
+	// No backfill
+	schedule.Spec.Backfill = nil
+	nextBackfillEpoch, mustRunNow = schedule.GetNextBackfillEpoch(
+		int64(0) /* active workflow count */, nowEpoch, *time.UTC)
+	assert.Equal(t, false, mustRunNow)
+	assert.Equal(t, int64(math.MaxInt64), nextBackfillEpoch)
+}
+
+func TestScheduledWorkflow_UpdateBackfillStatus(t *testing.T) {
+	startTime := metav1.NewTime(time.Unix(2*hour, 0).UTC())
+	endTime := metav1.NewTime(time.Unix(3*hour, 0).UTC())
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Trigger: swfapi.Trigger{
+				PeriodicSchedule: &swfapi.PeriodicSchedule{
+					IntervalSecond: int64(hour),
+				},
+			},
+			Backfill: &swfapi.Backfill{StartTime: startTime, EndTime: endTime},
+		},
+		Status: swfapi.ScheduledWorkflowStatus{
+			Trigger: swfapi.TriggerStatus{
+				LastIndex: commonutil.Int64Pointer(4),
+			},
+		},
+	})
+
+	schedule.UpdateBackfillStatus(false, 2*hour, &time.Location{})
+	assert.Equal(t, &swfapi.BackfillStatus{
+		StartTime:         startTime,
+		EndTime:           endTime,
+		NextTriggeredTime: commonutil.Metav1TimePointer(startTime),
+	}, schedule.Status.Trigger.Backfill)
+	assert.Equal(t, int64(4), schedule.lastIndex())
+
+	schedule.UpdateBackfillStatus(true, 2*hour, &time.Location{})
+	schedule.UpdateBackfillStatus(true, 3*hour, &time.Location{})
+	assert.Equal(t, &swfapi.BackfillStatus{
+		StartTime:         startTime,
+		EndTime:           endTime,
+		LastTriggeredTime: commonutil.Metav1TimePointer(endTime),
+		TriggeredCount:    2,
+		Completed:         true,
+	}, schedule.Status.Trigger.Backfill)
+	assert.Equal(t, int64(6), schedule.lastIndex())
+
+	// A new backfill starts over.
+	newEndTime := metav1.NewTime(time.Unix(4*hour, 0).UTC())
+	schedule.Spec.Backfill = &swfapi.Backfill{StartTime: startTime, EndTime: newEndTime}
+	schedule.UpdateBackfillStatus(false, 0, &time.Location{})
+	assert.Equal(t, &swfapi.BackfillStatus{
+		StartTime:         startTime,
+		EndTime:           newEndTime,
+		NextTriggeredTime: commonutil.Metav1TimePointer(startTime),
+	}, schedule.Status.Trigger.Backfill)
+}
+
 func TestScheduledWorkflow_NewWorkflow(t *testing.T) {
 	// Must run now
 	scheduledEpoch := int64(10 * hour)
@@ -817,3 +1136,214 @@ func TestScheduledWorkflow_NewWorkflow_Parameterized(t *testing.T) {
 
 	assert.Equal(t, expected, result.Get())
 }
+
+func TestScheduledWorkflow_GetNextBucketObject(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(2)),
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{
+					Bucket:        "minio://mlpipeline/incoming",
+					ParameterName: "PARAM1",
+				},
+			},
+		},
+	})
+	objects := []BucketObject{
+		// Modified before the creation of the schedule.
+		createBucketObject("a.csv", 8*hour),
+		createBucketObject("b.csv", 10*hour),
+	}
+
+	object, shouldRunNow := schedule.GetNextBucketObject(objects, 1)
+	assert.Equal(t, &objects[1], object)
+	assert.True(t, shouldRunNow)
+
+	// Allow waits for the active workflows to be below MaxConcurrency.
+	_, shouldRunNow = schedule.GetNextBucketObject(objects, 2)
+	assert.False(t, shouldRunNow)
+
+	// Forbid and Skip wait for all the active workflows.
+	for _, policy := range []swfapi.ConcurrencyPolicy{swfapi.ForbidConcurrent, swfapi.SkipConcurrent} {
+		schedule.Spec.ConcurrencyPolicy = policy
+		_, shouldRunNow = schedule.GetNextBucketObject(objects, 1)
+		assert.False(t, shouldRunNow)
+	}
+
+	// Replace doesn't wait.
+	schedule.Spec.ConcurrencyPolicy = swfapi.ReplaceConcurrent
+	_, shouldRunNow = schedule.GetNextBucketObject(objects, 5)
+	assert.True(t, shouldRunNow)
+
+	schedule.Spec.Enabled = false
+	_, shouldRunNow = schedule.GetNextBucketObject(objects, 0)
+	assert.False(t, shouldRunNow)
+
+	// The scheduled times of a bucket object trigger never come.
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 10*hour, time.Location{})
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+	assert.False(t, schedule.isOneOffRun())
+}
+
+func TestScheduledWorkflow_GetNextScheduledEpoch_RunDependencyTrigger(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled:        true,
+			MaxConcurrency: commonutil.Int64Pointer(int64(2)),
+			Trigger: swfapi.Trigger{
+				RunDependencyTrigger: &swfapi.RunDependencyTrigger{
+					UpstreamType:  "Job",
+					UpstreamID:    "123e4567-e89b-12d3-a456-426655440000",
+					ParameterName: "PARAM1",
+				},
+			},
+		},
+	})
+
+	// The workflows of a run dependency trigger are created by the API server.
+	nextScheduledEpoch, shouldRunNow := schedule.GetNextScheduledEpoch(0, 10*hour, time.Location{})
+	assert.Equal(t, int64(math.MaxInt64), nextScheduledEpoch)
+	assert.False(t, shouldRunNow)
+	assert.False(t, schedule.isOneOffRun())
+}
+
+func TestScheduledWorkflow_UpdateBucketObjectStatus(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{})
+
+	schedule.UpdateBucketObjectStatus(nil)
+	assert.Nil(t, schedule.Status.Trigger.BucketObject)
+
+	objects := []BucketObject{
+		createBucketObject("a.csv", 10*hour),
+		createBucketObject("b.csv", 10*hour),
+	}
+	schedule.UpdateBucketObjectStatus(&objects[0])
+	schedule.UpdateBucketObjectStatus(&objects[1])
+	modTime := metav1.NewTime(time.Unix(10*hour, 0).UTC())
+	assert.Equal(t, &swfapi.BucketObjectTriggerStatus{
+		LastObjectModTime: &modTime,
+		LastObjectKey:     "b.csv",
+		LastObjectURI:     "minio://mlpipeline/incoming/b.csv",
+		TriggeredCount:    2,
+	}, schedule.Status.Trigger.BucketObject)
+}
+
+func TestScheduledWorkflow_UpdateBucketObjectStatus_Bounded(t *testing.T) {
+	schedule := NewScheduledWorkflow(&swfapi.ScheduledWorkflow{
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{Pattern: "*.csv"},
+			},
+		},
+	})
+
+	// The objects are never deleted from the bucket.
+	var objects []BucketObject
+	for i := 0; i < 1000; i++ {
+		objects = append(objects, createBucketObject(fmt.Sprintf("%04d.csv", i), int64(i)))
+	}
+	for range objects {
+		object, shouldRunNow := schedule.GetNextBucketObject(objects, 0)
+		assert.True(t, shouldRunNow)
+		schedule.UpdateBucketObjectStatus(object)
+
+		// The status only holds the last object.
+		status, err := json.Marshal(schedule.Status.Trigger.BucketObject)
+		assert.Nil(t, err)
+		assert.True(t, len(status) < 200, string(status))
+	}
+	object, _ := schedule.GetNextBucketObject(objects, 0)
+	assert.Nil(t, object)
+	assert.Equal(t, int64(len(objects)), schedule.Status.Trigger.BucketObject.TriggeredCount)
+}
+
+func TestScheduledWorkflow_NewBucketObjectWorkflow(t *testing.T) {
+	nowEpoch := int64(11 * hour)
+	schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "SCHEDULE1",
+			CreationTimestamp: metav1.NewTime(time.Unix(9*hour, 0).UTC()),
+		},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{
+					Bucket:        "minio://mlpipeline/incoming",
+					ParameterName: "PARAM2",
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Parameters: []swfapi.Parameter{
+					{Name: "PARAM1", Value: "NEW_VALUE1"},
+				},
+				Spec: workflowapi.WorkflowSpec{
+					Arguments: workflowapi.Arguments{
+						Parameters: []workflowapi.Parameter{
+							{Name: "PARAM1", Value: workflowapi.AnyStringPtr("VALUE1")},
+							{Name: "PARAM2", Value: workflowapi.AnyStringPtr("VALUE2")},
+						},
+					},
+				},
+			},
+		},
+	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+	object := createBucketObject("data.csv", 10*hour)
+
+	result, err := schedule.NewBucketObjectWorkflow(&object, nowEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Parameter{
+		{Name: "PARAM1", Value: workflowapi.AnyStringPtr("NEW_VALUE1")},
+		{Name: "PARAM2", Value: workflowapi.AnyStringPtr("minio://mlpipeline/incoming/data.csv")},
+	}, result.Spec.Arguments.Parameters)
+	assert.Equal(t, strconv.Itoa(10*hour), result.Labels[commonutil.LabelKeyWorkflowEpoch])
+}
+
+func TestScheduledWorkflow_NewBucketObjectWorkflow_V2(t *testing.T) {
+	schedule := ScheduledWorkflow{&swfapi.ScheduledWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "SCHEDULE1"},
+		Spec: swfapi.ScheduledWorkflowSpec{
+			Enabled: true,
+			Trigger: swfapi.Trigger{
+				BucketObjectTrigger: &swfapi.BucketObjectTrigger{
+					Bucket:        "minio://mlpipeline/incoming",
+					ParameterName: "input",
+				},
+			},
+			Workflow: &swfapi.WorkflowResource{
+				Spec: workflowapi.WorkflowSpec{
+					Templates: []workflowapi.Template{{
+						Name: "entrypoint",
+						DAG: &workflowapi.DAGTemplate{
+							Tasks: []workflowapi.DAGTask{{
+								Name: "root-driver",
+								Arguments: workflowapi.Arguments{
+									Parameters: []workflowapi.Parameter{
+										{Name: "runtime-config", Value: workflowapi.AnyStringPtr(`{"parameterValues":{"other":"value"}}`)},
+										{Name: "driver-type", Value: workflowapi.AnyStringPtr("ROOT_DAG")},
+									},
+								},
+							}},
+						},
+					}},
+				},
+			},
+		},
+	}, commonutil.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil)}
+	object := createBucketObject("data.csv", 10*hour)
+
+	// The parameters of v2 pipelines are in the runtime config of the root
+	// DAG driver.
+	result, err := schedule.NewBucketObjectWorkflow(&object, 11*hour)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"parameterValues":{"input":"minio://mlpipeline/incoming/data.csv","other":"value"}}`,
+		result.Spec.Templates[0].DAG.Tasks[0].Arguments.Parameters[0].Value.String())
+}
@@ -62,6 +62,12 @@ type ScheduledWorkflowSpec struct {
 	// +optional
 	NoCatchup *bool `json:"noCatchup,omitempty"`
 
+	// Specifies how to treat a scheduled workflow that is due while workflows
+	// created by the schedule are still active.
+	// ConcurrencyPolicy defaults to Allow if not specified.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
 	// Max number of completed workflows to keep track of.
 	// If MaxHistory is not specified, MaxHistory is 10.
 	// MaxHistory cannot be smaller than 0.
@@ -72,6 +78,11 @@ type ScheduledWorkflowSpec struct {
 	// Trigger describes when to create a new workflow.
 	Trigger `json:"trigger,omitempty"`
 
+	// Backfill describes a past time range for which to create the workflows
+	// of the trigger, in addition to the scheduled ones.
+	// +optional
+	Backfill *Backfill `json:"backfill,omitempty"`
+
 	// Specification of the workflow to schedule.
 	// +optional
 	Workflow *WorkflowResource `json:"workflow,omitempty"`
@@ -80,6 +91,30 @@ type ScheduledWorkflowSpec struct {
 
 }
 
+// ConcurrencyPolicy describes how the scheduled workflows of a schedule are
+// handled when they overlap.
+type ConcurrencyPolicy string
+
+// These are valid concurrency policies of a ScheduledWorkflow.
+const (
+	// AllowConcurrent allows up to MaxConcurrency workflows to be active. A
+	// scheduled workflow waits until the number of active workflows is below
+	// MaxConcurrency.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent allows a single workflow to be active. A scheduled
+	// workflow waits until no workflow is active.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// SkipConcurrent skips a scheduled workflow if a workflow is still active,
+	// and records the skipped time in the status.
+	SkipConcurrent ConcurrencyPolicy = "Skip"
+
+	// ReplaceConcurrent terminates the active workflows before creating a
+	// scheduled workflow.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
 type WorkflowResource struct {
 	// List of parameters to substitute in the workflow template.
 	// The parameter values may include special strings that the controller will substitute:
@@ -113,6 +148,28 @@ type Trigger struct {
 
 	// Create workflows periodically.
 	PeriodicSchedule *PeriodicSchedule `json:"periodicSchedule,omitempty"`
+
+	// Create a workflow for each new object of a bucket.
+	BucketObjectTrigger *BucketObjectTrigger `json:"bucketObjectTrigger,omitempty"`
+
+	// Create a workflow each time a run of an upstream job or pipeline version
+	// succeeds. The runs are created by the API server, which watches the runs
+	// reported by the persistence agent, so the controller never creates them.
+	RunDependencyTrigger *RunDependencyTrigger `json:"runDependencyTrigger,omitempty"`
+}
+
+// Backfill is a time range of the schedule of the trigger. A workflow is created
+// for each time of the range at which the schedule creates one, e.g. a
+// periodic schedule is still anchored at its start time, or at the creation
+// time of the ScheduledWorkflow.
+type Backfill struct {
+	// Time of the first scheduled time to backfill. A scheduled time equal to
+	// the start time is backfilled.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Time of the last scheduled time to backfill. A scheduled time equal to
+	// the end time is backfilled.
+	EndTime metav1.Time `json:"endTime"`
 }
 
 type CronSchedule struct {
@@ -130,6 +187,12 @@ type CronSchedule struct {
 	// time interval defined by StartTime and EndTime.
 	// +optional
 	Cron string `json:"cron,omitempty"`
+
+	// IANA name of the time zone in which the cron string is interpreted,
+	// such as "Europe/Stockholm".
+	// If no time zone is specified, the time zone of the controller is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type PeriodicSchedule struct {
@@ -149,6 +212,37 @@ type PeriodicSchedule struct {
 	IntervalSecond int64 `json:"intervalSecond,omitempty"`
 }
 
+type BucketObjectTrigger struct {
+	// URL of the bucket to watch, in the same format as a pipeline root, such
+	// as "minio://mlpipeline/incoming" or "gs://my-bucket/data". The path of the
+	// URL is the prefix of the keys of the objects to watch.
+	Bucket string `json:"bucket"`
+
+	// Pattern that the keys of the objects must match, relative to the
+	// prefix of the bucket, such as "*.csv". The syntax is the one of
+	// https://golang.org/pkg/path/#Match, so "*" doesn't match "/".
+	// If no pattern is specified, all the objects under the prefix match.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+
+	// Name of the workflow parameter set to the URI of the object. For the
+	// workflows of v2 pipelines, it's the name of the pipeline input
+	// parameter set in the runtime config.
+	ParameterName string `json:"parameterName"`
+}
+
+type RunDependencyTrigger struct {
+	// Type of the upstream resource, either "Job" or "PipelineVersion".
+	UpstreamType string `json:"upstreamType"`
+
+	// ID of the upstream job or pipeline version whose succeeded runs create a
+	// workflow.
+	UpstreamID string `json:"upstreamId"`
+
+	// Name of the workflow parameter set to the ID of the upstream run.
+	ParameterName string `json:"parameterName"`
+}
+
 // ScheduledWorkflowStatus is the status for a ScheduledWorkflow resource.
 type ScheduledWorkflowStatus struct {
 
@@ -200,8 +294,80 @@ type TriggerStatus struct {
 	// Time of the next creation of a workflow (assuming that the schedule is enabled).
 	NextTriggeredTime *metav1.Time `json:"nextTriggeredTime,omitempty"`
 
+	// NextTriggeredTime in RFC 3339 format, in the time zone of the cron schedule.
+	// Only set if the cron schedule has a time zone.
+	// +optional
+	NextTriggeredTimeInZone string `json:"nextTriggeredTimeInZone,omitempty"`
+
 	// Index of the last workflow created.
 	LastIndex *int64 `json:"lastWorkflowIndex,omitempty"`
+
+	// Progress of the backfill of the spec.
+	// +optional
+	Backfill *BackfillStatus `json:"backfill,omitempty"`
+
+	// The most recent scheduled times for which no workflow was created, most
+	// recent first. At most MaxHistory of them are kept.
+	// +optional
+	Skipped []SkippedTrigger `json:"skipped,omitempty"`
+
+	// Record of the objects of the bucket object trigger that already
+	// created a workflow.
+	// +optional
+	BucketObject *BucketObjectTriggerStatus `json:"bucketObject,omitempty"`
+}
+
+// BucketObjectTriggerStatus records the last object that created a workflow.
+// The objects create workflows in order of modification time, then of key, so
+// that only the last one needs to be kept: an object creates a workflow if it
+// was modified after the last object, or in the same second with a greater
+// key. An object that is overwritten or created again later creates another
+// workflow.
+type BucketObjectTriggerStatus struct {
+	// Time at which the last object that created a workflow was modified.
+	LastObjectModTime *metav1.Time `json:"lastObjectModTime,omitempty"`
+
+	// Key of the last object that created a workflow.
+	LastObjectKey string `json:"lastObjectKey,omitempty"`
+
+	// URI of the last object that created a workflow.
+	LastObjectURI string `json:"lastObjectURI,omitempty"`
+
+	// Number of workflows created for objects of the bucket.
+	TriggeredCount int64 `json:"triggeredCount,omitempty"`
+}
+
+type SkippedTrigger struct {
+	// The scheduled time for which no workflow was created.
+	ScheduledAt metav1.Time `json:"scheduledAt,omitempty"`
+
+	// (brief) reason for skipping the scheduled time.
+	Reason string `json:"reason,omitempty"`
+
+	// Human readable message indicating details about skipping the scheduled time.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type BackfillStatus struct {
+	// Start time of the backfill this status is about.
+	StartTime metav1.Time `json:"startTime"`
+
+	// End time of the backfill this status is about.
+	EndTime metav1.Time `json:"endTime"`
+
+	// Scheduled time of the last workflow created by the backfill.
+	LastTriggeredTime *metav1.Time `json:"lastTriggeredTime,omitempty"`
+
+	// Scheduled time of the next workflow to create for the backfill.
+	NextTriggeredTime *metav1.Time `json:"nextTriggeredTime,omitempty"`
+
+	// Number of workflows created by the backfill.
+	TriggeredCount int64 `json:"triggeredCount,omitempty"`
+
+	// True once the workflows of all the scheduled times of the backfill are
+	// created.
+	Completed bool `json:"completed,omitempty"`
 }
 
 type WorkflowHistory struct {
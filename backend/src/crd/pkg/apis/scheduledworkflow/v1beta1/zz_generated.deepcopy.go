@@ -21,6 +21,86 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backfill) DeepCopyInto(out *Backfill) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backfill.
+func (in *Backfill) DeepCopy() *Backfill {
+	if in == nil {
+		return nil
+	}
+	out := new(Backfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackfillStatus) DeepCopyInto(out *BackfillStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.LastTriggeredTime != nil {
+		in, out := &in.LastTriggeredTime, &out.LastTriggeredTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTriggeredTime != nil {
+		in, out := &in.NextTriggeredTime, &out.NextTriggeredTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackfillStatus.
+func (in *BackfillStatus) DeepCopy() *BackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObjectTrigger) DeepCopyInto(out *BucketObjectTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectTrigger.
+func (in *BucketObjectTrigger) DeepCopy() *BucketObjectTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObjectTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObjectTriggerStatus) DeepCopyInto(out *BucketObjectTriggerStatus) {
+	*out = *in
+	if in.LastObjectModTime != nil {
+		in, out := &in.LastObjectModTime, &out.LastObjectModTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectTriggerStatus.
+func (in *BucketObjectTriggerStatus) DeepCopy() *BucketObjectTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObjectTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronSchedule) DeepCopyInto(out *CronSchedule) {
 	*out = *in
@@ -85,6 +165,22 @@ func (in *PeriodicSchedule) DeepCopy() *PeriodicSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunDependencyTrigger) DeepCopyInto(out *RunDependencyTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunDependencyTrigger.
+func (in *RunDependencyTrigger) DeepCopy() *RunDependencyTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunDependencyTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledWorkflow) DeepCopyInto(out *ScheduledWorkflow) {
 	*out = *in
@@ -183,6 +279,11 @@ func (in *ScheduledWorkflowSpec) DeepCopyInto(out *ScheduledWorkflowSpec) {
 		**out = **in
 	}
 	in.Trigger.DeepCopyInto(&out.Trigger)
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(Backfill)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Workflow != nil {
 		in, out := &in.Workflow, &out.Workflow
 		*out = new(WorkflowResource)
@@ -230,6 +331,23 @@ func (in *ScheduledWorkflowStatus) DeepCopy() *ScheduledWorkflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedTrigger) DeepCopyInto(out *SkippedTrigger) {
+	*out = *in
+	in.ScheduledAt.DeepCopyInto(&out.ScheduledAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedTrigger.
+func (in *SkippedTrigger) DeepCopy() *SkippedTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Trigger) DeepCopyInto(out *Trigger) {
 	*out = *in
@@ -243,6 +361,16 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(PeriodicSchedule)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BucketObjectTrigger != nil {
+		in, out := &in.BucketObjectTrigger, &out.BucketObjectTrigger
+		*out = new(BucketObjectTrigger)
+		**out = **in
+	}
+	if in.RunDependencyTrigger != nil {
+		in, out := &in.RunDependencyTrigger, &out.RunDependencyTrigger
+		*out = new(RunDependencyTrigger)
+		**out = **in
+	}
 	return
 }
 
@@ -272,6 +400,23 @@ func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(BackfillStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]SkippedTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BucketObject != nil {
+		in, out := &in.BucketObject, &out.BucketObject
+		*out = new(BucketObjectTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
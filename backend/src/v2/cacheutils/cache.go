@@ -77,14 +77,24 @@ func GenerateCacheKey(
 		if len(outputArtifactList.Artifacts) == 0 {
 			continue
 		}
-		// TODO: Support multiple artifacts someday, probably through the v2 engine.
-		outputArtifact := outputArtifactList.Artifacts[0]
-		outputArtifactWithUriWiped := pipelinespec.RuntimeArtifact{
-			Name:     outputArtifact.GetName(),
-			Type:     outputArtifact.GetType(),
-			Metadata: outputArtifact.GetMetadata(),
+		outputArtifactsWithUriWiped := make([]*pipelinespec.RuntimeArtifact, 0, len(outputArtifactList.Artifacts))
+		for _, outputArtifact := range outputArtifactList.Artifacts {
+			outputArtifactsWithUriWiped = append(outputArtifactsWithUriWiped, &pipelinespec.RuntimeArtifact{
+				Name:     outputArtifact.GetName(),
+				Type:     outputArtifact.GetType(),
+				Metadata: outputArtifact.GetMetadata(),
+			})
 		}
-		cacheKey.OutputArtifactsSpec[outputArtifactName] = &outputArtifactWithUriWiped
+		// Single output artifacts keep their spec in OutputArtifactsSpec, so
+		// that their cache keys do not change.
+		if len(outputArtifactsWithUriWiped) == 1 {
+			cacheKey.OutputArtifactsSpec[outputArtifactName] = outputArtifactsWithUriWiped[0]
+			continue
+		}
+		if cacheKey.OutputArtifactLists == nil {
+			cacheKey.OutputArtifactLists = make(map[string]*pipelinespec.ArtifactList)
+		}
+		cacheKey.OutputArtifactLists[outputArtifactName] = &pipelinespec.ArtifactList{Artifacts: outputArtifactsWithUriWiped}
 	}
 
 	for outputParameterName, _ := range outputs.GetParameters() {
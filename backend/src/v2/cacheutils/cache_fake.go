@@ -0,0 +1,100 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheutils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewFakeClient returns a client of an in-memory cache, for tests and local
+// runs. The cache lists the latest tasks whose fingerprint, pipelineName and
+// namespace equal the string values of the predicates of the filter.
+func NewFakeClient() *Client {
+	return &Client{svc: &fakeTaskService{}}
+}
+
+type fakeTaskService struct {
+	mu sync.Mutex
+	// tasks in the order they are created
+	tasks []*api.Task
+}
+
+func (s *fakeTaskService) CreateTask(ctx context.Context, in *api.CreateTaskRequest, opts ...grpc.CallOption) (*api.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task := proto.Clone(in.GetTask()).(*api.Task)
+	task.Id = strconv.Itoa(len(s.tasks) + 1)
+	s.tasks = append(s.tasks, task)
+	return task, nil
+}
+
+func (s *fakeTaskService) ListTasks(ctx context.Context, in *api.ListTasksRequest, opts ...grpc.CallOption) (*api.ListTasksResponse, error) {
+	filter := &api.Filter{}
+	if in.GetFilter() != "" {
+		if err := protojson.Unmarshal([]byte(in.GetFilter()), filter); err != nil {
+			return nil, fmt.Errorf("invalid filter %q: %w", in.GetFilter(), err)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &api.ListTasksResponse{}
+	// The latest tasks first.
+	for i := len(s.tasks) - 1; i >= 0; i-- {
+		match, err := matchTask(s.tasks[i], filter)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		if in.GetPageSize() > 0 && len(res.Tasks) == int(in.GetPageSize()) {
+			break
+		}
+		res.Tasks = append(res.Tasks, s.tasks[i])
+	}
+	res.TotalSize = int32(len(res.Tasks))
+	return res, nil
+}
+
+func matchTask(task *api.Task, filter *api.Filter) (bool, error) {
+	for _, predicate := range filter.GetPredicates() {
+		if predicate.GetOp() != api.Predicate_EQUALS {
+			return false, fmt.Errorf("unsupported operation %v of predicate on key %q", predicate.GetOp(), predicate.GetKey())
+		}
+		var value string
+		switch predicate.GetKey() {
+		case "fingerprint":
+			value = task.GetFingerprint()
+		case "pipelineName":
+			value = task.GetPipelineName()
+		case "namespace":
+			value = task.GetNamespace()
+		default:
+			return false, fmt.Errorf("unsupported predicate key %q", predicate.GetKey())
+		}
+		if value != predicate.GetStringValue() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -132,6 +132,48 @@ func TestGenerateCacheKey(t *testing.T) {
 
 			wantErr: false,
 		},
+		{
+			name: "Generate CacheKey of Output Artifact List",
+			executorInputInputs: &pipelinespec.ExecutorInput_Inputs{
+				Artifacts: map[string]*pipelinespec.ArtifactList{
+					"shards": {
+						Artifacts: []*pipelinespec.RuntimeArtifact{
+							{Name: "1", Uri: "gs://bucket/shards/0"},
+							{Name: "2", Uri: "gs://bucket/shards/1"},
+						},
+					},
+				},
+			},
+			executorInputOutputs: &pipelinespec.ExecutorInput_Outputs{
+				Artifacts: map[string]*pipelinespec.ArtifactList{
+					"models": {
+						Artifacts: []*pipelinespec.RuntimeArtifact{
+							{Name: "model-0", Uri: "gs://bucket/models/0", Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "kfp.Model"}}},
+							{Name: "model-1", Uri: "gs://bucket/models/1", Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "kfp.Model"}}},
+						},
+					},
+				},
+			},
+			cmdArgs: []string{"sh", "ec", "test"},
+			image:   "python:3.9",
+			want: &cachekey.CacheKey{
+				InputArtifactNames: map[string]*cachekey.ArtifactNameList{
+					"shards": {ArtifactNames: []string{"1", "2"}},
+				},
+				OutputArtifactLists: map[string]*pipelinespec.ArtifactList{
+					"models": {
+						Artifacts: []*pipelinespec.RuntimeArtifact{
+							{Name: "model-0", Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "kfp.Model"}}},
+							{Name: "model-1", Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "kfp.Model"}}},
+						},
+					},
+				},
+				ContainerSpec: &cachekey.ContainerSpec{
+					CmdArgs: []string{"sh", "ec", "test"},
+					Image:   "python:3.9",
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 
@@ -23,6 +23,7 @@ import (
 	"github.com/golang/glog"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -49,9 +50,10 @@ func main() {
 		glog.Exitf("spec and job cannot be specified at the same time")
 	}
 	var job *pipelinespec.PipelineJob
+	var kubernetesSpec *pipelinespec.SinglePlatformSpec
 	var err error
 	if !noSpec {
-		job, err = loadSpec(*specPath)
+		job, kubernetesSpec, err = loadSpec(*specPath)
 	} else {
 		// !noJob
 		job, err = loadJob(*jobPath)
@@ -59,13 +61,13 @@ func main() {
 	if err != nil {
 		glog.Exitf("Failed to load: %v", err)
 	}
-	if err := compile(job); err != nil {
+	if err := compile(job, kubernetesSpec); err != nil {
 		glog.Exitf("Failed to compile: %v", err)
 	}
 }
 
-func compile(job *pipelinespec.PipelineJob) error {
-	wf, err := argocompiler.Compile(job, &argocompiler.Options{
+func compile(job *pipelinespec.PipelineJob, kubernetesSpec *pipelinespec.SinglePlatformSpec) error {
+	wf, err := argocompiler.Compile(job, kubernetesSpec, &argocompiler.Options{
 		DriverImage:   *driver,
 		LauncherImage: *launcher,
 		PipelineRoot:  *pipelineRoot,
@@ -103,20 +105,38 @@ func loadJob(path string) (*pipelinespec.PipelineJob, error) {
 	return job, nil
 }
 
-func loadSpec(path string) (*pipelinespec.PipelineJob, error) {
-	bytes, err := ioutil.ReadFile(path)
+// loadSpec loads a pipeline spec file, and the kubernetes platform spec when
+// the file has a platform spec.
+func loadSpec(path string) (*pipelinespec.PipelineJob, *pipelinespec.SinglePlatformSpec, error) {
+	content, err := ioutil.ReadFile(path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	bytes, platformSpecBytes, err := compiler.SplitPipelineYAML(content)
+	if err != nil {
+		return nil, nil, err
 	}
 	spec := &pipelinespec.PipelineSpec{}
 	specJson, err := yaml.YAMLToJSON(bytes)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to convert pipeline spec from yaml to json, error: %s, spec: %v", err, string(bytes))
+		return nil, nil, fmt.Errorf("Failed to convert pipeline spec from yaml to json, error: %s, spec: %v", err, string(bytes))
 	}
 	if err := protojson.Unmarshal(specJson, spec); err != nil {
-		return nil, fmt.Errorf("Failed to parse pipeline spec, error: %s, spec: %v", err, string(specJson))
+		return nil, nil, fmt.Errorf("Failed to parse pipeline spec, error: %s, spec: %v", err, string(specJson))
+	}
+	var kubernetesSpec *pipelinespec.SinglePlatformSpec
+	if platformSpecBytes != nil {
+		platformSpec, err := compiler.ParsePlatformSpec(platformSpecBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		kubernetesSpec = compiler.GetKubernetesSpec(platformSpec)
+	}
+	job, err := jobFromSpec(spec)
+	if err != nil {
+		return nil, nil, err
 	}
-	return jobFromSpec(spec)
+	return job, kubernetesSpec, nil
 }
 
 func jobFromSpec(spec *pipelinespec.PipelineSpec) (*pipelinespec.PipelineJob, error) {
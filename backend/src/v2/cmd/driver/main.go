@@ -29,9 +29,11 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/config"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
 )
 
 const (
@@ -40,7 +42,7 @@ const (
 
 var (
 	// inputs
-	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, CONTAINER")
+	driverType        = flag.String(driverTypeArg, "", "task driver type, one of ROOT_DAG, DAG, CONTAINER, RESOLVER")
 	pipelineName      = flag.String("pipeline_name", "", "pipeline context name")
 	runID             = flag.String("run_id", "", "pipeline run uid")
 	componentSpecJson = flag.String("component", "{}", "component spec")
@@ -51,10 +53,15 @@ var (
 	// container inputs
 	dagExecutionID    = flag.Int64("dag_execution_id", 0, "DAG execution ID")
 	containerSpecJson = flag.String("container", "{}", "container spec")
+	k8sExecConfigJson = flag.String("kubernetes_config", "", "kubernetes executor config")
+
+	// resolver inputs
+	resolverSpecJson = flag.String("resolver", "", "resolver spec")
 
 	// config
 	mlmdServerAddress = flag.String("mlmd_server_address", "", "MLMD server address")
 	mlmdServerPort    = flag.String("mlmd_server_port", "", "MLMD server port")
+	parameterCoercion = flag.String("parameter_coercion", "", "how input parameter values are converted to the parameter types, one of TO_STRING (default), NONE, PARSE")
 
 	// output paths
 	executionIDPath    = flag.String("execution_id_path", "", "Exeucution ID output path")
@@ -118,6 +125,22 @@ func drive() (err error) {
 	if err := jsonpb.UnmarshalString(*containerSpecJson, containerSpec); err != nil {
 		return fmt.Errorf("failed to unmarshal container spec, error: %w\ncontainerSpec: %v", err, containerSpecJson)
 	}
+	var k8sExecCfg *kubernetesplatform.KubernetesExecutorConfig
+	if *k8sExecConfigJson != "" {
+		glog.Infof("input kubernetesConfig:%s\n", prettyPrint(*k8sExecConfigJson))
+		k8sExecCfg = &kubernetesplatform.KubernetesExecutorConfig{}
+		if err := jsonpb.UnmarshalString(*k8sExecConfigJson, k8sExecCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal Kubernetes config, error: %w\nKubernetesConfig: %v", err, k8sExecConfigJson)
+		}
+	}
+	var resolverSpec *pipelinespec.PipelineDeploymentConfig_ResolverSpec
+	if *resolverSpecJson != "" {
+		glog.Infof("input ResolverSpec:%s\n", prettyPrint(*resolverSpecJson))
+		resolverSpec = &pipelinespec.PipelineDeploymentConfig_ResolverSpec{}
+		if err := jsonpb.UnmarshalString(*resolverSpecJson, resolverSpec); err != nil {
+			return fmt.Errorf("failed to unmarshal resolver spec, error: %w\nresolverSpec: %v", err, resolverSpecJson)
+		}
+	}
 	var runtimeConfig *pipelinespec.PipelineJob_RuntimeConfig
 	if *runtimeConfigJson != "" {
 		glog.Infof("input RuntimeConfig:%s\n", prettyPrint(*runtimeConfigJson))
@@ -126,6 +149,10 @@ func drive() (err error) {
 			return fmt.Errorf("failed to unmarshal runtime config, error: %w\nruntimeConfig: %v", err, runtimeConfigJson)
 		}
 	}
+	coercion, err := parameter.ParseCoercionPolicy(*parameterCoercion)
+	if err != nil {
+		return err
+	}
 	namespace, err := config.InPodNamespace()
 	if err != nil {
 		return err
@@ -146,6 +173,8 @@ func drive() (err error) {
 		Task:           taskSpec,
 		DAGExecutionID: *dagExecutionID,
 		IterationIndex: *iterationIndex,
+
+		ParameterCoercion: coercion,
 	}
 	var execution *driver.Execution
 	var driverErr error
@@ -157,7 +186,11 @@ func drive() (err error) {
 		execution, driverErr = driver.DAG(ctx, options, client)
 	case "CONTAINER":
 		options.Container = containerSpec
+		options.KubernetesExecutorConfig = k8sExecCfg
 		execution, driverErr = driver.Container(ctx, options, client, cacheClient)
+	case "RESOLVER":
+		options.Resolver = resolverSpec
+		execution, driverErr = driver.Resolver(ctx, options, client)
 	default:
 		err = fmt.Errorf("unknown driverType %s", *driverType)
 	}
@@ -0,0 +1,179 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Local run command for Kubeflow Pipelines v2, it runs a pipeline on the local
+// machine without Kubernetes or Argo.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/localrunner"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	specPath          = flag.String("spec", "", "path to pipeline spec file")
+	jobPath           = flag.String("job", "", "path to pipeline job file")
+	parameterValues   = flag.String("parameter_values", "", "JSON object of pipeline parameter values, they override the parameter values of the pipeline job")
+	pipelineRoot      = flag.String("pipeline_root", "", "local directory or file:// URI to store artifacts in, defaults to the pipeline root of the job or a temporary directory")
+	runID             = flag.String("run_id", "", "KFP run ID, defaults to a new UUID")
+	docker            = flag.Bool("docker", false, "run containers in docker containers of their images instead of as local processes")
+	mlmdServerAddress = flag.String("mlmd_server_address", "", "The MLMD gRPC server address, defaults to an in-memory MLMD store")
+	mlmdServerPort    = flag.String("mlmd_server_port", "8080", "The MLMD gRPC server port.")
+	parameterCoercion = flag.String("parameter_coercion", "", "how input parameter values are converted to the parameter types, one of TO_STRING (default), NONE, PARSE")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		glog.Exit(err)
+	}
+}
+
+func run() error {
+	noSpec := *specPath == ""
+	noJob := *jobPath == ""
+	if noSpec == noJob {
+		return fmt.Errorf("exactly one of spec and job must be specified")
+	}
+	var job *pipelinespec.PipelineJob
+	var err error
+	if !noSpec {
+		job, err = loadSpec(*specPath)
+	} else {
+		job, err = loadJob(*jobPath)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load: %w", err)
+	}
+	if *parameterValues != "" {
+		values := &structpb.Struct{}
+		if err := protojson.Unmarshal([]byte(*parameterValues), values); err != nil {
+			return fmt.Errorf("failed to parse parameter values %q: %w", *parameterValues, err)
+		}
+		if job.RuntimeConfig == nil {
+			job.RuntimeConfig = &pipelinespec.PipelineJob_RuntimeConfig{}
+		}
+		if job.RuntimeConfig.ParameterValues == nil {
+			job.RuntimeConfig.ParameterValues = make(map[string]*structpb.Value)
+		}
+		for name, value := range values.GetFields() {
+			job.RuntimeConfig.ParameterValues[name] = value
+		}
+	}
+	coercion, err := parameter.ParseCoercionPolicy(*parameterCoercion)
+	if err != nil {
+		return err
+	}
+	opts := localrunner.Options{
+		PipelineRoot:      *pipelineRoot,
+		RunID:             *runID,
+		Docker:            *docker,
+		ParameterCoercion: coercion,
+	}
+	if opts.PipelineRoot != "" && !strings.Contains(opts.PipelineRoot, "://") {
+		dir, err := filepath.Abs(opts.PipelineRoot)
+		if err != nil {
+			return err
+		}
+		opts.PipelineRoot = "file://" + dir
+	}
+	if *mlmdServerAddress != "" {
+		opts.MetadataClient, err = metadata.NewClient(*mlmdServerAddress, *mlmdServerPort)
+		if err != nil {
+			return err
+		}
+	}
+	result, err := localrunner.Run(context.Background(), job, opts)
+	if result != nil {
+		fmt.Printf("Run ID: %s\nPipeline root: %s\n", result.RunID, result.PipelineRoot)
+	}
+	return err
+}
+
+// Use WARNING default logging level to facilitate troubleshooting.
+func init() {
+	flag.Set("logtostderr", "true")
+	// Change the WARNING to INFO level for debugging.
+	flag.Set("stderrthreshold", "WARNING")
+}
+
+func loadJob(path string) (*pipelinespec.PipelineJob, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	job := &pipelinespec.PipelineJob{}
+	if err := protojson.Unmarshal(bytes, job); err != nil {
+		return nil, fmt.Errorf("failed to parse pipeline job: %w", err)
+	}
+	return job, nil
+}
+
+// loadSpec loads a pipeline spec file as a pipeline job with default parameter
+// values. The platform spec of the file is ignored, it does not apply to
+// local runs.
+func loadSpec(path string) (*pipelinespec.PipelineJob, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bytes, _, err := compiler.SplitPipelineYAML(content)
+	if err != nil {
+		return nil, err
+	}
+	specJSON, err := yaml.YAMLToJSON(bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert pipeline spec from yaml to json: %w", err)
+	}
+	spec := &pipelinespec.PipelineSpec{}
+	if err := protojson.Unmarshal(specJSON, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse pipeline spec: %w", err)
+	}
+	specStruct, err := toStruct(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &pipelinespec.PipelineJob{
+		Name:         spec.GetPipelineInfo().GetName(),
+		PipelineSpec: specStruct,
+		RuntimeConfig: &pipelinespec.PipelineJob_RuntimeConfig{
+			ParameterValues: map[string]*structpb.Value{},
+		},
+	}, nil
+}
+
+func toStruct(msg proto.Message) (*structpb.Struct, error) {
+	specStr, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	res := &structpb.Struct{}
+	err = protojson.Unmarshal(specStr, res)
+	return res, err
+}
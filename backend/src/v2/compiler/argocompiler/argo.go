@@ -19,8 +19,11 @@ import (
 	"strings"
 
 	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	k8score "k8s.io/api/core/v1"
@@ -28,17 +31,47 @@ import (
 	k8smeta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// TODO(chensun): release process and update the images.
+	DefaultDriverImage   = "gcr.io/ml-pipeline-test/dev/kfp-driver:latest"
+	DefaultLauncherImage = "gcr.io/ml-pipeline-test/dev/kfp-launcher-v2:latest"
+	// DefaultServiceAccountName is the service account of the workflow when
+	// none is configured.
+	DefaultServiceAccountName = "pipeline-runner"
+)
+
 type Options struct {
 	// optional, use official image if not provided
 	LauncherImage string
 	// optional
 	DriverImage string
+	// optional, image pull policy of the driver and launcher images, the
+	// Kubernetes default applies if not provided
+	ImagePullPolicy k8score.PullPolicy
+	// optional, use DefaultServiceAccountName if not provided
+	ServiceAccountName string
 	// optional
 	PipelineRoot string
-	// TODO(Bobgy): add an option -- dev mode, ImagePullPolicy should only be Always in dev mode.
+	// optional, how drivers convert input parameter values to the parameter
+	// types, use the default policy of drivers if not provided
+	ParameterCoercion parameter.CoercionPolicy
+	// optional, image name prefixes and the prefixes that replace them, e.g.
+	// "gcr.io/ml-pipeline" -> "registry.local/ml-pipeline" to pull images
+	// from a mirror registry in an air-gapped cluster. They apply to the
+	// driver, launcher and executor images. The longest matching prefix
+	// wins, a prefix matches whole path components, tags or digests of
+	// image names as they are written.
+	ImageMirrors map[string]string
+	// optional, loads the pipeline specs of the pipeline versions that
+	// components import. Compiling a pipeline spec that imports pipeline
+	// versions fails if not provided.
+	PipelineVersionLoader compiler.PipelineVersionLoader
 }
 
-func Compile(jobArg *pipelinespec.PipelineJob, opts *Options) (*wfapi.Workflow, error) {
+// Compile compiles a PipelineJob to an argo workflow. kubernetesSpecArg is
+// optional, it is the platform spec of the "kubernetes" platform, which
+// extends the pod specs of the executors.
+func Compile(jobArg *pipelinespec.PipelineJob, kubernetesSpecArg *pipelinespec.SinglePlatformSpec, opts *Options) (*wfapi.Workflow, error) {
 	// clone jobArg, because we don't want to change it
 	jobMsg := proto.Clone(jobArg)
 	job, ok := jobMsg.(*pipelinespec.PipelineJob)
@@ -55,6 +88,20 @@ func Compile(jobArg *pipelinespec.PipelineJob, opts *Options) (*wfapi.Workflow,
 	if err != nil {
 		return nil, err
 	}
+	if opts != nil && opts.PipelineVersionLoader != nil {
+		if _, err := compiler.InlinePipelineVersions(spec, "", opts.PipelineVersionLoader); err != nil {
+			return nil, err
+		}
+		// The components are compiled from the pipeline spec of the job.
+		specJSON, err := protojson.Marshal(spec)
+		if err != nil {
+			return nil, err
+		}
+		job.PipelineSpec = &structpb.Struct{}
+		if err := protojson.Unmarshal(specJSON, job.PipelineSpec); err != nil {
+			return nil, err
+		}
+	}
 	// validation
 	if spec.GetPipelineInfo().GetName() == "" {
 		return nil, fmt.Errorf("pipelineInfo.name is empty")
@@ -99,16 +146,15 @@ func Compile(jobArg *pipelinespec.PipelineJob, opts *Options) (*wfapi.Workflow,
 					"pipelines.kubeflow.org/v2_component": "true",
 				},
 			},
-			ServiceAccountName: "pipeline-runner",
+			ServiceAccountName: DefaultServiceAccountName,
 			Entrypoint:         tmplEntrypoint,
 		},
 	}
 	c := &workflowCompiler{
-		wf:        wf,
-		templates: make(map[string]*wfapi.Template),
-		// TODO(chensun): release process and update the images.
-		driverImage:   "gcr.io/ml-pipeline-test/dev/kfp-driver:latest",
-		launcherImage: "gcr.io/ml-pipeline-test/dev/kfp-launcher-v2:latest",
+		wf:            wf,
+		templates:     make(map[string]*wfapi.Template),
+		driverImage:   DefaultDriverImage,
+		launcherImage: DefaultLauncherImage,
 		job:           job,
 		spec:          spec,
 		executors:     deploy.GetExecutors(),
@@ -120,9 +166,41 @@ func Compile(jobArg *pipelinespec.PipelineJob, opts *Options) (*wfapi.Workflow,
 		if opts.LauncherImage != "" {
 			c.launcherImage = opts.LauncherImage
 		}
+		switch opts.ImagePullPolicy {
+		case "", k8score.PullAlways, k8score.PullIfNotPresent, k8score.PullNever:
+			c.imagePullPolicy = opts.ImagePullPolicy
+		default:
+			return nil, fmt.Errorf("invalid image pull policy %q", opts.ImagePullPolicy)
+		}
+		if opts.ParameterCoercion != "" {
+			if c.parameterCoercion, err = parameter.ParseCoercionPolicy(string(opts.ParameterCoercion)); err != nil {
+				return nil, err
+			}
+		}
+		if opts.ServiceAccountName != "" {
+			wf.Spec.ServiceAccountName = opts.ServiceAccountName
+		}
 		if opts.PipelineRoot != "" {
 			job.RuntimeConfig.GcsOutputDirectory = opts.PipelineRoot
 		}
+		c.imageMirrors = opts.ImageMirrors
+		c.driverImage = c.mirrorImage(c.driverImage)
+		c.launcherImage = c.mirrorImage(c.launcherImage)
+	}
+
+	c.kubernetesConfigs = make(map[string]*kubernetesplatform.KubernetesExecutorConfig)
+	for label, executorConfig := range kubernetesSpecArg.GetDeploymentSpec().GetExecutors() {
+		if _, ok := c.executors[label]; !ok {
+			return nil, fmt.Errorf("kubernetes platform spec: executor with label %q not found", label)
+		}
+		kubernetesConfig, err := compiler.GetKubernetesExecutorConfig(executorConfig)
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes platform spec of executor %q: %w", label, err)
+		}
+		c.kubernetesConfigs[label] = kubernetesConfig
+		if err := c.saveKubernetesSpec(label, executorConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	// compile
@@ -141,15 +219,48 @@ type workflowCompiler struct {
 	job       *pipelinespec.PipelineJob
 	spec      *pipelinespec.PipelineSpec
 	executors map[string]*pipelinespec.PipelineDeploymentConfig_ExecutorSpec
+	// kubernetes platform configs of the executors, by executor label
+	kubernetesConfigs map[string]*kubernetesplatform.KubernetesExecutorConfig
 	// state
-	wf            *wfapi.Workflow
-	templates     map[string]*wfapi.Template
-	driverImage   string
-	launcherImage string
+	wf              *wfapi.Workflow
+	templates       map[string]*wfapi.Template
+	driverImage     string
+	launcherImage   string
+	imagePullPolicy k8score.PullPolicy
+	// empty means the default policy of drivers
+	parameterCoercion parameter.CoercionPolicy
+	// image name prefixes and their mirrors
+	imageMirrors map[string]string
+}
+
+// driverArgs returns the args of a driver with the driver options that apply
+// to all drivers of the workflow.
+func (c *workflowCompiler) driverArgs(args []string) []string {
+	if c.parameterCoercion != "" {
+		args = append(args, "--parameter_coercion", string(c.parameterCoercion))
+	}
+	return args
 }
 
-func (c *workflowCompiler) Resolver(name string, component *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
-	return fmt.Errorf("resolver not implemented yet")
+// mirrorImage returns the image name with its longest prefix that has a
+// mirror replaced by the mirror, or the image name when no prefix matches.
+func (c *workflowCompiler) mirrorImage(image string) string {
+	matched := ""
+	for prefix := range c.imageMirrors {
+		if len(prefix) <= len(matched) || !strings.HasPrefix(image, prefix) {
+			continue
+		}
+		// Only match whole path components, so that "gcr.io/ml-pipeline"
+		// does not match "gcr.io/ml-pipeline-test/...".
+		if rest := image[len(prefix):]; rest != "" && !strings.HasSuffix(prefix, "/") && !strings.ContainsAny(rest[:1], "/:@") {
+			continue
+		}
+		matched = prefix
+	}
+	if matched == "" {
+		return image
+	}
+	return c.imageMirrors[matched] + image[len(matched):]
 }
 
 var errAlreadyExists = fmt.Errorf("template already exists")
@@ -176,6 +287,7 @@ func (c *workflowCompiler) templateName(componentName string) string {
 const (
 	annotationComponents = "pipelines.kubeflow.org/components-"
 	annotationContainers = "pipelines.kubeflow.org/implementations-"
+	annotationKubernetes = "pipelines.kubeflow.org/kubernetes-"
 )
 
 func (c *workflowCompiler) saveComponentSpec(name string, spec *pipelinespec.ComponentSpec) error {
@@ -196,6 +308,19 @@ func (c *workflowCompiler) useComponentImpl(name string) (string, error) {
 	return c.annotationPlaceholder(annotationContainers + name)
 }
 
+func (c *workflowCompiler) saveKubernetesSpec(executorLabel string, msg proto.Message) error {
+	return c.saveProtoToAnnotation(annotationKubernetes+executorLabel, msg)
+}
+
+// useKubernetesSpec returns a placeholder of the kubernetes config of an
+// executor, or an empty string if the executor has no kubernetes config.
+func (c *workflowCompiler) useKubernetesSpec(executorLabel string) (string, error) {
+	if _, exists := c.wf.Annotations[annotationKubernetes+executorLabel]; !exists {
+		return "", nil
+	}
+	return c.annotationPlaceholder(annotationKubernetes + executorLabel)
+}
+
 // TODO(Bobgy): sanitize component name
 func (c *workflowCompiler) saveProtoToAnnotation(name string, msg proto.Message) error {
 	if c == nil {
@@ -232,6 +357,7 @@ const (
 	paramTask           = "task"           // task spec
 	paramContainer      = "container"      // container spec
 	paramImporter       = "importer"       // importer spec
+	paramResolver       = "resolver"       // resolver spec
 	paramRuntimeConfig  = "runtime-config" // job runtime config, pipeline level inputs
 	paramParentDagID    = "parent-dag-id"
 	paramExecutionID    = "execution-id"
@@ -242,6 +368,8 @@ const (
 	paramCachedDecision = "cached-decision" // indicate hit cache or not
 	paramPodSpecPatch   = "pod-spec-patch"  // a strategic patch merged with the pod spec
 	paramCondition      = "condition"       // condition = false -> skip the task
+
+	paramKubernetesConfig = "kubernetes-config" // kubernetes executor config of the task
 )
 
 func runID() string {
@@ -18,22 +18,28 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/google/go-cmp/cmp"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
 	"github.com/kubeflow/pipelines/backend/src/v2/compiler/argocompiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	k8score "k8s.io/api/core/v1"
 )
 
 var update = flag.Bool("update", false, "update golden files")
 
 func Test_argo_compiler(t *testing.T) {
 	tests := []struct {
-		jobPath      string // path of input PipelineJob to compile
-		argoYAMLPath string // path of expected output argo workflow YAML
+		jobPath          string // path of input PipelineJob to compile
+		platformSpecPath string // optional, path of input PlatformSpec to compile
+		argoYAMLPath     string // path of expected output argo workflow YAML
 	}{
 		{
 			jobPath:      "../testdata/hello_world.json",
@@ -43,13 +49,22 @@ func Test_argo_compiler(t *testing.T) {
 			jobPath:      "../testdata/importer.json",
 			argoYAMLPath: "testdata/importer.yaml",
 		},
+		{
+			jobPath:          "../testdata/hello_world.json",
+			platformSpecPath: "../testdata/hello_world_platform_spec.json",
+			argoYAMLPath:     "testdata/hello_world_kubernetes.yaml",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%+v", tt), func(t *testing.T) {
 
 			job := load(t, tt.jobPath)
+			var kubernetesSpec *pipelinespec.SinglePlatformSpec
+			if tt.platformSpecPath != "" {
+				kubernetesSpec = loadKubernetesSpec(t, tt.platformSpecPath)
+			}
 			if *update {
-				wf, err := argocompiler.Compile(job, nil)
+				wf, err := argocompiler.Compile(job, kubernetesSpec, nil)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -66,7 +81,7 @@ func Test_argo_compiler(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			wf, err := argocompiler.Compile(job, nil)
+			wf, err := argocompiler.Compile(job, kubernetesSpec, nil)
 			if err != nil {
 				t.Error(err)
 			}
@@ -96,3 +111,250 @@ func load(t *testing.T, path string) *pipelinespec.PipelineJob {
 	}
 	return job
 }
+
+func loadKubernetesSpec(t *testing.T, path string) *pipelinespec.SinglePlatformSpec {
+	t.Helper()
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Error(err)
+	}
+	platformSpec, err := compiler.ParsePlatformSpec(content)
+	if err != nil {
+		t.Error(err)
+	}
+	return compiler.GetKubernetesSpec(platformSpec)
+}
+
+func Test_argo_compiler_invalidKubernetesSpec(t *testing.T) {
+	job := load(t, "../testdata/hello_world.json")
+	_, err := argocompiler.Compile(job, &pipelinespec.SinglePlatformSpec{
+		DeploymentSpec: &pipelinespec.PlatformDeploymentConfig{
+			Executors: map[string]*structpb.Struct{
+				"exec-not-found": {},
+			},
+		},
+	}, nil)
+	if err == nil || !strings.Contains(err.Error(), "exec-not-found") {
+		t.Errorf("expect error for unknown executor, got %v", err)
+	}
+}
+
+func Test_argo_compiler_retryPolicy(t *testing.T) {
+	job := load(t, "../testdata/hello_world.json")
+	job.GetPipelineSpec().GetFields()["root"].GetStructValue().GetFields()["dag"].GetStructValue().GetFields()["tasks"].GetStructValue().GetFields()["hello-world"].GetStructValue().GetFields()["retryPolicy"] = structpb.NewStructValue(&structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"maxRetryCount":      structpb.NewNumberValue(3),
+			"backoffDuration":    structpb.NewStringValue("10s"),
+			"backoffFactor":      structpb.NewNumberValue(1.6),
+			"backoffMaxDuration": structpb.NewStringValue("2h"),
+			"retryOn":            structpb.NewStringValue("USER_ERROR"),
+		},
+	})
+	wf, err := argocompiler.Compile(job, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var retried []wfapi.Template
+	for _, template := range wf.Spec.Templates {
+		if template.RetryStrategy != nil {
+			retried = append(retried, template)
+		}
+	}
+	if len(retried) != 1 {
+		t.Fatalf("expect one template with a retry strategy, got %v", len(retried))
+	}
+	if !strings.HasPrefix(retried[0].Name, "system-container-impl-retry-3-") {
+		t.Errorf("unexpected name of the retried template %q", retried[0].Name)
+	}
+	strategy := retried[0].RetryStrategy
+	if strategy.Limit.String() != "3" || strategy.RetryPolicy != wfapi.RetryPolicyOnFailure {
+		t.Errorf("unexpected retry strategy %+v", strategy)
+	}
+	if strategy.Backoff == nil || strategy.Backoff.Duration != "10s" || strategy.Backoff.Factor.String() != "2" || strategy.Backoff.MaxDuration != "1h0m0s" {
+		t.Errorf("unexpected backoff %+v", strategy.Backoff)
+	}
+}
+
+func Test_argo_compiler_resolver(t *testing.T) {
+	job := load(t, "../testdata/hello_world.json")
+	spec := job.GetPipelineSpec().GetFields()
+	set := func(s *structpb.Struct, key string, value map[string]interface{}) {
+		v, err := structpb.NewValue(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.GetFields()[key] = v
+	}
+	set(spec["components"].GetStructValue(), "comp-latest-model", map[string]interface{}{
+		"executorLabel": "exec-latest-model",
+		"outputDefinitions": map[string]interface{}{
+			"artifacts": map[string]interface{}{
+				"model": map[string]interface{}{
+					"artifactType": map[string]interface{}{"schemaTitle": "system.Model"},
+				},
+			},
+		},
+	})
+	set(spec["deploymentSpec"].GetStructValue().GetFields()["executors"].GetStructValue(), "exec-latest-model", map[string]interface{}{
+		"resolver": map[string]interface{}{
+			"outputArtifactQueries": map[string]interface{}{
+				"model": map[string]interface{}{"filter": `artifact_type="system.Model"`},
+			},
+		},
+	})
+	set(spec["root"].GetStructValue().GetFields()["dag"].GetStructValue().GetFields()["tasks"].GetStructValue(), "latest-model", map[string]interface{}{
+		"componentRef": map[string]interface{}{"name": "comp-latest-model"},
+		"taskInfo":     map[string]interface{}{"name": "latest-model"},
+	})
+	wf, err := argocompiler.Compile(job, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resolverTemplate *wfapi.Template
+	var resolverTask *wfapi.DAGTask
+	for i, template := range wf.Spec.Templates {
+		if template.Name == "system-resolver-driver" {
+			resolverTemplate = &wf.Spec.Templates[i]
+		}
+		if template.DAG == nil {
+			continue
+		}
+		for j, task := range template.DAG.Tasks {
+			if task.Name == "latest-model" {
+				resolverTask = &template.DAG.Tasks[j]
+			}
+		}
+	}
+	if resolverTemplate == nil || resolverTask == nil {
+		t.Fatalf("expect a resolver driver template and a latest-model task, got %+v", wf.Spec.Templates)
+	}
+	if resolverTask.Template != "system-resolver-driver" {
+		t.Errorf("unexpected template of the resolver task %q", resolverTask.Template)
+	}
+	if !strings.Contains(strings.Join(resolverTemplate.Container.Args, " "), "--type RESOLVER") {
+		t.Errorf("unexpected args of the resolver driver %v", resolverTemplate.Container.Args)
+	}
+	if resolverTask.Arguments.GetParameterByName("resolver") == nil {
+		t.Errorf("expect a resolver parameter of the resolver task, got %+v", resolverTask.Arguments)
+	}
+	found := false
+	for _, annotation := range wf.Annotations {
+		if strings.Contains(annotation, "outputArtifactQueries") && strings.Contains(annotation, "system.Model") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expect the resolver spec in the workflow annotations, got %+v", wf.Annotations)
+	}
+}
+
+func Test_argo_compiler_options(t *testing.T) {
+	job := load(t, "../testdata/hello_world.json")
+	wf, err := argocompiler.Compile(job, nil, &argocompiler.Options{
+		DriverImage:        "gcr.io/ml-pipeline/kfp-driver:2.0.0",
+		ImagePullPolicy:    k8score.PullIfNotPresent,
+		ServiceAccountName: "team-runner",
+		ParameterCoercion:  parameter.CoercionParse,
+		ImageMirrors: map[string]string{
+			"gcr.io":             "registry.local/gcr",
+			"gcr.io/ml-pipeline": "registry.local/ml-pipeline",
+			"gcr.io/ml-pipe":     "registry.local/wrong",
+			"python":             "registry.local/python",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wf.Spec.ServiceAccountName != "team-runner" {
+		t.Errorf("unexpected service account %q", wf.Spec.ServiceAccountName)
+	}
+	images := make(map[string]bool)
+	for _, template := range wf.Spec.Templates {
+		containers := []*k8score.Container{template.Container}
+		for i := range template.InitContainers {
+			containers = append(containers, &template.InitContainers[i].Container)
+		}
+		for _, container := range containers {
+			if container == nil || container.Image == "gcr.io/ml-pipeline/should-be-overridden-during-runtime" {
+				continue
+			}
+			images[container.Image] = true
+			if container.Command[0] == "driver" && !strings.Contains(strings.Join(container.Args, " "), "--parameter_coercion PARSE") {
+				t.Errorf("expect the parameter coercion policy in the driver args, got %v", container.Args)
+			}
+			if container.ImagePullPolicy != k8score.PullIfNotPresent {
+				t.Errorf("unexpected image pull policy %q of image %q", container.ImagePullPolicy, container.Image)
+			}
+		}
+	}
+	expected := map[string]bool{
+		"registry.local/ml-pipeline/kfp-driver:2.0.0":                    true,
+		"registry.local/gcr/ml-pipeline-test/dev/kfp-launcher-v2:latest": true,
+	}
+	if diff := cmp.Diff(expected, images); diff != "" {
+		t.Errorf("unexpected images, diff: %s", diff)
+	}
+	if impl := wf.Annotations["pipelines.kubeflow.org/implementations-comp-hello-world"]; !strings.Contains(impl, `"image":"registry.local/python:3.7"`) {
+		t.Errorf("expect the executor image to be mirrored, got %s", impl)
+	}
+
+	_, err = argocompiler.Compile(job, nil, &argocompiler.Options{ImagePullPolicy: "Sometimes"})
+	if err == nil || !strings.Contains(err.Error(), "invalid image pull policy") {
+		t.Errorf("expect an invalid image pull policy error, got %v", err)
+	}
+	_, err = argocompiler.Compile(job, nil, &argocompiler.Options{ParameterCoercion: "ALWAYS"})
+	if err == nil || !strings.Contains(err.Error(), "unknown parameter coercion policy") {
+		t.Errorf("expect an unknown parameter coercion policy error, got %v", err)
+	}
+}
+
+func Test_argo_compiler_pipeline_version_imports(t *testing.T) {
+	helloWorld, err := compiler.GetPipelineSpec(load(t, "../testdata/hello_world.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := &pipelinespec.PipelineSpec{
+		PipelineInfo:   &pipelinespec.PipelineInfo{Name: "importing"},
+		DeploymentSpec: &structpb.Struct{},
+		Root: &pipelinespec.ComponentSpec{
+			Implementation: &pipelinespec.ComponentSpec_Dag{Dag: &pipelinespec.DagSpec{
+				Tasks: map[string]*pipelinespec.PipelineTaskSpec{
+					"sub": {
+						TaskInfo:     &pipelinespec.PipelineTaskInfo{Name: "sub"},
+						ComponentRef: &pipelinespec.ComponentRef{Name: "comp-sub"},
+					},
+				},
+			}},
+		},
+		Components: map[string]*pipelinespec.ComponentSpec{
+			"comp-sub": {Implementation: &pipelinespec.ComponentSpec_PipelineVersionId{PipelineVersionId: "hello-world"}},
+		},
+	}
+	specJSON, err := protojson.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	job := &pipelinespec.PipelineJob{PipelineSpec: &structpb.Struct{}}
+	if err := protojson.Unmarshal(specJSON, job.PipelineSpec); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = argocompiler.Compile(job, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), `pipeline version "hello-world" is not inlined`) {
+		t.Errorf("expect a not inlined error, got %v", err)
+	}
+	wf, err := argocompiler.Compile(job, nil, &argocompiler.Options{
+		PipelineVersionLoader: func(id string) (*pipelinespec.PipelineSpec, error) {
+			if id != "hello-world" {
+				return nil, fmt.Errorf("pipeline version %q not found", id)
+			}
+			return helloWorld, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if impl := wf.Annotations["pipelines.kubeflow.org/implementations-comp-sub-comp-hello-world"]; !strings.Contains(impl, `"image":"python:3.7"`) {
+		t.Errorf("expect the executor of the imported pipeline version, got %s", impl)
+	}
+}
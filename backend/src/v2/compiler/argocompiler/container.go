@@ -15,14 +15,27 @@
 package argocompiler
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
 	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/component"
+	"google.golang.org/protobuf/proto"
 	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
 	volumeNameKFPLauncher = "kfp-launcher"
+	// The defaults and limits of the backoff of retry policies, see
+	// PipelineTaskSpec.RetryPolicy.
+	defaultRetryBackoffFactor      = 2
+	defaultRetryBackoffMaxDuration = time.Hour
 )
 
 func (c *workflowCompiler) Container(name string, component *pipelinespec.ComponentSpec, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec) error {
@@ -30,6 +43,10 @@ func (c *workflowCompiler) Container(name string, component *pipelinespec.Compon
 	if err != nil {
 		return err
 	}
+	if image := c.mirrorImage(container.GetImage()); image != container.GetImage() {
+		container = proto.Clone(container).(*pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec)
+		container.Image = image
+	}
 	err = c.saveComponentImpl(name, container)
 	if err != nil {
 		return err
@@ -49,6 +66,8 @@ type containerDriverInputs struct {
 	container      string
 	parentDagID    string
 	iterationIndex string // optional, when this is an iteration task
+	// optional, when the executor has a kubernetes platform config
+	kubernetesConfig string
 }
 
 func (c *workflowCompiler) containerDriverTask(name string, inputs containerDriverInputs) (*wfapi.DAGTask, *containerDriverOutputs) {
@@ -70,6 +89,12 @@ func (c *workflowCompiler) containerDriverTask(name string, inputs containerDriv
 			wfapi.Parameter{Name: paramIterationIndex, Value: wfapi.AnyStringPtr(inputs.iterationIndex)},
 		)
 	}
+	if inputs.kubernetesConfig != "" {
+		dagTask.Arguments.Parameters = append(
+			dagTask.Arguments.Parameters,
+			wfapi.Parameter{Name: paramKubernetesConfig, Value: wfapi.AnyStringPtr(inputs.kubernetesConfig)},
+		)
+	}
 	outputs := &containerDriverOutputs{
 		podSpecPatch: taskOutputParameter(name, paramPodSpecPatch),
 		cached:       taskOutputParameter(name, paramCachedDecision),
@@ -93,6 +118,7 @@ func (c *workflowCompiler) addContainerDriverTemplate() string {
 				{Name: paramContainer},
 				{Name: paramParentDagID},
 				{Name: paramIterationIndex, Default: wfapi.AnyStringPtr("-1")},
+				{Name: paramKubernetesConfig, Default: wfapi.AnyStringPtr("")},
 			},
 		},
 		Outputs: wfapi.Outputs{
@@ -103,9 +129,10 @@ func (c *workflowCompiler) addContainerDriverTemplate() string {
 			},
 		},
 		Container: &k8score.Container{
-			Image:   c.driverImage,
-			Command: []string{"driver"},
-			Args: []string{
+			Image:           c.driverImage,
+			ImagePullPolicy: c.imagePullPolicy,
+			Command:         []string{"driver"},
+			Args: c.driverArgs([]string{
 				"--type", "CONTAINER",
 				"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
 				"--run_id", runID(),
@@ -117,7 +144,8 @@ func (c *workflowCompiler) addContainerDriverTemplate() string {
 				"--cached_decision_path", outputPath(paramCachedDecision),
 				"--pod_spec_patch_path", outputPath(paramPodSpecPatch),
 				"--condition_path", outputPath(paramCondition),
-			},
+				"--kubernetes_config", inputValue(paramKubernetesConfig),
+			}),
 			Resources: driverResources,
 		},
 	}
@@ -133,6 +161,13 @@ type containerExecutorInputs struct {
 	cachedDecision string
 	// if false, the container will be skipped.
 	condition string
+	// optional, the executor label of the container, it is required only
+	// when podMetadata is specified.
+	executorLabel string
+	// optional, labels and annotations of the executor pod.
+	podMetadata *kubernetesplatform.PodMetadata
+	// optional, how the executor is retried when it fails.
+	retryPolicy *pipelinespec.PipelineTaskSpec_RetryPolicy
 }
 
 // containerExecutorTask returns an argo workflows DAGTask.
@@ -146,7 +181,7 @@ func (c *workflowCompiler) containerExecutorTask(name string, inputs containerEx
 	}
 	return &wfapi.DAGTask{
 		Name:     name,
-		Template: c.addContainerExecutorTemplate(),
+		Template: c.addContainerExecutorTemplate(inputs.executorLabel, inputs.podMetadata, retryStrategy(inputs.retryPolicy)),
 		When:     when,
 		Arguments: wfapi.Arguments{
 			Parameters: []wfapi.Parameter{
@@ -161,10 +196,22 @@ func (c *workflowCompiler) containerExecutorTask(name string, inputs containerEx
 // any container component task.
 // During runtime, it's expected that pod-spec-patch will specify command, args
 // and resources etc, that are different for different tasks.
-func (c *workflowCompiler) addContainerExecutorTemplate() string {
+// Pod metadata cannot be patched by pod-spec-patch, so executors with pod
+// metadata get their own templates. So do executors with a retry strategy,
+// one template per distinct strategy.
+func (c *workflowCompiler) addContainerExecutorTemplate(executorLabel string, podMetadata *kubernetesplatform.PodMetadata, retry *wfapi.RetryStrategy) string {
 	// container template is parent of container implementation template
 	nameContainerExecutor := "system-container-executor"
 	nameContainerImpl := "system-container-impl"
+	if podMetadata != nil {
+		nameContainerExecutor = nameContainerExecutor + "-" + executorLabel
+		nameContainerImpl = nameContainerImpl + "-" + executorLabel
+	}
+	if retry != nil {
+		suffix := retryTemplateSuffix(retry)
+		nameContainerExecutor = nameContainerExecutor + suffix
+		nameContainerImpl = nameContainerImpl + suffix
+	}
 	_, ok := c.templates[nameContainerExecutor]
 	if ok {
 		return nameContainerExecutor
@@ -216,9 +263,10 @@ func (c *workflowCompiler) addContainerExecutorTemplate() string {
 		}},
 		InitContainers: []wfapi.UserContainer{{
 			Container: k8score.Container{
-				Name:    "kfp-launcher",
-				Image:   c.launcherImage,
-				Command: []string{"launcher-v2", "--copy", component.KFPLauncherPath},
+				Name:            "kfp-launcher",
+				Image:           c.launcherImage,
+				ImagePullPolicy: c.imagePullPolicy,
+				Command:         []string{"launcher-v2", "--copy", component.KFPLauncherPath},
 				VolumeMounts: []k8score.VolumeMount{{
 					Name:      volumeNameKFPLauncher,
 					MountPath: component.VolumePathKFPLauncher,
@@ -242,8 +290,66 @@ func (c *workflowCompiler) addContainerExecutorTemplate() string {
 			EnvFrom: []k8score.EnvFromSource{metadataEnvFrom},
 			Env:     commonEnvs,
 		},
+		RetryStrategy: retry,
+	}
+	if podMetadata != nil {
+		executor.Metadata = wfapi.Metadata{
+			Labels:      podMetadata.GetLabels(),
+			Annotations: podMetadata.GetAnnotations(),
+		}
 	}
 	c.templates[nameContainerImpl] = executor
 	c.wf.Spec.Templates = append(c.wf.Spec.Templates, *container, *executor)
 	return nameContainerExecutor
 }
+
+// retryStrategy converts the retry policy of a task to the retry strategy of
+// its executor, it returns nil when the task is not retried.
+// Argo retries the executor pod only, so the driver runs once and the
+// launcher records every attempt as an execution of the same task.
+func retryStrategy(policy *pipelinespec.PipelineTaskSpec_RetryPolicy) *wfapi.RetryStrategy {
+	if policy.GetMaxRetryCount() <= 0 {
+		return nil
+	}
+	limit := intstr.FromInt(int(policy.GetMaxRetryCount()))
+	strategy := &wfapi.RetryStrategy{Limit: &limit}
+	switch policy.GetRetryOn() {
+	case pipelinespec.PipelineTaskSpec_RetryPolicy_USER_ERROR:
+		// Argo failures are containers that exit with a non-zero exit code.
+		strategy.RetryPolicy = wfapi.RetryPolicyOnFailure
+	case pipelinespec.PipelineTaskSpec_RetryPolicy_SYSTEM_ERROR:
+		// Argo errors are pods that could not run or were interrupted.
+		strategy.RetryPolicy = wfapi.RetryPolicyOnError
+	default:
+		strategy.RetryPolicy = wfapi.RetryPolicyAlways
+	}
+	duration := policy.GetBackoffDuration().AsDuration()
+	if duration <= 0 {
+		return strategy
+	}
+	// Argo only supports integer backoff factors.
+	factor := defaultRetryBackoffFactor
+	if policy.GetBackoffFactor() > 0 {
+		factor = int(math.Max(1, math.Round(policy.GetBackoffFactor())))
+	}
+	maxDuration := defaultRetryBackoffMaxDuration
+	if d := policy.GetBackoffMaxDuration().AsDuration(); d > 0 && d < maxDuration {
+		maxDuration = d
+	}
+	factorIntOrString := intstr.FromInt(factor)
+	strategy.Backoff = &wfapi.Backoff{
+		Duration:    duration.String(),
+		Factor:      &factorIntOrString,
+		MaxDuration: maxDuration.String(),
+	}
+	return strategy
+}
+
+// retryTemplateSuffix returns a template name suffix that is unique to a
+// retry strategy, e.g. "-retry-3-1a2b3c4d".
+func retryTemplateSuffix(retry *wfapi.RetryStrategy) string {
+	// Marshalling a struct of strings and numbers never fails.
+	bytes, _ := json.Marshal(retry)
+	hash := sha256.Sum256(bytes)
+	return fmt.Sprintf("-retry-%s-%x", retry.Limit.String(), hash[:4])
+}
@@ -52,9 +52,6 @@ func (c *workflowCompiler) DAG(name string, componentSpec *pipelinespec.Componen
 		if kfpTask.GetParameterIterator() != nil && kfpTask.GetArtifactIterator() != nil {
 			return fmt.Errorf("invalid task %q: parameterIterator and artifactIterator cannot be specified at the same time", taskName)
 		}
-		if kfpTask.GetArtifactIterator() != nil {
-			return fmt.Errorf("artifact iterator not implemented yet")
-		}
 		tasks, err := c.task(taskName, kfpTask, taskInputs{
 			parentDagID: inputParameter(paramParentDagID),
 		})
@@ -169,6 +166,10 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 		if inputs.iterationIndex == "" {
 			driver.Depends = depends(task.GetDependentTasks())
 		}
+		// Handle exit handler dependency
+		if task.GetTriggerPolicy().GetStrategy().String() == "ALL_UPSTREAM_TASKS_COMPLETED" {
+			driver.Depends = depends_exit_handler(task.GetDependentTasks())
+		}
 		dag := c.dagTask(name, componentName, dagInputs{
 			parentDagID: driverOutputs.executionID,
 			condition:   driverOutputs.condition,
@@ -189,13 +190,18 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 			if err != nil {
 				return nil, err
 			}
+			kubernetesConfigPlaceholder, err := c.useKubernetesSpec(impl.ExecutorLabel)
+			if err != nil {
+				return nil, err
+			}
 			driverTaskName := name + "-driver"
 			driver, driverOutputs := c.containerDriverTask(driverTaskName, containerDriverInputs{
-				component:      componentSpecPlaceholder,
-				task:           taskSpecJson,
-				container:      containerPlaceholder,
-				parentDagID:    inputs.parentDagID,
-				iterationIndex: inputs.iterationIndex,
+				component:        componentSpecPlaceholder,
+				task:             taskSpecJson,
+				container:        containerPlaceholder,
+				parentDagID:      inputs.parentDagID,
+				iterationIndex:   inputs.iterationIndex,
+				kubernetesConfig: kubernetesConfigPlaceholder,
 			})
 			if task.GetTriggerPolicy().GetCondition() == "" {
 				driverOutputs.condition = ""
@@ -212,6 +218,9 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 				podSpecPatch:   driverOutputs.podSpecPatch,
 				cachedDecision: driverOutputs.cached,
 				condition:      driverOutputs.condition,
+				executorLabel:  impl.ExecutorLabel,
+				podMetadata:    c.kubernetesConfigs[impl.ExecutorLabel].GetPodMetadata(),
+				retryPolicy:    task.GetRetryPolicy(),
 			})
 			executor.Depends = depends([]string{driverTaskName})
 			return []wfapi.DAGTask{*driver, *executor}, nil
@@ -227,7 +236,19 @@ func (c *workflowCompiler) task(name string, task *pipelinespec.PipelineTaskSpec
 			}
 			return []wfapi.DAGTask{*importer}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_Resolver:
-			return nil, fmt.Errorf("resolver executors not implemented")
+			if task.GetTriggerPolicy().GetCondition() != "" {
+				// Like importers, resolvers have no executor that a condition could skip.
+				return nil, fmt.Errorf("triggerPolicy.condition on resolver task is not supported")
+			}
+			resolver, err := c.resolverTask(name, task, taskSpecJson, inputs.parentDagID, inputs.iterationIndex)
+			if err != nil {
+				return nil, err
+			}
+			// iterations belong to a sub-DAG, no need to add dependent tasks
+			if inputs.iterationIndex == "" {
+				resolver.Depends = depends(task.GetDependentTasks())
+			}
+			return []wfapi.DAGTask{*resolver}, nil
 		case *pipelinespec.PipelineDeploymentConfig_ExecutorSpec_CustomJob:
 			return nil, fmt.Errorf("custom job executors is Google Cloud only, it's not supported")
 		default:
@@ -406,9 +427,10 @@ func (c *workflowCompiler) addDAGDriverTemplate() string {
 			},
 		},
 		Container: &k8score.Container{
-			Image:   c.driverImage,
-			Command: []string{"driver"},
-			Args: []string{
+			Image:           c.driverImage,
+			ImagePullPolicy: c.imagePullPolicy,
+			Command:         []string{"driver"},
+			Args: c.driverArgs([]string{
 				"--type", inputValue(paramDriverType),
 				"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
 				"--run_id", runID(),
@@ -420,7 +442,7 @@ func (c *workflowCompiler) addDAGDriverTemplate() string {
 				"--execution_id_path", outputPath(paramExecutionID),
 				"--iteration_count_path", outputPath(paramIterationCount),
 				"--condition_path", outputPath(paramCondition),
-			},
+			}),
 			Resources: driverResources,
 		},
 	}
@@ -461,7 +483,9 @@ func addImplicitDependencies(dagSpec *pipelinespec.DagSpec) error {
 					return wrap(err)
 				}
 			case *pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_:
-				return wrap(fmt.Errorf("task final status not supported yet"))
+				if err := addDep(input.GetTaskFinalStatus().GetProducerTask()); err != nil {
+					return wrap(err)
+				}
 			default:
 				// other parameter input types do not introduce implicit dependencies
 			}
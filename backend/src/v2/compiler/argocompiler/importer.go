@@ -92,12 +92,13 @@ func (c *workflowCompiler) addImporterTemplate() string {
 			},
 		},
 		Container: &k8score.Container{
-			Image:     c.launcherImage,
-			Command:   []string{"launcher-v2"},
-			Args:      launcherArgs,
-			EnvFrom:   []k8score.EnvFromSource{metadataEnvFrom},
-			Env:       commonEnvs,
-			Resources: driverResources,
+			Image:           c.launcherImage,
+			ImagePullPolicy: c.imagePullPolicy,
+			Command:         []string{"launcher-v2"},
+			Args:            launcherArgs,
+			EnvFrom:         []k8score.EnvFromSource{metadataEnvFrom},
+			Env:             commonEnvs,
+			Resources:       driverResources,
 		},
 	}
 	c.templates[name] = importerTemplate
@@ -0,0 +1,105 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argocompiler
+
+import (
+	wfapi "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	k8score "k8s.io/api/core/v1"
+)
+
+func (c *workflowCompiler) Resolver(name string, componentSpec *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
+	err := c.saveComponentSpec(name, componentSpec)
+	if err != nil {
+		return err
+	}
+	return c.saveComponentImpl(name, resolver)
+}
+
+// resolverTask returns a task that runs a resolver driver, it queries the
+// artifacts of the resolver in MLMD and records them as the outputs of the
+// task, no executor is needed.
+func (c *workflowCompiler) resolverTask(name string, task *pipelinespec.PipelineTaskSpec, taskJSON string, parentDagID string, iterationIndex string) (*wfapi.DAGTask, error) {
+	componentPlaceholder, err := c.useComponentSpec(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resolverPlaceholder, err := c.useComponentImpl(task.GetComponentRef().GetName())
+	if err != nil {
+		return nil, err
+	}
+	params := []wfapi.Parameter{{
+		Name:  paramTask,
+		Value: wfapi.AnyStringPtr(taskJSON),
+	}, {
+		Name:  paramComponent,
+		Value: wfapi.AnyStringPtr(componentPlaceholder),
+	}, {
+		Name:  paramResolver,
+		Value: wfapi.AnyStringPtr(resolverPlaceholder),
+	}, {
+		Name:  paramParentDagID,
+		Value: wfapi.AnyStringPtr(parentDagID),
+	}}
+	if iterationIndex != "" {
+		params = append(params, wfapi.Parameter{
+			Name:  paramIterationIndex,
+			Value: wfapi.AnyStringPtr(iterationIndex),
+		})
+	}
+	return &wfapi.DAGTask{
+		Name:      name,
+		Template:  c.addResolverTemplate(),
+		Arguments: wfapi.Arguments{Parameters: params},
+	}, nil
+}
+
+func (c *workflowCompiler) addResolverTemplate() string {
+	name := "system-resolver-driver"
+	if _, alreadyExists := c.templates[name]; alreadyExists {
+		return name
+	}
+	t := &wfapi.Template{
+		Name: name,
+		Inputs: wfapi.Inputs{
+			Parameters: []wfapi.Parameter{
+				{Name: paramTask},
+				{Name: paramComponent},
+				{Name: paramResolver},
+				{Name: paramParentDagID},
+				{Name: paramIterationIndex, Default: wfapi.AnyStringPtr("-1")},
+			},
+		},
+		Container: &k8score.Container{
+			Image:           c.driverImage,
+			ImagePullPolicy: c.imagePullPolicy,
+			Command:         []string{"driver"},
+			Args: []string{
+				"--type", "RESOLVER",
+				"--pipeline_name", c.spec.GetPipelineInfo().GetName(),
+				"--run_id", runID(),
+				"--dag_execution_id", inputValue(paramParentDagID),
+				"--component", inputValue(paramComponent),
+				"--task", inputValue(paramTask),
+				"--resolver", inputValue(paramResolver),
+				"--iteration_index", inputValue(paramIterationIndex),
+			},
+			Resources: driverResources,
+		},
+	}
+	c.templates[name] = t
+	c.wf.Spec.Templates = append(c.wf.Spec.Templates, *t)
+	return name
+}
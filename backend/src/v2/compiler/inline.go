@@ -0,0 +1,187 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PipelineVersionLoader loads the pipeline spec of an uploaded pipeline
+// version by its ID.
+type PipelineVersionLoader func(id string) (*pipelinespec.PipelineSpec, error)
+
+// InlinePipelineVersions replaces the components of spec that import a
+// pipeline version, i.e. that have a pipeline_version_id, by the root DAG of
+// the pipeline spec of the version. The inputs and outputs of such a
+// component are the ones of the root component of the version.
+//
+// The components and executors of an imported pipeline spec are added to spec,
+// their names and labels are prefixed by the name of the importing component
+// and "-". Imported pipeline versions can import other pipeline versions, a
+// cycle of imports is an error. versionID is the ID of the pipeline version of
+// spec, it is optional and detects a version that imports itself. The
+// platform specs of the imported versions do not apply.
+//
+// It returns the sorted IDs of all the imported pipeline versions, including
+// the ones imported indirectly.
+func InlinePipelineVersions(spec *pipelinespec.PipelineSpec, versionID string, load PipelineVersionLoader) ([]string, error) {
+	in := &inliner{
+		load:  load,
+		specs: make(map[string]*pipelinespec.PipelineSpec),
+	}
+	var stack []string
+	if versionID != "" {
+		stack = []string{versionID}
+	}
+	if err := in.inline(spec, stack); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(in.specs))
+	for id := range in.specs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+type inliner struct {
+	load PipelineVersionLoader
+	// The inlined pipeline specs of the imported versions, map key is the
+	// version ID.
+	specs map[string]*pipelinespec.PipelineSpec
+}
+
+// inline inlines the pipeline versions that spec imports. stack is the chain
+// of pipeline versions that imports spec.
+func (in *inliner) inline(spec *pipelinespec.PipelineSpec, stack []string) error {
+	if id := spec.GetRoot().GetPipelineVersionId(); id != "" {
+		return fmt.Errorf("the root component cannot import pipeline version %q", id)
+	}
+	names := make([]string, 0, len(spec.GetComponents()))
+	for name := range spec.GetComponents() {
+		names = append(names, name)
+	}
+	// Inline in deterministic order, so that errors are deterministic too.
+	sort.Strings(names)
+	for _, name := range names {
+		id := spec.Components[name].GetPipelineVersionId()
+		if id == "" {
+			continue
+		}
+		imported, err := in.get(id, stack)
+		if err != nil {
+			return fmt.Errorf("component %q: %w", name, err)
+		}
+		if err := merge(spec, name, imported); err != nil {
+			return fmt.Errorf("component %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// get returns the inlined pipeline spec of a pipeline version.
+func (in *inliner) get(id string, stack []string) (*pipelinespec.PipelineSpec, error) {
+	for i, importer := range stack {
+		if importer == id {
+			cycle := append(append([]string{}, stack[i:]...), id)
+			return nil, fmt.Errorf("cycle in pipeline version imports: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	if spec, ok := in.specs[id]; ok {
+		return spec, nil
+	}
+	loaded, err := in.load(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pipeline version %q: %w", id, err)
+	}
+	if loaded.GetRoot().GetDag() == nil {
+		return nil, fmt.Errorf("pipeline version %q: root component is not a DAG", id)
+	}
+	// Do not change the spec of the loader.
+	spec := proto.Clone(loaded).(*pipelinespec.PipelineSpec)
+	if err := in.inline(spec, append(stack[:len(stack):len(stack)], id)); err != nil {
+		return nil, fmt.Errorf("pipeline version %q: %w", id, err)
+	}
+	in.specs[id] = spec
+	return spec, nil
+}
+
+// merge replaces the component name of spec by the root component of
+// imported, and adds the components and executors of imported.
+func merge(spec *pipelinespec.PipelineSpec, name string, imported *pipelinespec.PipelineSpec) error {
+	prefix := name + "-"
+	root := proto.Clone(imported.GetRoot()).(*pipelinespec.ComponentSpec)
+	prefixRefs(root, prefix)
+	spec.Components[name] = root
+	for componentName, component := range imported.GetComponents() {
+		if _, ok := spec.Components[prefix+componentName]; ok {
+			return fmt.Errorf("component %q of the imported pipeline spec conflicts with component %q", componentName, prefix+componentName)
+		}
+		component = proto.Clone(component).(*pipelinespec.ComponentSpec)
+		prefixRefs(component, prefix)
+		spec.Components[prefix+componentName] = component
+	}
+	importedExecutors := imported.GetDeploymentSpec().GetFields()["executors"].GetStructValue().GetFields()
+	if len(importedExecutors) == 0 {
+		return nil
+	}
+	executors := deploymentExecutors(spec)
+	for label, executor := range importedExecutors {
+		if _, ok := executors.Fields[prefix+label]; ok {
+			return fmt.Errorf("executor %q of the imported pipeline spec conflicts with executor %q", label, prefix+label)
+		}
+		executors.Fields[prefix+label] = proto.Clone(executor).(*structpb.Value)
+	}
+	return nil
+}
+
+// prefixRefs prefixes the executor label and the component refs of the tasks
+// of a component.
+func prefixRefs(component *pipelinespec.ComponentSpec, prefix string) {
+	if label := component.GetExecutorLabel(); label != "" {
+		component.Implementation = &pipelinespec.ComponentSpec_ExecutorLabel{ExecutorLabel: prefix + label}
+	}
+	for _, task := range component.GetDag().GetTasks() {
+		if task.GetComponentRef() != nil {
+			task.ComponentRef.Name = prefix + task.ComponentRef.Name
+		}
+	}
+}
+
+// deploymentExecutors returns the executors of the deployment spec of spec,
+// and creates them if they do not exist.
+func deploymentExecutors(spec *pipelinespec.PipelineSpec) *structpb.Struct {
+	if spec.DeploymentSpec == nil {
+		spec.DeploymentSpec = &structpb.Struct{}
+	}
+	if spec.DeploymentSpec.Fields == nil {
+		spec.DeploymentSpec.Fields = make(map[string]*structpb.Value)
+	}
+	executors := spec.DeploymentSpec.Fields["executors"].GetStructValue()
+	if executors == nil {
+		executors = &structpb.Struct{}
+		spec.DeploymentSpec.Fields["executors"] = structpb.NewStructValue(executors)
+	}
+	if executors.Fields == nil {
+		executors.Fields = make(map[string]*structpb.Value)
+	}
+	return executors
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// importingSpec returns a pipeline spec whose root DAG has a task "sub" that
+// runs component "comp-sub", which imports a pipeline version.
+func importingSpec(versionID string) *pipelinespec.PipelineSpec {
+	return &pipelinespec.PipelineSpec{
+		PipelineInfo: &pipelinespec.PipelineInfo{Name: "importing"},
+		Root: &pipelinespec.ComponentSpec{
+			Implementation: &pipelinespec.ComponentSpec_Dag{Dag: &pipelinespec.DagSpec{
+				Tasks: map[string]*pipelinespec.PipelineTaskSpec{
+					"sub": {
+						TaskInfo:     &pipelinespec.PipelineTaskInfo{Name: "sub"},
+						ComponentRef: &pipelinespec.ComponentRef{Name: "comp-sub"},
+					},
+				},
+			}},
+		},
+		Components: map[string]*pipelinespec.ComponentSpec{
+			"comp-sub": {
+				Implementation: &pipelinespec.ComponentSpec_PipelineVersionId{PipelineVersionId: versionID},
+			},
+		},
+	}
+}
+
+func loader(specs map[string]*pipelinespec.PipelineSpec) compiler.PipelineVersionLoader {
+	return func(id string) (*pipelinespec.PipelineSpec, error) {
+		spec, ok := specs[id]
+		if !ok {
+			return nil, fmt.Errorf("not found")
+		}
+		return spec, nil
+	}
+}
+
+func Test_InlinePipelineVersions(t *testing.T) {
+	helloWorld, err := compiler.GetPipelineSpec(load(t, "testdata/hello_world.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	specs := map[string]*pipelinespec.PipelineSpec{
+		"hello-world": helloWorld,
+		"nested":      importingSpec("hello-world"),
+	}
+	spec := importingSpec("nested")
+	ids, err := compiler.InlinePipelineVersions(spec, "", loader(specs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(ids, []string{"hello-world", "nested"}) {
+		t.Errorf("   got: %v\nexpect: %v", ids, []string{"hello-world", "nested"})
+	}
+	// The spec of the loader does not change.
+	if specs["nested"].GetComponents()["comp-sub"].GetPipelineVersionId() != "hello-world" {
+		t.Errorf("the spec of pipeline version nested changed: %v", specs["nested"])
+	}
+	helloWorldComponent := spec.GetComponents()["comp-sub-comp-sub-comp-hello-world"]
+	if got := helloWorldComponent.GetExecutorLabel(); got != "comp-sub-comp-sub-exec-hello-world" {
+		t.Errorf("got executor label %q", got)
+	}
+	if spec.GetDeploymentSpec().GetFields()["executors"].GetStructValue().GetFields()["comp-sub-comp-sub-exec-hello-world"] == nil {
+		t.Errorf("executor comp-sub-comp-sub-exec-hello-world not found in %v", spec.GetDeploymentSpec())
+	}
+
+	specStruct := &structpb.Struct{}
+	specJSON, err := protojson.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := protojson.Unmarshal(specJSON, specStruct); err != nil {
+		t.Fatal(err)
+	}
+	v := &testVisitor{visited: make([]string, 0)}
+	if err := compiler.Accept(&pipelinespec.PipelineJob{PipelineSpec: specStruct}, v); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`container(name="comp-sub-comp-sub-comp-hello-world")`,
+		`DAG(name="comp-sub-comp-sub")`,
+		`DAG(name="comp-sub")`,
+		`DAG(name="root")`,
+	}
+	if !cmp.Equal(v.visited, expected) {
+		t.Errorf("   got: %v\nexpect: %v", v.visited, expected)
+	}
+}
+
+func Test_InlinePipelineVersions_Errors(t *testing.T) {
+	specs := map[string]*pipelinespec.PipelineSpec{
+		"a": importingSpec("b"),
+		"b": importingSpec("a"),
+		"c": importingSpec("missing"),
+	}
+	tests := []struct {
+		name      string
+		spec      *pipelinespec.PipelineSpec
+		versionID string
+		wantErr   string
+	}{
+		{name: "cycle", spec: importingSpec("a"), wantErr: "cycle in pipeline version imports: a -> b -> a"},
+		{name: "self import", spec: importingSpec("d"), versionID: "d", wantErr: "cycle in pipeline version imports: d -> d"},
+		{name: "not found", spec: importingSpec("c"), wantErr: `failed to load pipeline version "missing": not found`},
+		{name: "root import", spec: &pipelinespec.PipelineSpec{
+			Root: &pipelinespec.ComponentSpec{Implementation: &pipelinespec.ComponentSpec_PipelineVersionId{PipelineVersionId: "a"}},
+		}, wantErr: `the root component cannot import pipeline version "a"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compiler.InlinePipelineVersions(tt.spec, tt.versionID, loader(specs))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, expect error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PlatformKubernetes is the key of the kubernetes platform in PlatformSpec.
+const PlatformKubernetes = "kubernetes"
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*\r?$`)
+
+// SplitPipelineYAML splits a pipeline file into its PipelineSpec document and
+// its optional PlatformSpec document. The PlatformSpec, when there is one, is
+// the second YAML document of the file.
+func SplitPipelineYAML(content []byte) (pipelineSpec []byte, platformSpec []byte, err error) {
+	documents := make([][]byte, 0, 2)
+	for _, document := range yamlDocumentSeparator.Split(string(content), -1) {
+		if len(bytes.TrimSpace([]byte(document))) == 0 {
+			continue
+		}
+		documents = append(documents, []byte(document))
+	}
+	switch len(documents) {
+	case 0:
+		return nil, nil, fmt.Errorf("pipeline spec is empty")
+	case 1:
+		return documents[0], nil, nil
+	case 2:
+		return documents[0], documents[1], nil
+	default:
+		return nil, nil, fmt.Errorf("expect at most 2 YAML documents, a pipeline spec and a platform spec, got %v", len(documents))
+	}
+}
+
+// ParsePlatformSpec parses a PlatformSpec in YAML or JSON format.
+func ParsePlatformSpec(content []byte) (*pipelinespec.PlatformSpec, error) {
+	platformSpecJson, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to convert platform spec from yaml to json: %w", err)
+	}
+	platformSpec := &pipelinespec.PlatformSpec{}
+	if err := protojson.Unmarshal(platformSpecJson, platformSpec); err != nil {
+		return nil, fmt.Errorf("Failed to parse platform spec: %w", err)
+	}
+	return platformSpec, nil
+}
+
+// GetKubernetesSpec returns the spec of the kubernetes platform, or nil if
+// there is none.
+func GetKubernetesSpec(platformSpec *pipelinespec.PlatformSpec) *pipelinespec.SinglePlatformSpec {
+	return platformSpec.GetPlatforms()[PlatformKubernetes]
+}
+
+// GetKubernetesExecutorConfig parses the config of an executor in the
+// kubernetes platform spec.
+func GetKubernetesExecutorConfig(config *structpb.Struct) (*kubernetesplatform.KubernetesExecutorConfig, error) {
+	marshaler := jsonpb.Marshaler{}
+	json, err := marshaler.MarshalToString(config)
+	if err != nil {
+		return nil, fmt.Errorf("Failed marshal kubernetes executor config to json: %w", err)
+	}
+	executorConfig := &kubernetesplatform.KubernetesExecutorConfig{}
+	if err := jsonpb.UnmarshalString(json, executorConfig); err != nil {
+		return nil, fmt.Errorf("Failed to parse kubernetes executor config: %w", err)
+	}
+	return executorConfig, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+)
+
+func Test_SplitPipelineYAML(t *testing.T) {
+	tests := []struct {
+		content      string
+		pipelineSpec string
+		platformSpec string
+		wantErr      bool
+	}{
+		{content: "pipelineInfo: {}\n", pipelineSpec: "pipelineInfo: {}"},
+		{content: "---\npipelineInfo: {}\n---\n", pipelineSpec: "pipelineInfo: {}"},
+		{content: "pipelineInfo: {}\n---\nplatforms: {}\n", pipelineSpec: "pipelineInfo: {}", platformSpec: "platforms: {}"},
+		{content: "{\"pipelineInfo\": {}}\r\n---\r\n{\"platforms\": {}}", pipelineSpec: "{\"pipelineInfo\": {}}", platformSpec: "{\"platforms\": {}}"},
+		// document separators inside block scalars are indented
+		{content: "command: |\n  ---\n", pipelineSpec: "command: |\n  ---"},
+		{content: "", wantErr: true},
+		{content: "a: 1\n---\nb: 2\n---\nc: 3\n", wantErr: true},
+	}
+	for _, test := range tests {
+		pipelineSpec, platformSpec, err := compiler.SplitPipelineYAML([]byte(test.content))
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("SplitPipelineYAML(%q) expects error", test.content)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SplitPipelineYAML(%q) failed: %v", test.content, err)
+			continue
+		}
+		if strings.TrimSpace(string(pipelineSpec)) != test.pipelineSpec {
+			t.Errorf("SplitPipelineYAML(%q) pipeline spec=%q, expect %q", test.content, pipelineSpec, test.pipelineSpec)
+		}
+		if strings.TrimSpace(string(platformSpec)) != test.platformSpec {
+			t.Errorf("SplitPipelineYAML(%q) platform spec=%q, expect %q", test.content, platformSpec, test.platformSpec)
+		}
+	}
+}
+
+func Test_GetKubernetesExecutorConfig_Invalid(t *testing.T) {
+	platformSpec, err := compiler.ParsePlatformSpec([]byte(`
+platforms:
+  kubernetes:
+    deploymentSpec:
+      executors:
+        exec-hello-world:
+          unknownField: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := compiler.GetKubernetesSpec(platformSpec).GetDeploymentSpec().GetExecutors()["exec-hello-world"]
+	if _, err := compiler.GetKubernetesExecutorConfig(config); err == nil {
+		t.Errorf("GetKubernetesExecutorConfig expects error for unknown fields")
+	}
+}
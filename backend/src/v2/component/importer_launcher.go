@@ -102,6 +102,22 @@ func NewImporterLauncher(ctx context.Context, componentSpecJSON, importerSpecJSO
 	}, nil
 }
 
+// NewLocalImporterLauncher creates an importer launcher that runs outside of a
+// Kubernetes cluster with the given ML Metadata client, e.g. in a local run.
+func NewLocalImporterLauncher(component *pipelinespec.ComponentSpec, importer *pipelinespec.PipelineDeploymentConfig_ImporterSpec, task *pipelinespec.PipelineTaskSpec, launcherV2Opts *LauncherV2Options, importerLauncherOpts *ImporterLauncherOptions, metadataClient *metadata.Client) (*ImportLauncher, error) {
+	if err := importerLauncherOpts.validate(); err != nil {
+		return nil, fmt.Errorf("failed to create local importer launcher: %w", err)
+	}
+	return &ImportLauncher{
+		component:               component,
+		importer:                importer,
+		task:                    task,
+		launcherV2Options:       *launcherV2Opts,
+		importerLauncherOptions: *importerLauncherOpts,
+		metadataClient:          metadataClient,
+	}, nil
+}
+
 func (l *ImportLauncher) Execute(ctx context.Context) (err error) {
 	defer func() {
 		if err != nil {
@@ -25,6 +25,7 @@ import (
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
 	"gocloud.dev/blob"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -109,6 +110,29 @@ func NewLauncherV2(ctx context.Context, executionID int64, executorInputJSON, co
 	}, nil
 }
 
+// NewLocalLauncherV2 creates a component launcher v2 that runs outside of a
+// Kubernetes cluster with the given ML Metadata and cache clients, e.g. in a
+// local run. There is no Kubernetes client, so the pipeline root and input
+// artifacts must not need Kubernetes secrets, e.g. file:// URIs.
+func NewLocalLauncherV2(executionID int64, executorInput *pipelinespec.ExecutorInput, component *pipelinespec.ComponentSpec, cmdArgs []string, opts *LauncherV2Options, metadataClient *metadata.Client, cacheClient *cacheutils.Client) (*LauncherV2, error) {
+	if executionID == 0 {
+		return nil, fmt.Errorf("failed to create local component launcher v2: must specify execution ID")
+	}
+	if len(cmdArgs) == 0 {
+		return nil, fmt.Errorf("failed to create local component launcher v2: command and arguments are empty")
+	}
+	return &LauncherV2{
+		executionID:    executionID,
+		executorInput:  executorInput,
+		component:      component,
+		command:        cmdArgs[0],
+		args:           cmdArgs[1:],
+		options:        *opts,
+		metadataClient: metadataClient,
+		cacheClient:    cacheClient,
+	}, nil
+}
+
 func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	defer func() {
 		if err != nil {
@@ -120,6 +144,15 @@ func (l *LauncherV2) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	defer func() {
+		// Record failed attempts, so that a retried task has the state of
+		// every attempt in MLMD.
+		if err != nil {
+			if publishErr := l.metadataClient.PublishExecution(ctx, execution, nil, nil, pb.Execution_FAILED); publishErr != nil {
+				glog.Errorf("failed to publish failed execution ID=%v: %v", execution.GetID(), publishErr)
+			}
+		}
+	}()
 	fingerPrint := execution.FingerPrint()
 	bucketConfig, err := objectstore.ParseBucketConfig(execution.GetPipeline().GetPipelineRoot())
 	if err != nil {
@@ -209,6 +242,13 @@ func (l *LauncherV2) prePublish(ctx context.Context) (execution *metadata.Execut
 		PodUID:    l.options.PodUID,
 		Namespace: l.options.Namespace,
 	}
+	// When the task has a retry policy, Argo runs a new Pod with the same
+	// execution ID for each attempt. The first attempt records its Pod info
+	// in the execution created by the driver, each later attempt gets its
+	// own execution.
+	if podUID := execution.PodUID(); podUID != "" && podUID != l.options.PodUID {
+		return l.metadataClient.CreateRetryExecution(ctx, execution, ecfg)
+	}
 	return l.metadataClient.PrePublishExecution(ctx, execution, ecfg)
 }
 
@@ -349,49 +389,78 @@ func uploadOutputArtifacts(ctx context.Context, executorInput *pipelinespec.Exec
 		if len(artifactList.Artifacts) == 0 {
 			continue
 		}
-		// TODO: Support multiple artifacts someday, probably through the v2 engine.
-		outputArtifact := artifactList.Artifacts[0]
-
-		// Merge executor output artifact info with executor input
-		if list, ok := executorOutput.Artifacts[name]; ok && len(list.Artifacts) > 0 {
-			mergeRuntimeArtifacts(list.Artifacts[0], outputArtifact)
-		}
-
-		// Upload artifacts from local path to remote storages.
-		localDir, err := localPathForURI(outputArtifact.Uri)
-		if err != nil {
-			glog.Warningf("Output Artifact %q does not have a recognized storage URI %q. Skipping uploading to remote storage.", name, outputArtifact.Uri)
-		} else {
-			blobKey, err := opts.bucketConfig.KeyFromURI(outputArtifact.Uri)
+		for _, outputArtifact := range executorOutputArtifacts(name, artifactList, executorOutput) {
+			mlmdArtifact, err := uploadOutputArtifact(ctx, name, outputArtifact, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to upload output artifact %q: %w", name, err)
-			}
-			if err := objectstore.UploadBlob(ctx, opts.bucket, localDir, blobKey); err != nil {
-				//  We allow components to not produce output files
-				if errors.Is(err, os.ErrNotExist) {
-					glog.Warningf("Local filepath %q does not exist", localDir)
-				} else {
-					return nil, fmt.Errorf("failed to upload output artifact %q to remote storage URI %q: %w", name, outputArtifact.Uri, err)
-				}
+				return nil, err
 			}
+			outputArtifacts = append(outputArtifacts, mlmdArtifact)
 		}
+	}
+	return outputArtifacts, nil
+}
 
-		// Write out the metadata.
-		metadataErr := func(err error) error {
-			return fmt.Errorf("unable to produce MLMD artifact for output %q: %w", name, err)
-		}
-		// TODO(neuromage): Consider batching these instead of recording one by one.
-		schema, err := getArtifactSchema(outputArtifact.GetType())
-		if err != nil {
-			return nil, fmt.Errorf("failed to determine schema for output %q: %w", name, err)
-		}
-		mlmdArtifact, err := opts.metadataClient.RecordArtifact(ctx, name, schema, outputArtifact, pb.Artifact_LIVE)
+// executorOutputArtifacts returns the artifacts of an output artifact list.
+// The executor may output a list of artifacts in place of the provisioned
+// artifact, artifacts in the list without a URI are placed under the URI of
+// the provisioned artifact by their index.
+func executorOutputArtifacts(name string, provisioned *pipelinespec.ArtifactList, executorOutput *pipelinespec.ExecutorOutput) []*pipelinespec.RuntimeArtifact {
+	provisionedArtifact := provisioned.Artifacts[0]
+	list := executorOutput.GetArtifacts()[name].GetArtifacts()
+	if len(list) == 0 {
+		return []*pipelinespec.RuntimeArtifact{provisionedArtifact}
+	}
+	// Merge executor output artifact info with executor input
+	if len(list) == 1 {
+		mergeRuntimeArtifacts(list[0], provisionedArtifact)
+		return []*pipelinespec.RuntimeArtifact{provisionedArtifact}
+	}
+	artifacts := make([]*pipelinespec.RuntimeArtifact, 0, len(list))
+	for i, src := range list {
+		artifact := proto.Clone(provisionedArtifact).(*pipelinespec.RuntimeArtifact)
+		artifact.Uri = fmt.Sprintf("%s/%v", strings.TrimSuffix(provisionedArtifact.GetUri(), "/"), i)
+		mergeRuntimeArtifacts(src, artifact)
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts
+}
+
+func uploadOutputArtifact(ctx context.Context, name string, outputArtifact *pipelinespec.RuntimeArtifact, opts uploadOutputArtifactsOptions) (*metadata.OutputArtifact, error) {
+	// Upload artifacts from local path to remote storages.
+	localDir, err := localPathForURI(outputArtifact.Uri)
+	if err != nil {
+		glog.Warningf("Output Artifact %q does not have a recognized storage URI %q. Skipping uploading to remote storage.", name, outputArtifact.Uri)
+	} else if objectstore.IsFileURI(outputArtifact.Uri) {
+		glog.Infof("Output Artifact %q is written in place at local path %q.", name, localDir)
+	} else {
+		blobKey, err := opts.bucketConfig.KeyFromURI(outputArtifact.Uri)
 		if err != nil {
-			return nil, metadataErr(err)
+			return nil, fmt.Errorf("failed to upload output artifact %q: %w", name, err)
+		}
+		if err := objectstore.UploadBlob(ctx, opts.bucket, localDir, blobKey); err != nil {
+			//  We allow components to not produce output files
+			if errors.Is(err, os.ErrNotExist) {
+				glog.Warningf("Local filepath %q does not exist", localDir)
+			} else {
+				return nil, fmt.Errorf("failed to upload output artifact %q to remote storage URI %q: %w", name, outputArtifact.Uri, err)
+			}
 		}
-		outputArtifacts = append(outputArtifacts, mlmdArtifact)
 	}
-	return outputArtifacts, nil
+
+	// Write out the metadata.
+	metadataErr := func(err error) error {
+		return fmt.Errorf("unable to produce MLMD artifact for output %q: %w", name, err)
+	}
+	// TODO(neuromage): Consider batching these instead of recording one by one.
+	schema, err := getArtifactSchema(outputArtifact.GetType())
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine schema for output %q: %w", name, err)
+	}
+	mlmdArtifact, err := opts.metadataClient.RecordArtifact(ctx, name, schema, outputArtifact, pb.Artifact_LIVE)
+	if err != nil {
+		return nil, metadataErr(err)
+	}
+	return mlmdArtifact, nil
 }
 
 func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.ExecutorInput, defaultBucket *blob.Bucket, defaultBucketConfig *objectstore.Config, namespace string, k8sClient *kubernetes.Clientset) error {
@@ -410,43 +479,51 @@ func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.Executor
 	}
 	for name, artifactList := range executorInput.Inputs.Artifacts {
 		// TODO(neuromage): Support concat-based placholders for arguments.
-		if len(artifactList.Artifacts) == 0 {
-			continue
-		}
-		inputArtifact := artifactList.Artifacts[0]
-		localPath, err := localPathForURI(inputArtifact.Uri)
-		if err != nil {
-			glog.Warningf("Input Artifact %q does not have a recognized storage URI %q. Skipping downloading to local path.", name, inputArtifact.Uri)
-			continue
-		}
-		// Copy artifact to local storage.
-		copyErr := func(err error) error {
-			return fmt.Errorf("failed to download input artifact %q from remote storage URI %q: %w", name, inputArtifact.Uri, err)
-		}
-		// TODO: Selectively copy artifacts for which .path was actually specified
-		// on the command line.
-		bucket := defaultBucket
-		bucketConfig := defaultBucketConfig
-		if !strings.HasPrefix(inputArtifact.Uri, defaultBucketConfig.PrefixedBucket()) {
-			nonDefaultBucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(inputArtifact.Uri)
-			if err != nil {
-				return fmt.Errorf("failed to parse bucketConfig for output artifact %q with uri %q: %w", name, inputArtifact.GetUri(), err)
-			}
-			nonDefaultBucket, ok := nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()]
-			if !ok {
-				return fmt.Errorf("failed to get bucket when downloading input artifact %s with bucket key %s: %w", name, nonDefaultBucketConfig.PrefixedBucket(), err)
+		for _, inputArtifact := range artifactList.Artifacts {
+			if err := downloadArtifact(ctx, name, inputArtifact, defaultBucket, defaultBucketConfig, nonDefaultBuckets); err != nil {
+				return err
 			}
-			bucket = nonDefaultBucket
-			bucketConfig = nonDefaultBucketConfig
 		}
-		blobKey, err := bucketConfig.KeyFromURI(inputArtifact.Uri)
+	}
+	return nil
+}
+
+func downloadArtifact(ctx context.Context, name string, inputArtifact *pipelinespec.RuntimeArtifact, defaultBucket *blob.Bucket, defaultBucketConfig *objectstore.Config, nonDefaultBuckets map[string]*blob.Bucket) error {
+	localPath, err := localPathForURI(inputArtifact.Uri)
+	if err != nil {
+		glog.Warningf("Input Artifact %q does not have a recognized storage URI %q. Skipping downloading to local path.", name, inputArtifact.Uri)
+		return nil
+	}
+	if objectstore.IsFileURI(inputArtifact.Uri) {
+		// The artifact is read in place.
+		return nil
+	}
+	// Copy artifact to local storage.
+	copyErr := func(err error) error {
+		return fmt.Errorf("failed to download input artifact %q from remote storage URI %q: %w", name, inputArtifact.Uri, err)
+	}
+	// TODO: Selectively copy artifacts for which .path was actually specified
+	// on the command line.
+	bucket := defaultBucket
+	bucketConfig := defaultBucketConfig
+	if !strings.HasPrefix(inputArtifact.Uri, defaultBucketConfig.PrefixedBucket()) {
+		nonDefaultBucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(inputArtifact.Uri)
 		if err != nil {
-			return copyErr(err)
+			return fmt.Errorf("failed to parse bucketConfig for output artifact %q with uri %q: %w", name, inputArtifact.GetUri(), err)
 		}
-		if err := objectstore.DownloadBlob(ctx, bucket, localPath, blobKey); err != nil {
-			return copyErr(err)
+		nonDefaultBucket, ok := nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()]
+		if !ok {
+			return fmt.Errorf("failed to get bucket when downloading input artifact %s with bucket key %s: %w", name, nonDefaultBucketConfig.PrefixedBucket(), err)
 		}
-
+		bucket = nonDefaultBucket
+		bucketConfig = nonDefaultBucketConfig
+	}
+	blobKey, err := bucketConfig.KeyFromURI(inputArtifact.Uri)
+	if err != nil {
+		return copyErr(err)
+	}
+	if err := objectstore.DownloadBlob(ctx, bucket, localPath, blobKey); err != nil {
+		return copyErr(err)
 	}
 	return nil
 }
@@ -454,26 +531,26 @@ func downloadArtifacts(ctx context.Context, executorInput *pipelinespec.Executor
 func fetchNonDefaultBuckets(ctx context.Context, artifacts map[string]*pipelinespec.ArtifactList, defaultBucketConfig *objectstore.Config, namespace string, k8sClient *kubernetes.Clientset) (buckets map[string]*blob.Bucket, err error) {
 	nonDefaultBuckets := make(map[string]*blob.Bucket)
 	for name, artifactList := range artifacts {
-		if len(artifactList.Artifacts) == 0 {
-			continue
-		}
-		// TODO: Support multiple artifacts someday, probably through the v2 engine.
-		artifact := artifactList.Artifacts[0]
-		if !strings.HasPrefix(artifact.Uri, defaultBucketConfig.PrefixedBucket()) {
+		for _, artifact := range artifactList.Artifacts {
+			if strings.HasPrefix(artifact.Uri, defaultBucketConfig.PrefixedBucket()) || objectstore.IsFileURI(artifact.Uri) {
+				continue
+			}
 			nonDefaultBucketConfig, err := objectstore.ParseBucketConfigForArtifactURI(artifact.Uri)
 			if err != nil {
 				return nonDefaultBuckets, fmt.Errorf("failed to parse bucketConfig for output artifact %q with uri %q: %w", name, artifact.GetUri(), err)
 			}
+			if _, ok := nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()]; ok {
+				// The artifacts of a list are usually in the same bucket.
+				continue
+			}
 			nonDefaultBucket, err := objectstore.OpenBucket(ctx, k8sClient, namespace, nonDefaultBucketConfig)
 			if err != nil {
 				return nonDefaultBuckets, fmt.Errorf("failed to open bucket for output artifact %q with uri %q: %w", name, artifact.GetUri(), err)
 			}
 			nonDefaultBuckets[nonDefaultBucketConfig.PrefixedBucket()] = nonDefaultBucket
 		}
-
 	}
 	return nonDefaultBuckets, nil
-
 }
 
 // Add executor input placeholders to provided map.
@@ -633,13 +710,17 @@ func localPathForURI(uri string) (string, error) {
 	if strings.HasPrefix(uri, "s3://") {
 		return "/s3/" + strings.TrimPrefix(uri, "s3://"), nil
 	}
+	if objectstore.IsFileURI(uri) {
+		// Artifacts on the local filesystem are stored at their path.
+		return strings.TrimPrefix(uri, "file://"), nil
+	}
 	return "", fmt.Errorf("failed to generate local path for URI %s: unsupported storage scheme", uri)
 }
 
 func prepareOutputFolders(executorInput *pipelinespec.ExecutorInput) error {
 	for name, parameter := range executorInput.GetOutputs().GetParameters() {
 		dir := filepath.Dir(parameter.OutputFile)
-		if err := os.MkdirAll(dir, 0644); err != nil {
+		if err := os.MkdirAll(dir, 0755); err != nil {
 			return fmt.Errorf("failed to create directory %q for output parameter %q: %w", dir, name, err)
 		}
 	}
@@ -655,7 +736,7 @@ func prepareOutputFolders(executorInput *pipelinespec.ExecutorInput) error {
 			return fmt.Errorf("failed to generate local storage path for output artifact %q: %w", name, err)
 		}
 
-		if err := os.MkdirAll(filepath.Dir(localPath), 0644); err != nil {
+		if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
 			return fmt.Errorf("unable to create directory %q for output artifact %q: %w", filepath.Dir(localPath), name, err)
 		}
 	}
@@ -0,0 +1,101 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func provisionedModel() *pipelinespec.ArtifactList {
+	return &pipelinespec.ArtifactList{
+		Artifacts: []*pipelinespec.RuntimeArtifact{{
+			Uri:  "gs://my-bucket/root/task/model",
+			Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_SchemaTitle{SchemaTitle: "system.Model"}},
+		}},
+	}
+}
+
+func Test_executorOutputArtifacts(t *testing.T) {
+	// No executor output, the provisioned artifact is the output.
+	artifacts := executorOutputArtifacts("model", provisionedModel(), &pipelinespec.ExecutorOutput{})
+	assert.Len(t, artifacts, 1)
+	assert.Equal(t, "gs://my-bucket/root/task/model", artifacts[0].GetUri())
+
+	// A single executor output artifact is merged into the provisioned one.
+	metadata, err := structpb.NewStruct(map[string]interface{}{"accuracy": 0.9})
+	assert.Nil(t, err)
+	artifacts = executorOutputArtifacts("model", provisionedModel(), &pipelinespec.ExecutorOutput{
+		Artifacts: map[string]*pipelinespec.ArtifactList{
+			"model": {Artifacts: []*pipelinespec.RuntimeArtifact{{Metadata: metadata}}},
+		},
+	})
+	assert.Len(t, artifacts, 1)
+	assert.Equal(t, "gs://my-bucket/root/task/model", artifacts[0].GetUri())
+	assert.Equal(t, 0.9, artifacts[0].GetMetadata().GetFields()["accuracy"].GetNumberValue())
+
+	// A list of executor output artifacts, placed under the provisioned URI
+	// unless they have a URI of their own.
+	artifacts = executorOutputArtifacts("model", provisionedModel(), &pipelinespec.ExecutorOutput{
+		Artifacts: map[string]*pipelinespec.ArtifactList{
+			"model": {Artifacts: []*pipelinespec.RuntimeArtifact{
+				{},
+				{Uri: "gs://my-bucket/root/task/model/custom"},
+				{Metadata: metadata},
+			}},
+		},
+	})
+	var uris []string
+	for _, artifact := range artifacts {
+		uris = append(uris, artifact.GetUri())
+		assert.Equal(t, "system.Model", artifact.GetType().GetSchemaTitle())
+	}
+	assert.Equal(t, []string{
+		"gs://my-bucket/root/task/model/0",
+		"gs://my-bucket/root/task/model/custom",
+		"gs://my-bucket/root/task/model/2",
+	}, uris)
+	assert.Nil(t, artifacts[0].GetMetadata())
+	assert.Equal(t, 0.9, artifacts[2].GetMetadata().GetFields()["accuracy"].GetNumberValue())
+}
+
+func Test_localPathForURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{uri: "gs://my-bucket/root/task/model", want: "/gcs/my-bucket/root/task/model"},
+		{uri: "minio://mlpipeline/root/task/model", want: "/minio/mlpipeline/root/task/model"},
+		{uri: "s3://my-bucket/root/task/model", want: "/s3/my-bucket/root/task/model"},
+		// Artifacts on the local filesystem are used in place.
+		{uri: "file:///mnt/pipeline-root/task/model", want: "/mnt/pipeline-root/task/model"},
+		{uri: "https://example.com/model", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			got, err := localPathForURI(test.uri)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
+	k8score "k8s.io/api/core/v1"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -15,8 +18,21 @@ const (
 	configMapName                = "kfp-launcher"
 	defaultPipelineRoot          = "minio://mlpipeline/v2/artifacts"
 	configKeyDefaultPipelineRoot = "defaultPipelineRoot"
+	configKeyAcceleratorMapping  = "acceleratorMapping"
 )
 
+// AcceleratorConfig describes how to request an accelerator type from
+// Kubernetes.
+type AcceleratorConfig struct {
+	// The extended resource whose limit is set to the number of accelerators,
+	// e.g. "nvidia.com/gpu".
+	ResourceName string `json:"resourceName"`
+	// Node selectors to schedule the pod on nodes with this accelerator type.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations of the taints of the nodes with this accelerator type.
+	Tolerations []k8score.Toleration `json:"tolerations,omitempty"`
+}
+
 // Config is the KFP runtime configuration.
 type Config struct {
 	data map[string]string
@@ -45,6 +61,43 @@ func (c *Config) DefaultPipelineRoot() string {
 	return c.data[configKeyDefaultPipelineRoot]
 }
 
+// Config.AcceleratorConfig gets how to request an accelerator type. The
+// key acceleratorMapping of launcher config is an optional YAML map from
+// accelerator types to their AcceleratorConfig, it takes precedence over the
+// default mapping.
+func (c *Config) AcceleratorConfig(acceleratorType string) (*AcceleratorConfig, error) {
+	if c != nil && c.data[configKeyAcceleratorMapping] != "" {
+		mapping := make(map[string]*AcceleratorConfig)
+		if err := yaml.Unmarshal([]byte(c.data[configKeyAcceleratorMapping]), &mapping); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of launcher config: %w", configKeyAcceleratorMapping, err)
+		}
+		if accelerator, ok := mapping[acceleratorType]; ok {
+			if accelerator == nil || accelerator.ResourceName == "" {
+				return nil, fmt.Errorf("resourceName of accelerator type %q is required in %s of launcher config", acceleratorType, configKeyAcceleratorMapping)
+			}
+			return accelerator, nil
+		}
+	}
+	return defaultAcceleratorConfig(acceleratorType)
+}
+
+// defaultAcceleratorConfig maps the accelerator types of KFP SDK, such as
+// NVIDIA_TESLA_T4 or TPU_V3, to their resource names. Accelerator types that
+// are already resource names, such as nvidia.com/gpu, are used as is.
+func defaultAcceleratorConfig(acceleratorType string) (*AcceleratorConfig, error) {
+	switch {
+	case strings.Contains(acceleratorType, "/"):
+		return &AcceleratorConfig{ResourceName: acceleratorType}, nil
+	case strings.HasPrefix(acceleratorType, "NVIDIA_"):
+		return &AcceleratorConfig{ResourceName: "nvidia.com/gpu"}, nil
+	case strings.HasPrefix(acceleratorType, "TPU_"):
+		version := strings.ToLower(strings.TrimPrefix(acceleratorType, "TPU_"))
+		return &AcceleratorConfig{ResourceName: "cloud-tpus.google.com/" + version}, nil
+	default:
+		return nil, fmt.Errorf("unknown accelerator type %q, it can be configured in %s of launcher config", acceleratorType, configKeyAcceleratorMapping)
+	}
+}
+
 // InPodNamespace gets current namespace from inside a Kubernetes Pod.
 func InPodNamespace() (string, error) {
 	// The path is available in Pods.
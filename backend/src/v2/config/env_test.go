@@ -0,0 +1,91 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8score "k8s.io/api/core/v1"
+)
+
+func TestAcceleratorConfig_Default(t *testing.T) {
+	tests := []struct {
+		acceleratorType string
+		want            string
+	}{
+		{acceleratorType: "NVIDIA_TESLA_T4", want: "nvidia.com/gpu"},
+		{acceleratorType: "TPU_V3", want: "cloud-tpus.google.com/v3"},
+		{acceleratorType: "amd.com/gpu", want: "amd.com/gpu"},
+	}
+	for _, test := range tests {
+		t.Run(test.acceleratorType, func(t *testing.T) {
+			// Default config is nil when there is no launcher config.
+			var config *Config
+			accelerator, err := config.AcceleratorConfig(test.acceleratorType)
+			assert.Nil(t, err)
+			assert.Equal(t, &AcceleratorConfig{ResourceName: test.want}, accelerator)
+		})
+	}
+}
+
+func TestAcceleratorConfig_UnknownType(t *testing.T) {
+	config := &Config{data: map[string]string{}}
+	_, err := config.AcceleratorConfig("UNKNOWN")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown accelerator type")
+}
+
+func TestAcceleratorConfig_Mapping(t *testing.T) {
+	config := &Config{data: map[string]string{
+		configKeyAcceleratorMapping: `
+NVIDIA_TESLA_T4:
+  resourceName: nvidia.com/gpu
+  nodeSelector:
+    cloud.google.com/gke-accelerator: nvidia-tesla-t4
+  tolerations:
+  - key: nvidia.com/gpu
+    operator: Exists
+    effect: NoSchedule
+`,
+	}}
+	accelerator, err := config.AcceleratorConfig("NVIDIA_TESLA_T4")
+	assert.Nil(t, err)
+	assert.Equal(t, &AcceleratorConfig{
+		ResourceName: "nvidia.com/gpu",
+		NodeSelector: map[string]string{"cloud.google.com/gke-accelerator": "nvidia-tesla-t4"},
+		Tolerations: []k8score.Toleration{{
+			Key:      "nvidia.com/gpu",
+			Operator: k8score.TolerationOpExists,
+			Effect:   k8score.TaintEffectNoSchedule,
+		}},
+	}, accelerator)
+
+	// Types that are not in the mapping use the default mapping.
+	accelerator, err = config.AcceleratorConfig("TPU_V2")
+	assert.Nil(t, err)
+	assert.Equal(t, &AcceleratorConfig{ResourceName: "cloud-tpus.google.com/v2"}, accelerator)
+}
+
+func TestAcceleratorConfig_InvalidMapping(t *testing.T) {
+	config := &Config{data: map[string]string{configKeyAcceleratorMapping: "NVIDIA_TESLA_T4: [nvidia.com/gpu]"}}
+	_, err := config.AcceleratorConfig("NVIDIA_TESLA_T4")
+	assert.NotNil(t, err)
+
+	config = &Config{data: map[string]string{configKeyAcceleratorMapping: "NVIDIA_TESLA_T4: {}"}}
+	_, err = config.AcceleratorConfig("NVIDIA_TESLA_T4")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "resourceName")
+}
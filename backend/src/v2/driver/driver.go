@@ -2,24 +2,31 @@ package driver
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
 	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
 	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
 	"github.com/kubeflow/pipelines/backend/src/v2/component"
 	"github.com/kubeflow/pipelines/backend/src/v2/config"
 	"github.com/kubeflow/pipelines/backend/src/v2/expression"
 	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
 	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
 	k8score "k8s.io/api/core/v1"
 	k8sres "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -46,6 +53,17 @@ type Options struct {
 
 	// optional, required only by container driver
 	Container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec
+
+	// optional, only used by container driver, the kubernetes platform config
+	// of the container executor
+	KubernetesExecutorConfig *kubernetesplatform.KubernetesExecutorConfig
+
+	// optional, required only by resolver driver
+	Resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec
+
+	// optional, how input parameter values are converted to the parameter
+	// types, parameter.CoercionToString if not provided
+	ParameterCoercion parameter.CoercionPolicy
 }
 
 // Identifying information used for error messages
@@ -105,15 +123,7 @@ func RootDAG(ctx context.Context, opts Options, mlmd *metadata.Client) (executio
 	if pipelineRoot != "" {
 		glog.Infof("PipelineRoot=%q", pipelineRoot)
 	} else {
-		restConfig, err := rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize kubernetes client: %w", err)
-		}
-		k8sClient, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize kubernetes client set: %w", err)
-		}
-		cfg, err := config.FromConfigMap(ctx, k8sClient, opts.Namespace)
+		cfg, err := launcherConfig(ctx, opts.Namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -125,12 +135,19 @@ func RootDAG(ctx context.Context, opts Options, mlmd *metadata.Client) (executio
 	if err != nil {
 		return nil, err
 	}
+	inputParameters := opts.Component.GetInputDefinitions().GetParameters()
+	if unknown := parameter.Unknown(opts.RuntimeConfig.GetParameterValues(), inputParameters); len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown pipeline input parameters %q", unknown)
+	}
+	parameterValues, err := parameter.Resolve(opts.RuntimeConfig.GetParameterValues(), inputParameters, opts.ParameterCoercion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pipeline input parameters: %w", err)
+	}
 	executorInput := &pipelinespec.ExecutorInput{
 		Inputs: &pipelinespec.ExecutorInput_Inputs{
-			ParameterValues: opts.RuntimeConfig.GetParameterValues(),
+			ParameterValues: parameterValues,
 		},
 	}
-	// TODO(Bobgy): validate executorInput matches component spec types
 	ecfg, err := metadata.GenerateExecutionConfig(executorInput)
 	if err != nil {
 		return nil, err
@@ -213,7 +230,7 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 	if err != nil {
 		return nil, err
 	}
-	inputs, err := resolveInputs(ctx, dag, iterationIndex, pipeline, opts.Task, opts.Component.GetInputDefinitions(), mlmd, expr)
+	inputs, err := resolveInputs(ctx, dag, iterationIndex, pipeline, opts.Task, opts.Component.GetInputDefinitions(), mlmd, expr, opts.ParameterCoercion)
 	if err != nil {
 		return nil, err
 	}
@@ -285,17 +302,45 @@ func Container(ctx context.Context, opts Options, mlmd *metadata.Client, cacheCl
 		return execution, nil
 	}
 
-	execution.PodSpecPatch, err = makePodSpecPatch(opts.Container, opts.Component, executorInput, execution.ID, opts.PipelineName, opts.RunID)
+	var accelerator *config.AcceleratorConfig
+	if acceleratorType := opts.Container.GetResources().GetAccelerator().GetType(); acceleratorType != "" {
+		cfg, err := launcherConfig(ctx, opts.Namespace)
+		if err != nil {
+			return execution, err
+		}
+		accelerator, err = cfg.AcceleratorConfig(acceleratorType)
+		if err != nil {
+			return execution, err
+		}
+	}
+	execution.PodSpecPatch, err = makePodSpecPatch(opts.Container, opts.Component, executorInput, execution.ID, opts.PipelineName, opts.RunID, accelerator, opts.KubernetesExecutorConfig)
 	if err != nil {
 		return execution, err
 	}
 	return execution, nil
 }
 
+// launcherConfig loads the kfp-launcher config of a namespace.
+func launcherConfig(ctx context.Context, namespace string) (*config.Config, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize kubernetes client: %w", err)
+	}
+	k8sClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize kubernetes client set: %w", err)
+	}
+	return config.FromConfigMap(ctx, k8sClient, namespace)
+}
+
 // makePodSpecPatch generates a strategic merge patch for pod spec, it is merged
 // to container base template generated in compiler/container.go. Therefore, only
 // dynamic values are patched here. The volume mounts / configmap mounts are
 // defined in compiler, because they are static.
+// accelerator is how the accelerator type of the container is requested, it is
+// required only when the container requests accelerators.
+// kubernetesExecutorConfig is optional, it extends the pod spec with volumes,
+// secrets, node selectors etc.
 func makePodSpecPatch(
 	container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec,
 	componentSpec *pipelinespec.ComponentSpec,
@@ -303,6 +348,8 @@ func makePodSpecPatch(
 	executionID int64,
 	pipelineName string,
 	runID string,
+	accelerator *config.AcceleratorConfig,
+	kubernetesExecutorConfig *kubernetesplatform.KubernetesExecutorConfig,
 ) (string, error) {
 	executorInputJSON, err := protojson.Marshal(executorInput)
 	if err != nil {
@@ -338,7 +385,8 @@ func makePodSpecPatch(
 		"--", // separater before user command and args
 	}
 	res := k8score.ResourceRequirements{
-		Limits: map[k8score.ResourceName]k8sres.Quantity{},
+		Limits:   map[k8score.ResourceName]k8sres.Quantity{},
+		Requests: map[k8score.ResourceName]k8sres.Quantity{},
 	}
 	memoryLimit := container.GetResources().GetMemoryLimit()
 	if memoryLimit != 0 {
@@ -348,6 +396,14 @@ func makePodSpecPatch(
 		}
 		res.Limits[k8score.ResourceMemory] = q
 	}
+	memoryRequest := container.GetResources().GetMemoryRequest()
+	if memoryRequest != 0 {
+		q, err := k8sres.ParseQuantity(fmt.Sprintf("%vG", memoryRequest))
+		if err != nil {
+			return "", err
+		}
+		res.Requests[k8score.ResourceMemory] = q
+	}
 	cpuLimit := container.GetResources().GetCpuLimit()
 	if cpuLimit != 0 {
 		q, err := k8sres.ParseQuantity(fmt.Sprintf("%v", cpuLimit))
@@ -356,18 +412,40 @@ func makePodSpecPatch(
 		}
 		res.Limits[k8score.ResourceCPU] = q
 	}
-	accelerator := container.GetResources().GetAccelerator()
-	if accelerator != nil {
-		return "", fmt.Errorf("accelerator resources are not supported yet: https://github.com/kubeflow/pipelines/issues/7043")
+	cpuRequest := container.GetResources().GetCpuRequest()
+	if cpuRequest != 0 {
+		q, err := k8sres.ParseQuantity(fmt.Sprintf("%v", cpuRequest))
+		if err != nil {
+			return "", err
+		}
+		res.Requests[k8score.ResourceCPU] = q
 	}
-	podSpec := &k8score.PodSpec{
-		Containers: []k8score.Container{{
-			Name:      "main", // argo task user container is always called "main"
-			Command:   launcherCmd,
-			Args:      userCmdArgs,
-			Image:     container.Image,
-			Resources: res,
-		}},
+	podSpec := &k8score.PodSpec{}
+	acceleratorCount := container.GetResources().GetAccelerator().GetCount()
+	if acceleratorCount > 0 {
+		if accelerator == nil {
+			return "", fmt.Errorf("failed to make podSpecPatch: accelerator type %q is not configured", container.GetResources().GetAccelerator().GetType())
+		}
+		res.Limits[k8score.ResourceName(accelerator.ResourceName)] = *k8sres.NewQuantity(acceleratorCount, k8sres.DecimalSI)
+		for key, value := range accelerator.NodeSelector {
+			if podSpec.NodeSelector == nil {
+				podSpec.NodeSelector = make(map[string]string)
+			}
+			podSpec.NodeSelector[key] = value
+		}
+		podSpec.Tolerations = append(podSpec.Tolerations, accelerator.Tolerations...)
+	}
+	podSpec.Containers = []k8score.Container{{
+		Name:      "main", // argo task user container is always called "main"
+		Command:   launcherCmd,
+		Args:      userCmdArgs,
+		Image:     container.Image,
+		Resources: res,
+	}}
+	if kubernetesExecutorConfig != nil {
+		if err := extendPodSpecPatch(podSpec, kubernetesExecutorConfig); err != nil {
+			return "", fmt.Errorf("failed to make podSpecPatch: %w", err)
+		}
 	}
 	podSpecPatchBytes, err := json.Marshal(podSpec)
 	if err != nil {
@@ -376,6 +454,131 @@ func makePodSpecPatch(
 	return string(podSpecPatchBytes), nil
 }
 
+// extendPodSpecPatch extends the pod spec patch of a container with its
+// kubernetes platform config. Pod labels and annotations are not part of the
+// pod spec, they are set by the compiler.
+func extendPodSpecPatch(podSpec *k8score.PodSpec, kubernetesExecutorConfig *kubernetesplatform.KubernetesExecutorConfig) error {
+	container := &podSpec.Containers[0]
+	for _, secretAsVolume := range kubernetesExecutorConfig.GetSecretAsVolume() {
+		if secretAsVolume.GetSecretName() == "" || secretAsVolume.GetMountPath() == "" {
+			return fmt.Errorf("secret_name and mount_path of secret_as_volume are required")
+		}
+		volumeName := podVolumeName("secret", secretAsVolume.GetSecretName())
+		if findVolume(podSpec, volumeName) == nil {
+			podSpec.Volumes = append(podSpec.Volumes, k8score.Volume{
+				Name: volumeName,
+				VolumeSource: k8score.VolumeSource{
+					Secret: &k8score.SecretVolumeSource{SecretName: secretAsVolume.GetSecretName()},
+				},
+			})
+		}
+		container.VolumeMounts = append(container.VolumeMounts, k8score.VolumeMount{
+			Name:      volumeName,
+			MountPath: secretAsVolume.GetMountPath(),
+		})
+	}
+	for _, secretAsEnv := range kubernetesExecutorConfig.GetSecretAsEnv() {
+		if secretAsEnv.GetSecretName() == "" {
+			return fmt.Errorf("secret_name of secret_as_env is required")
+		}
+		for _, keyToEnv := range secretAsEnv.GetKeyToEnv() {
+			if keyToEnv.GetSecretKey() == "" || keyToEnv.GetEnvVar() == "" {
+				return fmt.Errorf("secret_key and env_var of secret %q are required", secretAsEnv.GetSecretName())
+			}
+			container.Env = append(container.Env, k8score.EnvVar{
+				Name: keyToEnv.GetEnvVar(),
+				ValueFrom: &k8score.EnvVarSource{
+					SecretKeyRef: &k8score.SecretKeySelector{
+						LocalObjectReference: k8score.LocalObjectReference{Name: secretAsEnv.GetSecretName()},
+						Key:                  keyToEnv.GetSecretKey(),
+					},
+				},
+			})
+		}
+	}
+	for _, pvcMount := range kubernetesExecutorConfig.GetPvcMount() {
+		if pvcMount.GetClaimName() == "" || pvcMount.GetMountPath() == "" {
+			return fmt.Errorf("claim_name and mount_path of pvc_mount are required")
+		}
+		volumeName := podVolumeName("pvc", pvcMount.GetClaimName())
+		if volume := findVolume(podSpec, volumeName); volume != nil {
+			// The claim is read-only only if every mount is read-only.
+			if !pvcMount.GetReadOnly() {
+				volume.PersistentVolumeClaim.ReadOnly = false
+			}
+		} else {
+			podSpec.Volumes = append(podSpec.Volumes, k8score.Volume{
+				Name: volumeName,
+				VolumeSource: k8score.VolumeSource{
+					PersistentVolumeClaim: &k8score.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvcMount.GetClaimName(),
+						ReadOnly:  pvcMount.GetReadOnly(),
+					},
+				},
+			})
+		}
+		container.VolumeMounts = append(container.VolumeMounts, k8score.VolumeMount{
+			Name:      volumeName,
+			MountPath: pvcMount.GetMountPath(),
+			ReadOnly:  pvcMount.GetReadOnly(),
+		})
+	}
+	if labels := kubernetesExecutorConfig.GetNodeSelector().GetLabels(); len(labels) > 0 {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = make(map[string]string)
+		}
+		for key, value := range labels {
+			podSpec.NodeSelector[key] = value
+		}
+	}
+	for _, toleration := range kubernetesExecutorConfig.GetTolerations() {
+		podSpec.Tolerations = append(podSpec.Tolerations, k8score.Toleration{
+			Key:               toleration.GetKey(),
+			Operator:          k8score.TolerationOperator(toleration.GetOperator()),
+			Value:             toleration.GetValue(),
+			Effect:            k8score.TaintEffect(toleration.GetEffect()),
+			TolerationSeconds: toleration.TolerationSeconds,
+		})
+	}
+	for _, imagePullSecret := range kubernetesExecutorConfig.GetImagePullSecret() {
+		if imagePullSecret.GetSecretName() == "" {
+			return fmt.Errorf("secret_name of image_pull_secret is required")
+		}
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, k8score.LocalObjectReference{Name: imagePullSecret.GetSecretName()})
+	}
+	return nil
+}
+
+// podVolumeName returns the name of the pod volume of a secret or a claim, so
+// that mounting the same source twice reuses its volume. Volume names are
+// DNS-1123 labels, but secret and claim names may contain dots and be longer:
+// such names are sanitized and suffixed with a hash of the source name, so
+// that different sources never share a volume.
+func podVolumeName(kind string, sourceName string) string {
+	name := kind + "-" + sourceName
+	if len(name) <= validation.DNS1123LabelMaxLength && len(validation.IsDNS1123Label(name)) == 0 {
+		return name
+	}
+	sanitized := strings.Trim(invalidVolumeNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(sourceName)))[:8]
+	if maxLength := validation.DNS1123LabelMaxLength - len(hash) - 1; len(sanitized) > maxLength {
+		sanitized = strings.TrimRight(sanitized[:maxLength], "-")
+	}
+	return sanitized + "-" + hash
+}
+
+var invalidVolumeNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// findVolume returns the volume of the pod spec with the given name, or nil.
+func findVolume(podSpec *k8score.PodSpec, name string) *k8score.Volume {
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == name {
+			return &podSpec.Volumes[i]
+		}
+	}
+	return nil
+}
+
 // TODO(Bobgy): merge DAG driver and container driver, because they are very similar.
 func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
 	defer func() {
@@ -407,7 +610,7 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	if err != nil {
 		return nil, err
 	}
-	inputs, err := resolveInputs(ctx, dag, iterationIndex, pipeline, opts.Task, opts.Component.GetInputDefinitions(), mlmd, expr)
+	inputs, err := resolveInputs(ctx, dag, iterationIndex, pipeline, opts.Task, opts.Component.GetInputDefinitions(), mlmd, expr, opts.ParameterCoercion)
 	if err != nil {
 		return nil, err
 	}
@@ -433,8 +636,16 @@ func DAG(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *E
 	ecfg.ParentDagID = dag.Execution.GetID()
 	ecfg.IterationIndex = iterationIndex
 	ecfg.NotTriggered = !execution.WillTrigger()
-	if opts.Task.GetArtifactIterator() != nil {
-		return execution, fmt.Errorf("ArtifactIterator is not implemented")
+	// Fan out iterations over the artifacts of an artifact list
+	if execution.WillTrigger() && opts.Task.GetArtifactIterator() != nil && opts.IterationIndex < 0 {
+		iterator := opts.Task.GetArtifactIterator()
+		artifactList, ok := executorInput.GetInputs().GetArtifacts()[iterator.GetItems().GetInputArtifact()]
+		if !ok {
+			return execution, fmt.Errorf("iterating on item input %q failed: cannot find input artifact %q", iterator.GetItemInput(), iterator.GetItems().GetInputArtifact())
+		}
+		count := len(artifactList.GetArtifacts())
+		ecfg.IterationCount = &count
+		execution.IterationCount = &count
 	}
 	isIterator := opts.Task.GetParameterIterator() != nil && opts.IterationIndex < 0
 	// Fan out iterations
@@ -543,12 +754,14 @@ func collectOutputArtifactMetadataFromCache(ctx context.Context, executorInput *
 			continue
 		}
 		artifact := artifactList.Artifacts[0]
-		outputArtifact, ok := outputArtifacts[name]
+		cachedArtifacts, ok := outputArtifacts[name]
 		if !ok {
 			return nil, fmt.Errorf("unable to find artifact with name %v in mlmd output artifacts", name)
 		}
-		outputArtifact.Schema = artifact.GetType().GetInstanceSchema()
-		registeredMLMDArtifacts = append(registeredMLMDArtifacts, outputArtifact)
+		for _, outputArtifact := range cachedArtifacts {
+			outputArtifact.Schema = artifact.GetType().GetInstanceSchema()
+			registeredMLMDArtifacts = append(registeredMLMDArtifacts, outputArtifact)
+		}
 	}
 	return registeredMLMDArtifacts, nil
 
@@ -617,7 +830,7 @@ func validateNonRoot(opts Options) error {
 	return nil
 }
 
-func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int, pipeline *metadata.Pipeline, task *pipelinespec.PipelineTaskSpec, inputsSpec *pipelinespec.ComponentInputsSpec, mlmd *metadata.Client, expr *expression.Expr) (inputs *pipelinespec.ExecutorInput_Inputs, err error) {
+func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int, pipeline *metadata.Pipeline, task *pipelinespec.PipelineTaskSpec, inputsSpec *pipelinespec.ComponentInputsSpec, mlmd *metadata.Client, expr *expression.Expr, coercion parameter.CoercionPolicy) (inputs *pipelinespec.ExecutorInput_Inputs, err error) {
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("failed to resolve inputs: %w", err)
@@ -656,7 +869,9 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		return nil
 	}
 	handleParamTypeValidationAndConversion := func() error {
-		// TODO(Bobgy): verify whether there are inputs not in the inputs spec.
+		// Parameters not in the inputs spec are kept as they are, the spec of
+		// each task's inputs is validated at compile time.
+		specs := make(map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec)
 		for name, spec := range inputsSpec.GetParameters() {
 			if task.GetParameterIterator() != nil {
 				if !isIterationDriver && task.GetParameterIterator().GetItemInput() == name {
@@ -670,59 +885,13 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 					continue
 				}
 			}
-			value, hasValue := inputs.GetParameterValues()[name]
-			if !hasValue {
-				if spec.GetDefaultValue() == nil {
-					return fmt.Errorf("input parameter %q is required", name)
-				}
-				inputs.GetParameterValues()[name] = spec.GetDefaultValue()
-				value = spec.GetDefaultValue()
-			}
-			switch spec.GetParameterType() {
-			case pipelinespec.ParameterType_STRING:
-				_, isValueString := value.GetKind().(*structpb.Value_StringValue)
-				if !isValueString {
-					// TODO(Bobgy): discuss whether we want to allow auto type conversion
-					// all parameter types can be consumed as JSON string
-					text, err := metadata.PbValueToText(value)
-					if err != nil {
-						return fmt.Errorf("converting input parameter %q to string: %w", name, err)
-					}
-					inputs.GetParameterValues()[name] = structpb.NewStringValue(text)
-				}
-			default:
-				typeMismatch := func(actual string) error {
-					return fmt.Errorf("input parameter %q type mismatch: expect %s, got %s", name, spec.GetParameterType(), actual)
-				}
-				switch v := value.GetKind().(type) {
-				case *structpb.Value_NullValue:
-					return fmt.Errorf("got null for input parameter %q", name)
-				case *structpb.Value_StringValue:
-					// TODO(Bobgy): consider whether we support parsing string as JSON for any other types.
-					if spec.GetParameterType() != pipelinespec.ParameterType_STRING {
-						return typeMismatch("string")
-					}
-				case *structpb.Value_NumberValue:
-					if spec.GetParameterType() != pipelinespec.ParameterType_NUMBER_DOUBLE && spec.GetParameterType() != pipelinespec.ParameterType_NUMBER_INTEGER {
-						return typeMismatch("number")
-					}
-				case *structpb.Value_BoolValue:
-					if spec.GetParameterType() != pipelinespec.ParameterType_BOOLEAN {
-						return typeMismatch("bool")
-					}
-				case *structpb.Value_ListValue:
-					if spec.GetParameterType() != pipelinespec.ParameterType_LIST {
-						return typeMismatch("list")
-					}
-				case *structpb.Value_StructValue:
-					if spec.GetParameterType() != pipelinespec.ParameterType_STRUCT {
-						return typeMismatch("struct")
-					}
-				default:
-					return fmt.Errorf("unknown protobuf.Value type: %T", v)
-				}
-			}
+			specs[name] = spec
 		}
+		parameterValues, err := parameter.Resolve(inputs.GetParameterValues(), specs, coercion)
+		if err != nil {
+			return err
+		}
+		inputs.ParameterValues = parameterValues
 		return nil
 	}
 	// this function has many branches, so it's hard to add more postprocess steps
@@ -746,7 +915,15 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		inputs.Artifacts = artifacts
 		switch {
 		case task.GetArtifactIterator() != nil:
-			return nil, fmt.Errorf("artifact iterator not implemented yet")
+			itemsInput := task.GetArtifactIterator().GetItems().GetInputArtifact()
+			items := inputs.Artifacts[itemsInput].GetArtifacts()
+			if *iterationIndex >= len(items) {
+				return nil, fmt.Errorf("bug: %v artifacts found, but getting index %v", len(items), *iterationIndex)
+			}
+			delete(inputs.Artifacts, itemsInput)
+			inputs.Artifacts[task.GetArtifactIterator().GetItemInput()] = &pipelinespec.ArtifactList{
+				Artifacts: []*pipelinespec.RuntimeArtifact{items[*iterationIndex]},
+			}
 		case task.GetParameterIterator() != nil:
 			var itemsInput string
 			if task.GetParameterIterator().GetItems().GetInputParameter() != "" {
@@ -785,6 +962,19 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		tasksCache = tasks
 		return tasks, nil
 	}
+	// get input artifacts of the parent DAG on demand
+	var dagArtifactsCache map[string]*pipelinespec.ArtifactList
+	getDAGArtifacts := func() (map[string]*pipelinespec.ArtifactList, error) {
+		if dagArtifactsCache != nil {
+			return dagArtifactsCache, nil
+		}
+		artifacts, err := mlmd.GetInputArtifactsByExecutionID(ctx, dag.Execution.GetID())
+		if err != nil {
+			return nil, err
+		}
+		dagArtifactsCache = artifacts
+		return artifacts, nil
+	}
 	for name, paramSpec := range task.GetInputs().GetParameters() {
 		paramError := func(err error) error {
 			return fmt.Errorf("resolving input parameter %s with spec %s: %w", name, paramSpec, err)
@@ -835,8 +1025,24 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 				return nil, paramError(fmt.Errorf("param runtime value spec of type %T not implemented", t))
 			}
 
-		// TODO(Bobgy): implement the following cases
-		// case *pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_:
+		case *pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_:
+			producerTask := paramSpec.GetTaskFinalStatus().GetProducerTask()
+			if producerTask == "" {
+				return nil, paramError(fmt.Errorf("producer task is empty"))
+			}
+			tasks, err := getDAGTasks()
+			if err != nil {
+				return nil, paramError(err)
+			}
+			producer, ok := tasks[producerTask]
+			if !ok {
+				return nil, paramError(fmt.Errorf("cannot find producer task %q", producerTask))
+			}
+			finalStatus, err := taskFinalStatus(ctx, mlmd, pipeline, producerTask, producer)
+			if err != nil {
+				return nil, paramError(err)
+			}
+			inputs.ParameterValues[name] = finalStatus
 		default:
 			return nil, paramError(fmt.Errorf("parameter spec of type %T not implemented yet", t))
 		}
@@ -847,7 +1053,21 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 		}
 		switch t := artifactSpec.Kind.(type) {
 		case *pipelinespec.TaskInputsSpec_InputArtifactSpec_ComponentInputArtifact:
-			return nil, artifactError(fmt.Errorf("component input artifact not implemented yet"))
+			componentInput := artifactSpec.GetComponentInputArtifact()
+			if componentInput == "" {
+				return nil, artifactError(fmt.Errorf("empty component input"))
+			}
+			// The input artifacts of a DAG are recorded as the inputs of
+			// its execution by the DAG driver.
+			dagArtifacts, err := getDAGArtifacts()
+			if err != nil {
+				return nil, artifactError(err)
+			}
+			artifactList, ok := dagArtifacts[componentInput]
+			if !ok {
+				return nil, artifactError(fmt.Errorf("parent DAG does not have input artifact %s", componentInput))
+			}
+			inputs.Artifacts[name] = artifactList
 
 		case *pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact:
 			taskOutput := artifactSpec.GetTaskOutputArtifact()
@@ -870,25 +1090,95 @@ func resolveInputs(ctx context.Context, dag *metadata.DAG, iterationIndex *int,
 			if err != nil {
 				return nil, artifactError(err)
 			}
-			artifact, ok := outputs[taskOutput.GetOutputArtifactKey()]
+			artifacts, ok := outputs[taskOutput.GetOutputArtifactKey()]
 			if !ok {
 				return nil, artifactError(fmt.Errorf("cannot find output artifact key %q in producer task %q", taskOutput.GetOutputArtifactKey(), taskOutput.GetProducerTask()))
 			}
-			runtimeArtifact, err := artifact.ToRuntimeArtifact()
-			if err != nil {
-				return nil, artifactError(err)
-			}
-			inputs.Artifacts[name] = &pipelinespec.ArtifactList{
-				Artifacts: []*pipelinespec.RuntimeArtifact{runtimeArtifact},
+			artifactList := &pipelinespec.ArtifactList{}
+			for _, artifact := range artifacts {
+				runtimeArtifact, err := artifact.ToRuntimeArtifact()
+				if err != nil {
+					return nil, artifactError(err)
+				}
+				artifactList.Artifacts = append(artifactList.Artifacts, runtimeArtifact)
 			}
+			inputs.Artifacts[name] = artifactList
 		default:
 			return nil, artifactError(fmt.Errorf("artifact spec of type %T not implemented yet", t))
 		}
 	}
-	// TODO(Bobgy): validate executor inputs match component inputs definition
 	return inputs, nil
 }
 
+// taskFinalStatus returns the final status of the producer task of a
+// TaskFinalStatus input parameter, as a PipelineTaskFinalStatus struct.
+func taskFinalStatus(ctx context.Context, mlmd *metadata.Client, pipeline *metadata.Pipeline, taskName string, execution *metadata.Execution) (*structpb.Value, error) {
+	state, err := taskState(ctx, mlmd, pipeline, execution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the final state of task %q: %w", taskName, err)
+	}
+	finalStatus := &pipelinespec.PipelineTaskFinalStatus{
+		State:                   state.String(),
+		PipelineJobResourceName: pipeline.GetRunID(),
+		PipelineTaskName:        taskName,
+	}
+	if state == pipelinespec.PipelineStateEnum_FAILED {
+		finalStatus.Error = &status.Status{
+			Code:    int32(codes.Unknown),
+			Message: fmt.Sprintf("task %q failed", taskName),
+		}
+	}
+	content, err := protojson.Marshal(finalStatus)
+	if err != nil {
+		return nil, err
+	}
+	value := &structpb.Value{}
+	if err := value.UnmarshalJSON(content); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// taskState returns the final state of a task from the state of its MLMD
+// execution. DAG executions are not published, so the state of a DAG is
+// failed if the last attempt of any of its tasks failed, else succeeded.
+func taskState(ctx context.Context, mlmd *metadata.Client, pipeline *metadata.Pipeline, execution *metadata.Execution) (pipelinespec.PipelineStateEnum_PipelineTaskState, error) {
+	switch execution.GetExecution().GetLastKnownState() {
+	case pb.Execution_COMPLETE:
+		return pipelinespec.PipelineStateEnum_SUCCEEDED, nil
+	case pb.Execution_CACHED:
+		return pipelinespec.PipelineStateEnum_SKIPPED, nil
+	case pb.Execution_CANCELED:
+		// CANCELED executions are not triggered, see metadata.CreateExecution.
+		return pipelinespec.PipelineStateEnum_NOT_TRIGGERED, nil
+	case pb.Execution_FAILED:
+		return pipelinespec.PipelineStateEnum_FAILED, nil
+	}
+	isDAG, err := mlmd.IsDAGExecution(ctx, execution)
+	if err != nil {
+		return pipelinespec.PipelineStateEnum_TASK_STATE_UNSPECIFIED, err
+	}
+	if !isDAG {
+		// The upstream tasks of a task that consumes their final status
+		// are done, so a task that is still running was interrupted.
+		return pipelinespec.PipelineStateEnum_FAILED, nil
+	}
+	lastAttempts, err := mlmd.ListLastAttemptsInDAG(ctx, &metadata.DAG{Execution: execution}, pipeline)
+	if err != nil {
+		return pipelinespec.PipelineStateEnum_TASK_STATE_UNSPECIFIED, err
+	}
+	for _, child := range lastAttempts {
+		childState, err := taskState(ctx, mlmd, pipeline, child)
+		if err != nil {
+			return pipelinespec.PipelineStateEnum_TASK_STATE_UNSPECIFIED, err
+		}
+		if childState == pipelinespec.PipelineStateEnum_FAILED {
+			return pipelinespec.PipelineStateEnum_FAILED, nil
+		}
+	}
+	return pipelinespec.PipelineStateEnum_SUCCEEDED, nil
+}
+
 func provisionOutputs(pipelineRoot, taskName string, outputsSpec *pipelinespec.ComponentOutputsSpec) *pipelinespec.ExecutorInput_Outputs {
 	outputs := &pipelinespec.ExecutorInput_Outputs{
 		Artifacts:  make(map[string]*pipelinespec.ArtifactList),
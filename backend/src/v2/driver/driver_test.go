@@ -0,0 +1,403 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/kubernetesplatform"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/config"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8score "k8s.io/api/core/v1"
+	k8sres "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func makeTestPodSpec(t *testing.T, resources *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec,
+	accelerator *config.AcceleratorConfig) (*k8score.PodSpec, error) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+		Image:     "python:3.7",
+		Command:   []string{"python"},
+		Args:      []string{"train.py"},
+		Resources: resources,
+	}
+	return makeTestPodSpecWithKubernetesConfig(t, container, accelerator, nil)
+}
+
+func makeTestPodSpecWithKubernetesConfig(t *testing.T, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec,
+	accelerator *config.AcceleratorConfig, kubernetesConfig *kubernetesplatform.KubernetesExecutorConfig) (*k8score.PodSpec, error) {
+	patch, err := makePodSpecPatch(container, &pipelinespec.ComponentSpec{}, &pipelinespec.ExecutorInput{}, 1, "pipeline", "run", accelerator, kubernetesConfig)
+	if err != nil {
+		return nil, err
+	}
+	podSpec := &k8score.PodSpec{}
+	require.Nil(t, json.Unmarshal([]byte(patch), podSpec))
+	return podSpec, nil
+}
+
+func quantityString(q k8sres.Quantity) string {
+	return q.String()
+}
+
+func TestMakePodSpecPatch_Resources(t *testing.T) {
+	podSpec, err := makeTestPodSpec(t, &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec{
+		CpuLimit:      2,
+		MemoryLimit:   4,
+		CpuRequest:    0.5,
+		MemoryRequest: 1.5,
+	}, nil)
+	require.Nil(t, err)
+	require.Len(t, podSpec.Containers, 1)
+	assert.Equal(t, "main", podSpec.Containers[0].Name)
+	assert.Equal(t, "python:3.7", podSpec.Containers[0].Image)
+	assert.Equal(t, []string{"python", "train.py"}, podSpec.Containers[0].Args)
+	resources := podSpec.Containers[0].Resources
+	assert.Equal(t, "2", quantityString(resources.Limits[k8score.ResourceCPU]))
+	assert.Equal(t, "4G", quantityString(resources.Limits[k8score.ResourceMemory]))
+	assert.Equal(t, "500m", quantityString(resources.Requests[k8score.ResourceCPU]))
+	assert.Equal(t, "1500M", quantityString(resources.Requests[k8score.ResourceMemory]))
+	assert.Nil(t, podSpec.NodeSelector)
+	assert.Nil(t, podSpec.Tolerations)
+}
+
+func TestMakePodSpecPatch_Accelerator(t *testing.T) {
+	tolerations := []k8score.Toleration{{
+		Key:      "nvidia.com/gpu",
+		Operator: k8score.TolerationOpExists,
+		Effect:   k8score.TaintEffectNoSchedule,
+	}}
+	podSpec, err := makeTestPodSpec(t, &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec{
+		Accelerator: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec_AcceleratorConfig{
+			Type:  "NVIDIA_TESLA_T4",
+			Count: 2,
+		},
+	}, &config.AcceleratorConfig{
+		ResourceName: "nvidia.com/gpu",
+		NodeSelector: map[string]string{"cloud.google.com/gke-accelerator": "nvidia-tesla-t4"},
+		Tolerations:  tolerations,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "2", quantityString(podSpec.Containers[0].Resources.Limits["nvidia.com/gpu"]))
+	assert.Empty(t, podSpec.Containers[0].Resources.Requests)
+	assert.Equal(t, map[string]string{"cloud.google.com/gke-accelerator": "nvidia-tesla-t4"}, podSpec.NodeSelector)
+	assert.Equal(t, tolerations, podSpec.Tolerations)
+}
+
+func TestMakePodSpecPatch_AcceleratorNotConfigured(t *testing.T) {
+	_, err := makeTestPodSpec(t, &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec{
+		Accelerator: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec_AcceleratorConfig{
+			Type:  "NVIDIA_TESLA_T4",
+			Count: 1,
+		},
+	}, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "NVIDIA_TESLA_T4")
+}
+
+func TestMakePodSpecPatch_KubernetesConfig(t *testing.T) {
+	tolerationSeconds := int64(60)
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+		Image: "python:3.7",
+		Resources: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec{
+			Accelerator: &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec_ResourceSpec_AcceleratorConfig{
+				Type:  "NVIDIA_TESLA_T4",
+				Count: 1,
+			},
+		},
+	}
+	podSpec, err := makeTestPodSpecWithKubernetesConfig(t, container, &config.AcceleratorConfig{
+		ResourceName: "nvidia.com/gpu",
+		NodeSelector: map[string]string{"accelerator": "t4"},
+	}, &kubernetesplatform.KubernetesExecutorConfig{
+		SecretAsVolume: []*kubernetesplatform.SecretAsVolume{{SecretName: "my-secret", MountPath: "/secrets"}},
+		SecretAsEnv: []*kubernetesplatform.SecretAsEnv{{
+			SecretName: "my-secret",
+			KeyToEnv: []*kubernetesplatform.SecretAsEnv_SecretKeyToEnvMap{
+				{SecretKey: "password", EnvVar: "PASSWORD"},
+			},
+		}},
+		PvcMount:        []*kubernetesplatform.PvcMount{{ClaimName: "my-pvc", MountPath: "/data", ReadOnly: true}},
+		NodeSelector:    &kubernetesplatform.NodeSelector{Labels: map[string]string{"disktype": "ssd"}},
+		ImagePullSecret: []*kubernetesplatform.ImagePullSecret{{SecretName: "registry"}},
+		Tolerations: []*kubernetesplatform.Toleration{{
+			Key:               "dedicated",
+			Operator:          "Equal",
+			Value:             "ml",
+			Effect:            "NoExecute",
+			TolerationSeconds: &tolerationSeconds,
+		}},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []k8score.Volume{
+		{
+			Name:         "secret-my-secret",
+			VolumeSource: k8score.VolumeSource{Secret: &k8score.SecretVolumeSource{SecretName: "my-secret"}},
+		},
+		{
+			Name: "pvc-my-pvc",
+			VolumeSource: k8score.VolumeSource{PersistentVolumeClaim: &k8score.PersistentVolumeClaimVolumeSource{
+				ClaimName: "my-pvc",
+				ReadOnly:  true,
+			}},
+		},
+	}, podSpec.Volumes)
+	assert.Equal(t, []k8score.VolumeMount{
+		{Name: "secret-my-secret", MountPath: "/secrets"},
+		{Name: "pvc-my-pvc", MountPath: "/data", ReadOnly: true},
+	}, podSpec.Containers[0].VolumeMounts)
+	assert.Equal(t, []k8score.EnvVar{{
+		Name: "PASSWORD",
+		ValueFrom: &k8score.EnvVarSource{SecretKeyRef: &k8score.SecretKeySelector{
+			LocalObjectReference: k8score.LocalObjectReference{Name: "my-secret"},
+			Key:                  "password",
+		}},
+	}}, podSpec.Containers[0].Env)
+	// Node selectors of the accelerator and of the kubernetes config are merged.
+	assert.Equal(t, map[string]string{"accelerator": "t4", "disktype": "ssd"}, podSpec.NodeSelector)
+	assert.Equal(t, []k8score.LocalObjectReference{{Name: "registry"}}, podSpec.ImagePullSecrets)
+	assert.Equal(t, []k8score.Toleration{{
+		Key:               "dedicated",
+		Operator:          k8score.TolerationOpEqual,
+		Value:             "ml",
+		Effect:            k8score.TaintEffectNoExecute,
+		TolerationSeconds: &tolerationSeconds,
+	}}, podSpec.Tolerations)
+}
+
+func TestMakePodSpecPatch_KubernetesConfigVolumeNames(t *testing.T) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{Image: "python:3.7"}
+	podSpec, err := makeTestPodSpecWithKubernetesConfig(t, container, nil, &kubernetesplatform.KubernetesExecutorConfig{
+		SecretAsVolume: []*kubernetesplatform.SecretAsVolume{
+			{SecretName: "my.secret", MountPath: "/secrets"},
+			{SecretName: "my.secret", MountPath: "/more-secrets"},
+			{SecretName: "my-secret", MountPath: "/other-secrets"},
+		},
+		PvcMount: []*kubernetesplatform.PvcMount{
+			{ClaimName: "my-pvc", MountPath: "/data", ReadOnly: true},
+			{ClaimName: "my-pvc", MountPath: "/output"},
+			{ClaimName: strings.Repeat("a", 100), MountPath: "/large"},
+		},
+	})
+	require.Nil(t, err)
+	var names []string
+	for _, volume := range podSpec.Volumes {
+		assert.Empty(t, validation.IsDNS1123Label(volume.Name), volume.Name)
+		names = append(names, volume.Name)
+	}
+	// Mounting the same source twice reuses its volume.
+	require.Len(t, names, 4)
+	assert.NotEqual(t, names[0], names[1])
+	assert.Equal(t, "secret-my-secret", names[1])
+	assert.Equal(t, "pvc-my-pvc", names[2])
+	assert.Equal(t, []k8score.VolumeMount{
+		{Name: names[0], MountPath: "/secrets"},
+		{Name: names[0], MountPath: "/more-secrets"},
+		{Name: names[1], MountPath: "/other-secrets"},
+		{Name: names[2], MountPath: "/data", ReadOnly: true},
+		{Name: names[2], MountPath: "/output"},
+		{Name: names[3], MountPath: "/large"},
+	}, podSpec.Containers[0].VolumeMounts)
+	// The claim isn't read-only, one of its mounts is writable.
+	assert.False(t, podSpec.Volumes[2].PersistentVolumeClaim.ReadOnly)
+}
+
+func TestMakePodSpecPatch_InvalidKubernetesConfig(t *testing.T) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{Image: "python:3.7"}
+	_, err := makeTestPodSpecWithKubernetesConfig(t, container, nil, &kubernetesplatform.KubernetesExecutorConfig{
+		PvcMount: []*kubernetesplatform.PvcMount{{ClaimName: "my-pvc"}},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "mount_path")
+}
+
+func TestResolveInputs_ComponentInputArtifact(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	train := run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "train"}, pb.Execution_COMPLETE, map[string]string{"model": "gs://my-bucket/model"})
+	outputs, err := mlmd.GetOutputArtifactsByExecutionId(run.ctx, train.GetID())
+	require.Nil(t, err)
+	subDAG := run.task(t, run.root, &metadata.ExecutionConfig{
+		TaskName:         "evaluate",
+		ExecutionType:    metadata.DagExecutionTypeName,
+		InputArtifactIDs: map[string][]int64{"model": {outputs["model"][0].Artifact.GetId()}},
+	}, pb.Execution_RUNNING, nil)
+
+	task := &pipelinespec.PipelineTaskSpec{
+		Inputs: &pipelinespec.TaskInputsSpec{
+			Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+				"trained_model": {Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_ComponentInputArtifact{ComponentInputArtifact: "model"}},
+			},
+		},
+	}
+	inputs, err := resolveInputs(run.ctx, &metadata.DAG{Execution: subDAG}, nil, run.pipeline, task, nil, mlmd, nil, parameter.CoercionToString)
+	require.Nil(t, err)
+	require.Len(t, inputs.Artifacts["trained_model"].GetArtifacts(), 1)
+	assert.Equal(t, "gs://my-bucket/model", inputs.Artifacts["trained_model"].GetArtifacts()[0].GetUri())
+
+	task.Inputs.Artifacts["trained_model"].Kind = &pipelinespec.TaskInputsSpec_InputArtifactSpec_ComponentInputArtifact{ComponentInputArtifact: "dataset"}
+	_, err = resolveInputs(run.ctx, &metadata.DAG{Execution: subDAG}, nil, run.pipeline, task, nil, mlmd, nil, parameter.CoercionToString)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "parent DAG does not have input artifact dataset")
+}
+
+func TestResolveInputs_TaskFinalStatus(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "succeeded"}, pb.Execution_COMPLETE, nil)
+	run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "failed"}, pb.Execution_FAILED, nil)
+	run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "cached"}, pb.Execution_CACHED, nil)
+	run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "interrupted"}, pb.Execution_RUNNING, nil)
+	// A DAG whose failed task succeeded when retried.
+	retriedDAG := run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "retried-dag", ExecutionType: metadata.DagExecutionTypeName}, pb.Execution_RUNNING, nil)
+	flaky := run.task(t, &metadata.DAG{Execution: retriedDAG}, &metadata.ExecutionConfig{TaskName: "flaky"}, pb.Execution_FAILED, nil)
+	retry, err := mlmd.CreateRetryExecution(run.ctx, flaky, &metadata.ExecutionConfig{TaskName: "flaky"})
+	require.Nil(t, err)
+	require.Nil(t, mlmd.PublishExecution(run.ctx, retry, nil, nil, pb.Execution_COMPLETE))
+	// A DAG with a failed task in a nested DAG.
+	failedDAG := run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "failed-dag", ExecutionType: metadata.DagExecutionTypeName}, pb.Execution_RUNNING, nil)
+	nestedDAG := run.task(t, &metadata.DAG{Execution: failedDAG}, &metadata.ExecutionConfig{TaskName: "nested", ExecutionType: metadata.DagExecutionTypeName}, pb.Execution_RUNNING, nil)
+	run.task(t, &metadata.DAG{Execution: failedDAG}, &metadata.ExecutionConfig{TaskName: "other"}, pb.Execution_COMPLETE, nil)
+	run.task(t, &metadata.DAG{Execution: nestedDAG}, &metadata.ExecutionConfig{TaskName: "broken"}, pb.Execution_FAILED, nil)
+
+	tests := []struct {
+		producer  string
+		wantState string
+		wantError bool
+	}{
+		{producer: "succeeded", wantState: "SUCCEEDED"},
+		{producer: "failed", wantState: "FAILED", wantError: true},
+		{producer: "cached", wantState: "SKIPPED"},
+		{producer: "interrupted", wantState: "FAILED", wantError: true},
+		{producer: "retried-dag", wantState: "SUCCEEDED"},
+		{producer: "failed-dag", wantState: "FAILED", wantError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.producer, func(t *testing.T) {
+			task := &pipelinespec.PipelineTaskSpec{
+				Inputs: &pipelinespec.TaskInputsSpec{
+					Parameters: map[string]*pipelinespec.TaskInputsSpec_InputParameterSpec{
+						"status": {Kind: &pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_{
+							TaskFinalStatus: &pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus{ProducerTask: test.producer},
+						}},
+					},
+				},
+			}
+			inputs, err := resolveInputs(run.ctx, run.root, nil, run.pipeline, task, nil, mlmd, nil, parameter.CoercionToString)
+			require.Nil(t, err)
+			status := inputs.ParameterValues["status"].GetStructValue().GetFields()
+			assert.Equal(t, test.wantState, status["state"].GetStringValue())
+			assert.Equal(t, testRunID, status["pipelineJobResourceName"].GetStringValue())
+			assert.Equal(t, test.producer, status["pipelineTaskName"].GetStringValue())
+			assert.Equal(t, test.wantError, status["error"] != nil)
+		})
+	}
+
+	task := &pipelinespec.PipelineTaskSpec{
+		Inputs: &pipelinespec.TaskInputsSpec{
+			Parameters: map[string]*pipelinespec.TaskInputsSpec_InputParameterSpec{
+				"status": {Kind: &pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus_{
+					TaskFinalStatus: &pipelinespec.TaskInputsSpec_InputParameterSpec_TaskFinalStatus{ProducerTask: "unknown"},
+				}},
+			},
+		},
+	}
+	_, err = resolveInputs(run.ctx, run.root, nil, run.pipeline, task, nil, mlmd, nil, parameter.CoercionToString)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `cannot find producer task "unknown"`)
+}
+
+func TestResolveInputs_TaskOutputArtifactList(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	producer := run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "shard"}, pb.Execution_RUNNING, nil)
+	shards := []*metadata.OutputArtifact{
+		run.model(t, "shards", "gs://my-bucket/shards/0"),
+		run.model(t, "shards", "gs://my-bucket/shards/1"),
+		run.model(t, "shards", "gs://my-bucket/shards/2"),
+	}
+	require.Nil(t, mlmd.PublishExecution(run.ctx, producer, nil, shards, pb.Execution_COMPLETE))
+
+	task := &pipelinespec.PipelineTaskSpec{
+		Inputs: &pipelinespec.TaskInputsSpec{
+			Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+				"models": {Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact{
+					TaskOutputArtifact: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifactSpec{ProducerTask: "shard", OutputArtifactKey: "shards"},
+				}},
+			},
+		},
+	}
+	inputs, err := resolveInputs(run.ctx, run.root, nil, run.pipeline, task, nil, mlmd, nil, parameter.CoercionToString)
+	require.Nil(t, err)
+	var uris []string
+	for _, artifact := range inputs.Artifacts["models"].GetArtifacts() {
+		uris = append(uris, artifact.GetUri())
+	}
+	assert.Equal(t, []string{"gs://my-bucket/shards/0", "gs://my-bucket/shards/1", "gs://my-bucket/shards/2"}, uris)
+}
+
+func TestDAG_ArtifactIterator(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	producer := run.task(t, run.root, &metadata.ExecutionConfig{TaskName: "shard"}, pb.Execution_RUNNING, nil)
+	shards := []*metadata.OutputArtifact{
+		run.model(t, "shards", "gs://my-bucket/shards/0"),
+		run.model(t, "shards", "gs://my-bucket/shards/1"),
+	}
+	require.Nil(t, mlmd.PublishExecution(run.ctx, producer, nil, shards, pb.Execution_COMPLETE))
+
+	opts := Options{
+		PipelineName:   testPipelineName,
+		RunID:          testRunID,
+		DAGExecutionID: run.root.Execution.GetID(),
+		IterationIndex: -1,
+		Component:      &pipelinespec.ComponentSpec{},
+		Task: &pipelinespec.PipelineTaskSpec{
+			TaskInfo: &pipelinespec.PipelineTaskInfo{Name: "for-loop-1"},
+			Inputs: &pipelinespec.TaskInputsSpec{
+				Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+					"shards": {Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact{
+						TaskOutputArtifact: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifactSpec{ProducerTask: "shard", OutputArtifactKey: "shards"},
+					}},
+				},
+			},
+			Iterator: &pipelinespec.PipelineTaskSpec_ArtifactIterator{
+				ArtifactIterator: &pipelinespec.ArtifactIteratorSpec{
+					Items:     &pipelinespec.ArtifactIteratorSpec_ItemsSpec{InputArtifact: "shards"},
+					ItemInput: "shard",
+				},
+			},
+		},
+	}
+	iterator, err := DAG(run.ctx, opts, mlmd)
+	require.Nil(t, err)
+	require.NotNil(t, iterator.IterationCount)
+	assert.Equal(t, 2, *iterator.IterationCount)
+
+	opts.DAGExecutionID = iterator.ID
+	opts.IterationIndex = 1
+	iteration, err := DAG(run.ctx, opts, mlmd)
+	require.Nil(t, err)
+	assert.NotContains(t, iteration.ExecutorInput.GetInputs().GetArtifacts(), "shards")
+	shard := iteration.ExecutorInput.GetInputs().GetArtifacts()["shard"].GetArtifacts()
+	require.Len(t, shard, 1)
+	assert.Equal(t, "gs://my-bucket/shards/1", shard[0].GetUri())
+}
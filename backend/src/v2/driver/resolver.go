@@ -0,0 +1,177 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+)
+
+// Resolver queries the artifacts of a resolver task in MLMD, and records them
+// as the output artifacts of the task, so that downstream tasks consume them
+// like the outputs of any other task.
+func Resolver(ctx context.Context, opts Options, mlmd *metadata.Client) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("driver.Resolver(%s) failed: %w", opts.info(), err)
+		}
+	}()
+	err = validateResolver(opts)
+	if err != nil {
+		return nil, err
+	}
+	var iterationIndex *int
+	if opts.IterationIndex >= 0 {
+		index := opts.IterationIndex
+		iterationIndex = &index
+	}
+	pipeline, err := mlmd.GetPipeline(ctx, opts.PipelineName, opts.RunID, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+	dag, err := mlmd.GetDAG(ctx, opts.DAGExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("parent DAG: %+v", dag.Execution)
+	var outputArtifacts []*metadata.OutputArtifact
+	for name, query := range opts.Resolver.GetOutputArtifactQueries() {
+		artifacts, err := resolveArtifactQuery(ctx, mlmd, pipeline, query.GetFilter(), query.GetLimit())
+		if err != nil {
+			return nil, fmt.Errorf("resolving output artifact %q: %w", name, err)
+		}
+		for _, artifact := range artifacts {
+			outputArtifacts = append(outputArtifacts, &metadata.OutputArtifact{
+				Name:     name,
+				Artifact: artifact,
+				Schema:   opts.Component.GetOutputDefinitions().GetArtifacts()[name].GetArtifactType().GetInstanceSchema(),
+			})
+		}
+	}
+	ecfg := &metadata.ExecutionConfig{
+		TaskName:       opts.Task.GetTaskInfo().GetName(),
+		ExecutionType:  metadata.ResolverExecutionTypeName,
+		ParentDagID:    dag.Execution.GetID(),
+		IterationIndex: iterationIndex,
+	}
+	createdExecution, err := mlmd.CreateExecution(ctx, pipeline, ecfg)
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Created execution: %s", createdExecution)
+	if err := mlmd.PublishExecution(ctx, createdExecution, nil, outputArtifacts, pb.Execution_COMPLETE); err != nil {
+		return nil, fmt.Errorf("failed to publish resolver execution: %w", err)
+	}
+	return &Execution{ID: createdExecution.GetID()}, nil
+}
+
+func validateResolver(opts Options) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("invalid resolver driver args: %w", err)
+		}
+	}()
+	if opts.Resolver == nil {
+		return fmt.Errorf("resolver spec is required")
+	}
+	if len(opts.Resolver.GetOutputArtifactQueries()) == 0 {
+		return fmt.Errorf("resolver spec must have at least one output artifact query")
+	}
+	if opts.Container != nil {
+		return fmt.Errorf("container spec is unnecessary")
+	}
+	return validateNonRoot(opts)
+}
+
+// resolveArtifactQuery returns the latest artifacts that match an artifact
+// query of a resolver, latest first.
+func resolveArtifactQuery(ctx context.Context, mlmd *metadata.Client, pipeline *metadata.Pipeline, filter string, limit int32) ([]*pb.Artifact, error) {
+	if limit <= 0 {
+		limit = 1
+	}
+	filterQuery, err := artifactFilterQuery(filter, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, err := mlmd.QueryArtifacts(ctx, filterQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(artifacts) == 0 {
+		return nil, fmt.Errorf("no artifact matches the filter %q", filter)
+	}
+	return artifacts, nil
+}
+
+var (
+	inContextCondition = regexp.MustCompile(`^in_context\("([^"']*)"\)$`)
+	fieldCondition     = regexp.MustCompile(`^(artifact_type|uri|name|state)\s*=\s*(?:"([^"']*)"|([A-Z_]+))$`)
+)
+
+// artifactFilterQuery converts the filter of an artifact query of a resolver
+// to an MLMD filter query. The filter is a conjunction of the conditions:
+// * in_context("<context name>")
+// * artifact_type="<artifact type name>"
+// * uri="<uri>"
+// * state=<state>
+// * name="<name>"
+// When the filter has no in_context condition, the query is scoped to the
+// pipeline context.
+func artifactFilterQuery(filter string, pipeline *metadata.Pipeline) (string, error) {
+	var conditions []string
+	inContext := false
+	if strings.TrimSpace(filter) != "" {
+		for _, condition := range strings.Split(filter, " AND ") {
+			condition = strings.TrimSpace(condition)
+			if match := inContextCondition.FindStringSubmatch(condition); match != nil {
+				// Each context has its own alias, so that an artifact matches
+				// when it is in all the contexts.
+				conditions = append(conditions, fmt.Sprintf("contexts_c%v.name = '%s'", len(conditions), match[1]))
+				inContext = true
+				continue
+			}
+			match := fieldCondition.FindStringSubmatch(condition)
+			if match == nil {
+				return "", fmt.Errorf("unsupported condition %q in artifact filter %q", condition, filter)
+			}
+			field, quoted, state := match[1], match[2], match[3]
+			// States are enum values, the other fields are quoted strings.
+			if (field == "state") != (state != "") {
+				return "", fmt.Errorf("invalid condition %q in artifact filter %q", condition, filter)
+			}
+			switch field {
+			case "artifact_type":
+				conditions = append(conditions, fmt.Sprintf("type = '%s'", quoted))
+			case "state":
+				if _, ok := pb.Artifact_State_value[state]; !ok {
+					return "", fmt.Errorf("unknown artifact state %q in artifact filter %q", state, filter)
+				}
+				conditions = append(conditions, fmt.Sprintf("state = %s", state))
+			default:
+				conditions = append(conditions, fmt.Sprintf("%s = '%s'", field, quoted))
+			}
+		}
+	}
+	if !inContext {
+		conditions = append(conditions, fmt.Sprintf("contexts_pipeline.id = %v", pipeline.GetCtxID()))
+	}
+	return strings.Join(conditions, " AND "), nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPipelineName = "pipeline-name"
+	testRunID        = "run-id"
+	testModelSchema  = "title: system.Model\ntype: object"
+)
+
+type testRun struct {
+	ctx      context.Context
+	mlmd     *metadata.Client
+	pipeline *metadata.Pipeline
+	root     *metadata.DAG
+}
+
+func newTestRun(t *testing.T, runID string, mlmd *metadata.Client) *testRun {
+	ctx := context.Background()
+	pipeline, err := mlmd.GetPipeline(ctx, testPipelineName, runID, "ns1", "workflow/"+runID, "gs://my-bucket/root")
+	require.Nil(t, err)
+	root, err := mlmd.CreateExecution(ctx, pipeline, &metadata.ExecutionConfig{
+		TaskName:      "root",
+		ExecutionType: metadata.DagExecutionTypeName,
+	})
+	require.Nil(t, err)
+	return &testRun{ctx: ctx, mlmd: mlmd, pipeline: pipeline, root: &metadata.DAG{Execution: root}}
+}
+
+// task creates a task execution in the DAG and publishes it in the state
+// when the state is not RUNNING, with the given output models.
+func (r *testRun) task(t *testing.T, dag *metadata.DAG, config *metadata.ExecutionConfig, state pb.Execution_State, models map[string]string) *metadata.Execution {
+	config.ParentDagID = dag.Execution.GetID()
+	if config.ExecutionType == "" {
+		config.ExecutionType = metadata.ContainerExecutionTypeName
+	}
+	execution, err := r.mlmd.CreateExecution(r.ctx, r.pipeline, config)
+	require.Nil(t, err)
+	if state == pb.Execution_RUNNING {
+		return execution
+	}
+	var outputs []*metadata.OutputArtifact
+	for name, uri := range models {
+		outputs = append(outputs, r.model(t, name, uri))
+	}
+	require.Nil(t, r.mlmd.PublishExecution(r.ctx, execution, nil, outputs, state))
+	return execution
+}
+
+// model records a model artifact of an output.
+func (r *testRun) model(t *testing.T, name string, uri string) *metadata.OutputArtifact {
+	output, err := r.mlmd.RecordArtifact(r.ctx, name, testModelSchema, &pipelinespec.RuntimeArtifact{
+		Uri:  uri,
+		Type: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_InstanceSchema{InstanceSchema: testModelSchema}},
+	}, pb.Artifact_LIVE)
+	require.Nil(t, err)
+	return output
+}
+
+func resolverOptions(runID string, dagExecutionID int64, filter string, limit int32) Options {
+	return Options{
+		PipelineName:   testPipelineName,
+		RunID:          runID,
+		DAGExecutionID: dagExecutionID,
+		IterationIndex: -1,
+		Task: &pipelinespec.PipelineTaskSpec{
+			TaskInfo:     &pipelinespec.PipelineTaskInfo{Name: "latest-model"},
+			ComponentRef: &pipelinespec.ComponentRef{Name: "comp-latest-model"},
+		},
+		Component: &pipelinespec.ComponentSpec{
+			OutputDefinitions: &pipelinespec.ComponentOutputsSpec{
+				Artifacts: map[string]*pipelinespec.ComponentOutputsSpec_ArtifactSpec{
+					"model": {ArtifactType: &pipelinespec.ArtifactTypeSchema{Kind: &pipelinespec.ArtifactTypeSchema_InstanceSchema{InstanceSchema: testModelSchema}}},
+				},
+			},
+		},
+		Resolver: &pipelinespec.PipelineDeploymentConfig_ResolverSpec{
+			OutputArtifactQueries: map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec_ArtifactQuerySpec{
+				"model": {Filter: filter, Limit: limit},
+			},
+		},
+	}
+}
+
+func TestResolver_LatestArtifact(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	previous := newTestRun(t, "previous-run", mlmd)
+	previous.task(t, previous.root, &metadata.ExecutionConfig{TaskName: "train"}, pb.Execution_COMPLETE, map[string]string{"model": "gs://my-bucket/model-1"})
+	previous.task(t, previous.root, &metadata.ExecutionConfig{TaskName: "retrain"}, pb.Execution_COMPLETE, map[string]string{"model": "gs://my-bucket/model-2"})
+	run := newTestRun(t, testRunID, mlmd)
+
+	execution, err := Resolver(run.ctx, resolverOptions(testRunID, run.root.Execution.GetID(), `artifact_type="system.Model" AND state=LIVE`, 1), mlmd)
+	require.Nil(t, err)
+	require.NotZero(t, execution.ID)
+
+	tasks, err := mlmd.GetExecutionsInDAG(run.ctx, run.root, run.pipeline)
+	require.Nil(t, err)
+	resolved, ok := tasks["latest-model"]
+	require.True(t, ok)
+	assert.Equal(t, execution.ID, resolved.GetID())
+	assert.Equal(t, pb.Execution_COMPLETE, resolved.GetExecution().GetLastKnownState())
+	outputs, err := mlmd.GetOutputArtifactsByExecutionId(run.ctx, resolved.GetID())
+	require.Nil(t, err)
+	require.Contains(t, outputs, "model")
+	assert.Equal(t, "gs://my-bucket/model-2", outputs["model"][0].Artifact.GetUri())
+}
+
+func TestResolver_Limit(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	previous := newTestRun(t, "previous-run", mlmd)
+	for _, uri := range []string{"gs://my-bucket/model-1", "gs://my-bucket/model-2", "gs://my-bucket/model-3"} {
+		previous.task(t, previous.root, &metadata.ExecutionConfig{TaskName: uri}, pb.Execution_COMPLETE, map[string]string{"model": uri})
+	}
+	run := newTestRun(t, testRunID, mlmd)
+
+	execution, err := Resolver(run.ctx, resolverOptions(testRunID, run.root.Execution.GetID(), `artifact_type="system.Model"`, 2), mlmd)
+	require.Nil(t, err)
+	outputs, err := mlmd.GetOutputArtifactsByExecutionId(run.ctx, execution.ID)
+	require.Nil(t, err)
+	require.Len(t, outputs["model"], 2)
+	// The latest artifacts, latest first.
+	assert.Equal(t, "gs://my-bucket/model-3", outputs["model"][0].Artifact.GetUri())
+	assert.Equal(t, "gs://my-bucket/model-2", outputs["model"][1].Artifact.GetUri())
+}
+
+func TestResolver_InContext(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	previous := newTestRun(t, "previous-run", mlmd)
+	previous.task(t, previous.root, &metadata.ExecutionConfig{TaskName: "train"}, pb.Execution_COMPLETE, map[string]string{"model": "gs://my-bucket/model-1"})
+	other := newTestRun(t, "other-run", mlmd)
+	other.task(t, other.root, &metadata.ExecutionConfig{TaskName: "train"}, pb.Execution_COMPLETE, map[string]string{"model": "gs://my-bucket/model-2"})
+	run := newTestRun(t, testRunID, mlmd)
+
+	_, err := Resolver(run.ctx, resolverOptions(testRunID, run.root.Execution.GetID(), `in_context("previous-run") AND artifact_type="system.Model"`, 0), mlmd)
+	require.Nil(t, err)
+	tasks, err := mlmd.GetExecutionsInDAG(run.ctx, run.root, run.pipeline)
+	require.Nil(t, err)
+	outputs, err := mlmd.GetOutputArtifactsByExecutionId(run.ctx, tasks["latest-model"].GetID())
+	require.Nil(t, err)
+	assert.Equal(t, "gs://my-bucket/model-1", outputs["model"][0].Artifact.GetUri())
+}
+
+func TestResolver_Errors(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	dagID := run.root.Execution.GetID()
+
+	_, err := Resolver(run.ctx, resolverOptions(testRunID, dagID, `artifact_type="system.Model"`, 1), mlmd)
+	assert.Contains(t, err.Error(), "no artifact matches")
+
+	opts := resolverOptions(testRunID, dagID, "", 1)
+	opts.Resolver = nil
+	_, err = Resolver(run.ctx, opts, mlmd)
+	assert.Contains(t, err.Error(), "resolver spec is required")
+}
+
+func Test_artifactFilterQuery(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	run := newTestRun(t, testRunID, mlmd)
+	pipelineFilter := fmt.Sprintf("contexts_pipeline.id = %v", run.pipeline.GetCtxID())
+	tests := []struct {
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		{filter: "", want: pipelineFilter},
+		{
+			filter: `artifact_type="system.Model" AND state=LIVE`,
+			want:   "type = 'system.Model' AND state = LIVE AND " + pipelineFilter,
+		},
+		{
+			filter: `in_context("run-a") AND in_context("run-b") AND uri="gs://my-bucket/model"`,
+			want:   "contexts_c0.name = 'run-a' AND contexts_c1.name = 'run-b' AND uri = 'gs://my-bucket/model'",
+		},
+		{filter: `name="model"`, want: "name = 'model' AND " + pipelineFilter},
+		{filter: `state=ALIVE`, wantErr: true},
+		{filter: `state="LIVE"`, wantErr: true},
+		{filter: `uri=LIVE`, wantErr: true},
+		{filter: `create_time_since_epoch > 0`, wantErr: true},
+		{filter: `name="model" OR name="other"`, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			got, err := artifactFilterQuery(test.filter, run.pipeline)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
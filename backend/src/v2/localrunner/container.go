@@ -0,0 +1,129 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/component"
+	"github.com/kubeflow/pipelines/backend/src/v2/driver"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultRetryBackoffFactor = 2
+
+// launch runs the executor of a container task with the launcher. A failed
+// attempt is retried as long as the retry policy of the task allows, whatever
+// the cause of the failure.
+func (r *runner) launch(ctx context.Context, name string, task *pipelinespec.PipelineTaskSpec, componentSpec *pipelinespec.ComponentSpec, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec, execution *driver.Execution) error {
+	policy := task.GetRetryPolicy()
+	for attempt := 0; ; attempt++ {
+		err := r.launchAttempt(ctx, componentSpec, container, execution)
+		if err == nil || attempt >= int(policy.GetMaxRetryCount()) {
+			return err
+		}
+		delay := retryDelay(policy, attempt)
+		glog.Warningf("Task %q attempt %v failed, retrying in %v: %v", name, attempt, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (r *runner) launchAttempt(ctx context.Context, componentSpec *pipelinespec.ComponentSpec, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec, execution *driver.Execution) error {
+	// The driver provisions the same output files for all tasks, because
+	// each pod has its own filesystem. Each attempt gets its own output
+	// directory instead, so that outputs of other tasks are never collected.
+	outputDir, err := ioutil.TempDir("", "kfp-local-run-outputs-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(outputDir); err != nil {
+			glog.Warningf("Failed to remove output directory %q: %v", outputDir, err)
+		}
+	}()
+	executorInput := proto.Clone(execution.ExecutorInput).(*pipelinespec.ExecutorInput)
+	if executorInput.Outputs == nil {
+		executorInput.Outputs = &pipelinespec.ExecutorInput_Outputs{}
+	}
+	executorInput.Outputs.OutputFile = filepath.Join(outputDir, "output_metadata.json")
+	for name, parameter := range executorInput.GetOutputs().GetParameters() {
+		parameter.OutputFile = filepath.Join(outputDir, "outputs", name)
+	}
+	cmdArgs, err := r.command(container, outputDir)
+	if err != nil {
+		return err
+	}
+	launcher, err := component.NewLocalLauncherV2(execution.ID, executorInput, componentSpec, cmdArgs, r.launcherOptions(), r.opts.MetadataClient, r.opts.CacheClient)
+	if err != nil {
+		return err
+	}
+	return launcher.Execute(ctx)
+}
+
+// command returns the command and arguments that run a container, as a local
+// process or in a docker container of its image. The pipeline root and the
+// output directory are mounted at the same paths in docker containers.
+func (r *runner) command(container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec, outputDir string) ([]string, error) {
+	if !r.opts.Docker {
+		if len(container.GetCommand()) == 0 {
+			return nil, fmt.Errorf("container of image %q has no command, it can only run in docker", container.GetImage())
+		}
+		cmdArgs := append([]string{}, container.GetCommand()...)
+		return append(cmdArgs, container.GetArgs()...), nil
+	}
+	cmdArgs := []string{
+		"docker", "run", "--rm",
+		"--volume", r.rootDir + ":" + r.rootDir,
+		"--volume", outputDir + ":" + outputDir,
+	}
+	args := container.GetArgs()
+	// Like in Kubernetes, the command overrides the entrypoint of the image.
+	if command := container.GetCommand(); len(command) > 0 {
+		cmdArgs = append(cmdArgs, "--entrypoint", command[0])
+		args = append(append([]string{}, command[1:]...), args...)
+	}
+	cmdArgs = append(cmdArgs, container.GetImage())
+	return append(cmdArgs, args...), nil
+}
+
+// retryDelay returns how long to wait before retrying an attempt of a task,
+// attempt is the index of the failed attempt.
+func retryDelay(policy *pipelinespec.PipelineTaskSpec_RetryPolicy, attempt int) time.Duration {
+	duration := policy.GetBackoffDuration().AsDuration()
+	if duration <= 0 {
+		return 0
+	}
+	factor := float64(defaultRetryBackoffFactor)
+	if policy.GetBackoffFactor() > 0 {
+		factor = policy.GetBackoffFactor()
+	}
+	delay := time.Duration(float64(duration) * math.Pow(factor, float64(attempt)))
+	if maxDuration := policy.GetBackoffMaxDuration().AsDuration(); maxDuration > 0 && delay > maxDuration {
+		return maxDuration
+	}
+	return delay
+}
@@ -0,0 +1,424 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localrunner runs a PipelineJob on the local machine, without
+// Kubernetes or Argo.
+//
+// Tasks run one at a time, with the same drivers and launcher as the v2
+// engine in a cluster. Containers run as local processes, or in docker
+// containers of their images. Artifacts are stored in a file:// pipeline root,
+// and ML Metadata is in memory unless an MLMD client is provided.
+package localrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/cacheutils"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler"
+	"github.com/kubeflow/pipelines/backend/src/v2/component"
+	"github.com/kubeflow/pipelines/backend/src/v2/driver"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/objectstore"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const defaultNamespace = "local"
+
+type Options struct {
+	// optional, file:// pipeline root. Defaults to the pipeline root of the
+	// pipeline job, or a new temporary directory.
+	PipelineRoot string
+	// optional, KFP run ID, a new UUID by default
+	RunID string
+	// optional, the namespace recorded in ML Metadata, "local" by default
+	Namespace string
+	// optional, run containers in docker containers of their images instead
+	// of as local processes
+	Docker bool
+	// optional, defaults to an in-memory MLMD store
+	MetadataClient *metadata.Client
+	// optional, defaults to an in-memory cache
+	CacheClient *cacheutils.Client
+	// optional, how input parameter values are converted to the parameter
+	// types, parameter.CoercionToString by default
+	ParameterCoercion parameter.CoercionPolicy
+}
+
+// Result identifies a local run in ML Metadata and its pipeline root.
+type Result struct {
+	RunID        string
+	PipelineRoot string
+	// execution ID of the root DAG
+	DAGExecutionID int64
+}
+
+// Run runs a pipeline job locally. It returns an error when a task of the
+// pipeline fails, after the tasks that do not depend on it have run.
+func Run(ctx context.Context, jobArg *pipelinespec.PipelineJob, opts Options) (result *Result, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("local run failed: %w", err)
+		}
+	}()
+	// clone jobArg, because we don't want to change it
+	job := proto.Clone(jobArg).(*pipelinespec.PipelineJob)
+	if job.RuntimeConfig == nil {
+		job.RuntimeConfig = &pipelinespec.PipelineJob_RuntimeConfig{}
+	}
+	if job.GetRuntimeConfig().GetParameterValues() == nil {
+		job.RuntimeConfig.ParameterValues = map[string]*structpb.Value{}
+	}
+	spec, err := compiler.GetPipelineSpec(job)
+	if err != nil {
+		return nil, err
+	}
+	if spec.GetPipelineInfo().GetName() == "" {
+		return nil, fmt.Errorf("pipelineInfo.name is empty")
+	}
+	// fill root component default parameters like the compiler does
+	for name, param := range spec.GetRoot().GetInputDefinitions().GetParameters() {
+		if _, ok := job.RuntimeConfig.ParameterValues[name]; !ok && param.GetDefaultValue() != nil {
+			job.RuntimeConfig.ParameterValues[name] = param.GetDefaultValue()
+		}
+	}
+	pipelineRoot := opts.PipelineRoot
+	if pipelineRoot == "" {
+		pipelineRoot = job.GetRuntimeConfig().GetGcsOutputDirectory()
+	}
+	if pipelineRoot == "" {
+		dir, err := ioutil.TempDir("", "kfp-local-run-")
+		if err != nil {
+			return nil, err
+		}
+		pipelineRoot = "file://" + dir
+	}
+	if !objectstore.IsFileURI(pipelineRoot) {
+		return nil, fmt.Errorf("pipeline root %q is not a file:// URI of an absolute path", pipelineRoot)
+	}
+	job.RuntimeConfig.GcsOutputDirectory = pipelineRoot
+	r := &runner{
+		opts:         opts,
+		pipelineName: spec.GetPipelineInfo().GetName(),
+		rootDir:      strings.TrimPrefix(pipelineRoot, "file://"),
+		components:   make(map[string]*pipelinespec.ComponentSpec),
+		containers:   make(map[string]*pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec),
+		importers:    make(map[string]*pipelinespec.PipelineDeploymentConfig_ImporterSpec),
+		resolvers:    make(map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec),
+		taskOrders:   make(map[string][]string),
+	}
+	if r.opts.RunID == "" {
+		r.opts.RunID = uuid.New().String()
+	}
+	if r.opts.Namespace == "" {
+		r.opts.Namespace = defaultNamespace
+	}
+	if r.opts.MetadataClient == nil {
+		r.opts.MetadataClient = metadata.NewFakeClient()
+	}
+	if r.opts.CacheClient == nil {
+		r.opts.CacheClient = cacheutils.NewFakeClient()
+	}
+	if err := os.MkdirAll(r.rootDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of pipeline root %q: %w", pipelineRoot, err)
+	}
+	if err := compiler.Accept(job, r); err != nil {
+		return nil, err
+	}
+	glog.Infof("Running pipeline %q, run ID=%q, pipeline root=%q", r.pipelineName, r.opts.RunID, pipelineRoot)
+	root, err := driver.RootDAG(ctx, driver.Options{
+		PipelineName:   r.pipelineName,
+		RunID:          r.opts.RunID,
+		Component:      spec.GetRoot(),
+		IterationIndex: -1,
+		RuntimeConfig:  job.GetRuntimeConfig(),
+		Namespace:      r.opts.Namespace,
+
+		ParameterCoercion: r.opts.ParameterCoercion,
+	}, r.opts.MetadataClient)
+	if err != nil {
+		return nil, err
+	}
+	result = &Result{RunID: r.opts.RunID, PipelineRoot: pipelineRoot, DAGExecutionID: root.ID}
+	return result, r.runDAG(ctx, compiler.RootComponentName, root.ID)
+}
+
+// runner is a compiler.Visitor, it collects the components of the pipeline
+// before running them.
+type runner struct {
+	opts         Options
+	pipelineName string
+	// local path of the pipeline root
+	rootDir string
+	// by component name
+	components map[string]*pipelinespec.ComponentSpec
+	containers map[string]*pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec
+	importers  map[string]*pipelinespec.PipelineDeploymentConfig_ImporterSpec
+	resolvers  map[string]*pipelinespec.PipelineDeploymentConfig_ResolverSpec
+	// task names of DAG components in the order they run
+	taskOrders map[string][]string
+}
+
+var _ compiler.Visitor = (*runner)(nil)
+
+func (r *runner) Container(name string, component *pipelinespec.ComponentSpec, container *pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec) error {
+	r.components[name] = component
+	r.containers[name] = container
+	return nil
+}
+
+func (r *runner) Importer(name string, component *pipelinespec.ComponentSpec, importer *pipelinespec.PipelineDeploymentConfig_ImporterSpec) error {
+	r.components[name] = component
+	r.importers[name] = importer
+	return nil
+}
+
+func (r *runner) Resolver(name string, component *pipelinespec.ComponentSpec, resolver *pipelinespec.PipelineDeploymentConfig_ResolverSpec) error {
+	r.components[name] = component
+	r.resolvers[name] = resolver
+	return nil
+}
+
+func (r *runner) DAG(name string, component *pipelinespec.ComponentSpec, dag *pipelinespec.DagSpec) error {
+	for taskName, task := range dag.GetTasks() {
+		if task.GetParameterIterator() != nil && task.GetArtifactIterator() != nil {
+			return fmt.Errorf("DAG %q: invalid task %q: parameterIterator and artifactIterator cannot be specified at the same time", name, taskName)
+		}
+	}
+	order, err := sortTasks(dag)
+	if err != nil {
+		return fmt.Errorf("DAG %q: %w", name, err)
+	}
+	r.components[name] = component
+	r.taskOrders[name] = order
+	return nil
+}
+
+type taskState int
+
+const (
+	taskSucceeded taskState = iota
+	taskSkipped
+	taskFailed
+)
+
+// runDAG runs the tasks of a DAG one at a time. Like in Argo, a task runs when
+// all its upstream tasks succeeded, or when all of them completed if it is an
+// exit handler task. The DAG fails when any of its tasks failed.
+func (r *runner) runDAG(ctx context.Context, componentName string, dagExecutionID int64) error {
+	tasks := r.components[componentName].GetDag().GetTasks()
+	states := make(map[string]taskState)
+	var dagErr error
+	for _, name := range r.taskOrders[componentName] {
+		task := tasks[name]
+		exitHandler := task.GetTriggerPolicy().GetStrategy() == pipelinespec.PipelineTaskSpec_TriggerPolicy_ALL_UPSTREAM_TASKS_COMPLETED
+		upstreamSucceeded := true
+		for _, upstream := range taskDependencies(task) {
+			upstreamSucceeded = upstreamSucceeded && states[upstream] == taskSucceeded
+		}
+		if !upstreamSucceeded && !exitHandler {
+			glog.Infof("Task %q is skipped, because its upstream tasks did not succeed", name)
+			states[name] = taskSkipped
+			continue
+		}
+		skipped, err := r.runTask(ctx, name, task, dagExecutionID, -1)
+		switch {
+		case err != nil:
+			glog.Errorf("Task %q failed: %v", name, err)
+			states[name] = taskFailed
+			if dagErr == nil {
+				dagErr = fmt.Errorf("task %q failed: %w", name, err)
+			}
+		case skipped:
+			glog.Infof("Task %q is skipped, because its condition is false", name)
+			states[name] = taskSkipped
+		default:
+			states[name] = taskSucceeded
+		}
+	}
+	return dagErr
+}
+
+// runTask runs a task of a DAG. It returns whether the task is skipped because
+// its trigger condition is false.
+// iterationIndex is the index of an iteration of an iterator task, or -1.
+func (r *runner) runTask(ctx context.Context, name string, task *pipelinespec.PipelineTaskSpec, parentDagID int64, iterationIndex int) (skipped bool, err error) {
+	componentName := task.GetComponentRef().GetName()
+	componentSpec, ok := r.components[componentName]
+	if !ok {
+		return false, fmt.Errorf("component spec for %q not found", componentName)
+	}
+	opts := driver.Options{
+		PipelineName:   r.pipelineName,
+		RunID:          r.opts.RunID,
+		Component:      componentSpec,
+		IterationIndex: iterationIndex,
+		Task:           task,
+		DAGExecutionID: parentDagID,
+
+		ParameterCoercion: r.opts.ParameterCoercion,
+	}
+	isIterator := iterationIndex < 0 && (task.GetParameterIterator() != nil || task.GetArtifactIterator() != nil)
+	if isIterator {
+		// Like the compiler, an iterator is a DAG whose iterations are the
+		// same task with an iteration index.
+		execution, err := driver.DAG(ctx, opts, r.opts.MetadataClient)
+		if err != nil {
+			return false, err
+		}
+		if !execution.WillTrigger() {
+			return true, nil
+		}
+		count := 0
+		if execution.IterationCount != nil {
+			count = *execution.IterationCount
+		}
+		for index := 0; index < count; index++ {
+			if _, err := r.runTask(ctx, name, task, execution.ID, index); err != nil {
+				return false, fmt.Errorf("iteration %v: %w", index, err)
+			}
+		}
+		return false, nil
+	}
+	if componentSpec.GetDag() != nil {
+		execution, err := driver.DAG(ctx, opts, r.opts.MetadataClient)
+		if err != nil {
+			return false, err
+		}
+		if !execution.WillTrigger() {
+			return true, nil
+		}
+		return false, r.runDAG(ctx, componentName, execution.ID)
+	}
+	if container, ok := r.containers[componentName]; ok {
+		opts.Container = container
+		execution, err := driver.Container(ctx, opts, r.opts.MetadataClient, r.opts.CacheClient)
+		if err != nil {
+			return false, err
+		}
+		if !execution.WillTrigger() {
+			return true, nil
+		}
+		if execution.Cached != nil && *execution.Cached {
+			glog.Infof("Task %q is cached", name)
+			return false, nil
+		}
+		return false, r.launch(ctx, name, task, componentSpec, container, execution)
+	}
+	if importer, ok := r.importers[componentName]; ok {
+		launcher, err := component.NewLocalImporterLauncher(componentSpec, importer, task, r.launcherOptions(), &component.ImporterLauncherOptions{
+			PipelineName: r.pipelineName,
+			RunID:        r.opts.RunID,
+			ParentDagID:  parentDagID,
+		}, r.opts.MetadataClient)
+		if err != nil {
+			return false, err
+		}
+		return false, launcher.Execute(ctx)
+	}
+	if resolver, ok := r.resolvers[componentName]; ok {
+		opts.Resolver = resolver
+		_, err := driver.Resolver(ctx, opts, r.opts.MetadataClient)
+		return false, err
+	}
+	return false, fmt.Errorf("unsupported implementation of component %q", componentName)
+}
+
+// launcherOptions returns the launcher options of a task, the local machine
+// plays the role of the pod.
+func (r *runner) launcherOptions() *component.LauncherV2Options {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	return &component.LauncherV2Options{
+		Namespace:    r.opts.Namespace,
+		PodName:      hostname,
+		PodUID:       uuid.New().String(),
+		PipelineName: r.pipelineName,
+		RunID:        r.opts.RunID,
+	}
+}
+
+// taskDependencies returns the upstream tasks of a task, they are the
+// dependent tasks and the producers of its inputs.
+func taskDependencies(task *pipelinespec.PipelineTaskSpec) []string {
+	var deps []string
+	seen := make(map[string]bool)
+	add := func(producer string) {
+		if producer != "" && !seen[producer] {
+			seen[producer] = true
+			deps = append(deps, producer)
+		}
+	}
+	for _, dep := range task.GetDependentTasks() {
+		add(dep)
+	}
+	for _, input := range task.GetInputs().GetParameters() {
+		add(input.GetTaskOutputParameter().GetProducerTask())
+		add(input.GetTaskFinalStatus().GetProducerTask())
+	}
+	for _, input := range task.GetInputs().GetArtifacts() {
+		add(input.GetTaskOutputArtifact().GetProducerTask())
+	}
+	return deps
+}
+
+// sortTasks sorts the tasks of a DAG topologically, tasks that can run at the
+// same time are sorted by name.
+func sortTasks(dag *pipelinespec.DagSpec) ([]string, error) {
+	tasks := dag.GetTasks()
+	upstreamCount := make(map[string]int)
+	downstream := make(map[string][]string)
+	var ready []string
+	for name, task := range tasks {
+		deps := taskDependencies(task)
+		for _, dep := range deps {
+			if _, ok := tasks[dep]; !ok {
+				return nil, fmt.Errorf("task %q depends on unknown task %q", name, dep)
+			}
+			downstream[dep] = append(downstream[dep], name)
+		}
+		upstreamCount[name] = len(deps)
+		if len(deps) == 0 {
+			ready = append(ready, name)
+		}
+	}
+	order := make([]string, 0, len(tasks))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		name := ready[0]
+		ready = ready[1:]
+		order = append(order, name)
+		for _, next := range downstream[name] {
+			upstreamCount[next]--
+			if upstreamCount[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+	if len(order) != len(tasks) {
+		return nil, fmt.Errorf("tasks have circular dependencies")
+	}
+	return order, nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localrunner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"github.com/kubeflow/pipelines/backend/src/v2/parameter"
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// producerConsumerSpec is a pipeline whose consumer task reads the output
+// parameter and artifact of its producer task.
+const producerConsumerSpec = `{
+	"pipelineInfo": {"name": "producer-consumer"},
+	"root": {
+		"inputDefinitions": {"parameters": {"greeting": {"parameterType": "STRING", "defaultValue": "hello"}}},
+		"dag": {"tasks": {
+			"producer": {
+				"taskInfo": {"name": "producer"},
+				"componentRef": {"name": "comp-producer"},
+				"inputs": {"parameters": {"greeting": {"componentInputParameter": "greeting"}}}
+			},
+			"consumer": {
+				"taskInfo": {"name": "consumer"},
+				"componentRef": {"name": "comp-consumer"},
+				"inputs": {
+					"parameters": {"message": {"taskOutputParameter": {"producerTask": "producer", "outputParameterKey": "message"}}},
+					"artifacts": {"data": {"taskOutputArtifact": {"producerTask": "producer", "outputArtifactKey": "data"}}}
+				}
+			}
+		}}
+	},
+	"components": {
+		"comp-producer": {
+			"executorLabel": "exec-producer",
+			"inputDefinitions": {"parameters": {"greeting": {"parameterType": "STRING"}}},
+			"outputDefinitions": {
+				"parameters": {"message": {"parameterType": "STRING"}},
+				"artifacts": {"data": {"artifactType": {"schemaTitle": "system.Dataset"}}}
+			}
+		},
+		"comp-consumer": {
+			"executorLabel": "exec-consumer",
+			"inputDefinitions": {
+				"parameters": {"message": {"parameterType": "STRING"}},
+				"artifacts": {"data": {"artifactType": {"schemaTitle": "system.Dataset"}}}
+			},
+			"outputDefinitions": {"parameters": {"result": {"parameterType": "STRING"}}}
+		}
+	},
+	"deploymentSpec": {"executors": {
+		"exec-producer": {"container": {
+			"image": "alpine",
+			"command": ["sh", "-c", "printf '%s world' \"$0\" > \"$1\" && printf data > \"$2\""],
+			"args": ["{{$.inputs.parameters['greeting']}}", "{{$.outputs.parameters['message'].output_file}}", "{{$.outputs.artifacts['data'].path}}"]
+		}},
+		"exec-consumer": {"container": {
+			"image": "alpine",
+			"command": ["sh", "-c", "printf '%s, ' \"$0\" > \"$2\" && cat \"$1\" >> \"$2\""],
+			"args": ["{{$.inputs.parameters['message']}}", "{{$.inputs.artifacts['data'].path}}", "{{$.outputs.parameters['result'].output_file}}"]
+		}}
+	}}
+}`
+
+// triggerPolicySpec is a pipeline with a task whose condition is false, a
+// failing task, their downstream tasks and an exit handler task.
+const triggerPolicySpec = `{
+	"pipelineInfo": {"name": "trigger-policy"},
+	"root": {"dag": {"tasks": {
+		"skipped": {
+			"taskInfo": {"name": "skipped"},
+			"componentRef": {"name": "comp-succeed"},
+			"triggerPolicy": {"condition": "1 == 2"}
+		},
+		"after-skipped": {"taskInfo": {"name": "after-skipped"}, "componentRef": {"name": "comp-succeed"}, "dependentTasks": ["skipped"]},
+		"fail": {"taskInfo": {"name": "fail"}, "componentRef": {"name": "comp-fail"}},
+		"after-fail": {"taskInfo": {"name": "after-fail"}, "componentRef": {"name": "comp-succeed"}, "dependentTasks": ["fail"]},
+		"exit-handler": {
+			"taskInfo": {"name": "exit-handler"},
+			"componentRef": {"name": "comp-succeed"},
+			"dependentTasks": ["fail"],
+			"triggerPolicy": {"strategy": "ALL_UPSTREAM_TASKS_COMPLETED"}
+		}
+	}}},
+	"components": {
+		"comp-succeed": {"executorLabel": "exec-succeed"},
+		"comp-fail": {"executorLabel": "exec-fail"}
+	},
+	"deploymentSpec": {"executors": {
+		"exec-succeed": {"container": {"image": "alpine", "command": ["true"]}},
+		"exec-fail": {"container": {"image": "alpine", "command": ["false"]}}
+	}}
+}`
+
+func testJob(t *testing.T, specJSON string) *pipelinespec.PipelineJob {
+	spec := &structpb.Struct{}
+	require.Nil(t, protojson.Unmarshal([]byte(specJSON), spec))
+	return &pipelinespec.PipelineJob{PipelineSpec: spec}
+}
+
+func testPipelineRoot(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "localrunner-test-")
+	require.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return "file://" + dir
+}
+
+// tasks returns the task executions of the root DAG of a run by task name.
+func tasks(t *testing.T, mlmd *metadata.Client, pipelineName string, result *Result) map[string]*metadata.Execution {
+	ctx := context.Background()
+	pipeline, err := mlmd.GetPipeline(ctx, pipelineName, result.RunID, "", "", "")
+	require.Nil(t, err)
+	dag, err := mlmd.GetDAG(ctx, result.DAGExecutionID)
+	require.Nil(t, err)
+	executions, err := mlmd.GetExecutionsInDAG(ctx, dag, pipeline)
+	require.Nil(t, err)
+	return executions
+}
+
+func TestRun(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	pipelineRoot := testPipelineRoot(t)
+	job := testJob(t, producerConsumerSpec)
+	job.RuntimeConfig = &pipelinespec.PipelineJob_RuntimeConfig{
+		ParameterValues: map[string]*structpb.Value{"greeting": structpb.NewStringValue("hi")},
+	}
+
+	result, err := Run(context.Background(), job, Options{PipelineRoot: pipelineRoot, MetadataClient: mlmd})
+	require.Nil(t, err)
+	assert.Equal(t, pipelineRoot, result.PipelineRoot)
+	assert.NotEmpty(t, result.RunID)
+
+	executions := tasks(t, mlmd, "producer-consumer", result)
+	require.Contains(t, executions, "producer")
+	require.Contains(t, executions, "consumer")
+	assert.Equal(t, pb.Execution_COMPLETE, executions["producer"].GetExecution().GetLastKnownState())
+	assert.Equal(t, pb.Execution_COMPLETE, executions["consumer"].GetExecution().GetLastKnownState())
+	_, outputs, err := executions["consumer"].GetParameters()
+	require.Nil(t, err)
+	assert.Equal(t, "hi world, data", outputs["result"].GetStringValue())
+	artifacts, err := mlmd.GetOutputArtifactsByExecutionId(context.Background(), executions["producer"].GetID())
+	require.Nil(t, err)
+	require.Len(t, artifacts["data"], 1)
+	// The artifact is stored in the pipeline root, under the run.
+	uri := artifacts["data"][0].Artifact.GetUri()
+	assert.Equal(t, fmt.Sprintf("%s/producer-consumer/%s/producer/data", pipelineRoot, result.RunID), uri)
+	content, err := ioutil.ReadFile(strings.TrimPrefix(uri, "file://"))
+	require.Nil(t, err)
+	assert.Equal(t, "data", string(content))
+}
+
+func TestRun_TriggerPolicy(t *testing.T) {
+	mlmd := metadata.NewFakeClient()
+	job := testJob(t, triggerPolicySpec)
+
+	result, err := Run(context.Background(), job, Options{PipelineRoot: testPipelineRoot(t), MetadataClient: mlmd})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `task "fail" failed`)
+	require.NotNil(t, result)
+
+	executions := tasks(t, mlmd, "trigger-policy", result)
+	assert.Equal(t, pb.Execution_FAILED, executions["fail"].GetExecution().GetLastKnownState())
+	assert.Equal(t, pb.Execution_COMPLETE, executions["exit-handler"].GetExecution().GetLastKnownState())
+	// The driver records a task whose condition is false, without running it.
+	require.Contains(t, executions, "skipped")
+	assert.NotEqual(t, pb.Execution_COMPLETE, executions["skipped"].GetExecution().GetLastKnownState())
+	// Tasks are not driven when their upstream tasks did not succeed.
+	assert.NotContains(t, executions, "after-skipped")
+	assert.NotContains(t, executions, "after-fail")
+}
+
+func TestRun_Retry(t *testing.T) {
+	pipelineRoot := testPipelineRoot(t)
+	marker := filepath.Join(strings.TrimPrefix(pipelineRoot, "file://"), "attempted")
+	// The first attempt fails, the retry succeeds.
+	spec := strings.ReplaceAll(triggerPolicySpec, `"command": ["false"]`,
+		fmt.Sprintf(`"command": ["sh", "-c", "test -f %[1]s || (touch %[1]s && false)"]`, marker))
+	job := testJob(t, spec)
+	_, err := Run(context.Background(), job, Options{PipelineRoot: pipelineRoot})
+	require.NotNil(t, err, "the task fails without a retry policy")
+
+	job = testJob(t, strings.Replace(spec, `"componentRef": {"name": "comp-fail"}`,
+		`"componentRef": {"name": "comp-fail"}, "retryPolicy": {"maxRetryCount": 1}`, 1))
+	require.Nil(t, os.Remove(marker))
+	_, err = Run(context.Background(), job, Options{PipelineRoot: pipelineRoot})
+	require.Nil(t, err)
+}
+
+func TestRun_ParameterCoercion(t *testing.T) {
+	newJob := func(values map[string]*structpb.Value) *pipelinespec.PipelineJob {
+		job := testJob(t, producerConsumerSpec)
+		job.RuntimeConfig = &pipelinespec.PipelineJob_RuntimeConfig{ParameterValues: values}
+		return job
+	}
+	// By default, STRING parameters consume numbers as text.
+	mlmd := metadata.NewFakeClient()
+	result, err := Run(context.Background(), newJob(map[string]*structpb.Value{"greeting": structpb.NewNumberValue(42)}),
+		Options{PipelineRoot: testPipelineRoot(t), MetadataClient: mlmd})
+	require.Nil(t, err)
+	_, outputs, err := tasks(t, mlmd, "producer-consumer", result)["consumer"].GetParameters()
+	require.Nil(t, err)
+	assert.Equal(t, "42 world, data", outputs["result"].GetStringValue())
+
+	_, err = Run(context.Background(), newJob(map[string]*structpb.Value{"greeting": structpb.NewNumberValue(42)}),
+		Options{PipelineRoot: testPipelineRoot(t), ParameterCoercion: parameter.CoercionNone})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `input parameter "greeting" type mismatch: expect STRING, got number`)
+
+	_, err = Run(context.Background(), newJob(map[string]*structpb.Value{"greting": structpb.NewStringValue("hi")}),
+		Options{PipelineRoot: testPipelineRoot(t)})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), `unknown pipeline input parameters ["greting"]`)
+}
+
+func TestRun_InvalidPipelineRoot(t *testing.T) {
+	_, err := Run(context.Background(), testJob(t, producerConsumerSpec), Options{PipelineRoot: "gs://my-bucket/root"})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is not a file:// URI")
+}
+
+func Test_sortTasks(t *testing.T) {
+	task := func(deps ...string) *pipelinespec.PipelineTaskSpec {
+		return &pipelinespec.PipelineTaskSpec{DependentTasks: deps}
+	}
+	tests := []struct {
+		name    string
+		tasks   map[string]*pipelinespec.PipelineTaskSpec
+		want    []string
+		wantErr string
+	}{{
+		name:  "independent tasks by name",
+		tasks: map[string]*pipelinespec.PipelineTaskSpec{"b": task(), "a": task(), "c": task()},
+		want:  []string{"a", "b", "c"},
+	}, {
+		name: "upstream tasks first",
+		tasks: map[string]*pipelinespec.PipelineTaskSpec{
+			"a": task("c"),
+			"b": task(),
+			"c": task("b"),
+			"d": {Inputs: &pipelinespec.TaskInputsSpec{Artifacts: map[string]*pipelinespec.TaskInputsSpec_InputArtifactSpec{
+				"data": {Kind: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact{
+					TaskOutputArtifact: &pipelinespec.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifactSpec{ProducerTask: "a"},
+				}},
+			}}},
+		},
+		want: []string{"b", "c", "a", "d"},
+	}, {
+		name:    "unknown task",
+		tasks:   map[string]*pipelinespec.PipelineTaskSpec{"a": task("x")},
+		wantErr: `task "a" depends on unknown task "x"`,
+	}, {
+		name:    "circular dependencies",
+		tasks:   map[string]*pipelinespec.PipelineTaskSpec{"a": task("b"), "b": task("a"), "c": task()},
+		wantErr: "circular dependencies",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := sortTasks(&pipelinespec.DagSpec{Tasks: test.tasks})
+			if test.wantErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_command(t *testing.T) {
+	container := &pipelinespec.PipelineDeploymentConfig_PipelineContainerSpec{
+		Image:   "python:3.7",
+		Command: []string{"python3", "-c"},
+		Args:    []string{"print(1)"},
+	}
+	r := &runner{rootDir: "/data/root"}
+	got, err := r.command(container, "/tmp/outputs")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"python3", "-c", "print(1)"}, got)
+
+	r.opts.Docker = true
+	got, err = r.command(container, "/tmp/outputs")
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"docker", "run", "--rm",
+		"--volume", "/data/root:/data/root",
+		"--volume", "/tmp/outputs:/tmp/outputs",
+		"--entrypoint", "python3",
+		"python:3.7", "-c", "print(1)",
+	}, got)
+
+	// Without a command, the container runs the entrypoint of its image.
+	container.Command = nil
+	got, err = r.command(container, "/tmp/outputs")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"python:3.7", "print(1)"}, got[len(got)-2:])
+	r.opts.Docker = false
+	_, err = r.command(container, "/tmp/outputs")
+	assert.NotNil(t, err)
+}
+
+func Test_retryDelay(t *testing.T) {
+	policy := &pipelinespec.PipelineTaskSpec_RetryPolicy{MaxRetryCount: 3}
+	assert.Zero(t, retryDelay(policy, 0))
+	policy.BackoffDuration = durationpb.New(10e9)
+	assert.Equal(t, "10s", retryDelay(policy, 0).String())
+	assert.Equal(t, "40s", retryDelay(policy, 2).String())
+	policy.BackoffFactor = 3
+	policy.BackoffMaxDuration = durationpb.New(60e9)
+	assert.Equal(t, "30s", retryDelay(policy, 1).String())
+	assert.Equal(t, "1m0s", retryDelay(policy, 2).String())
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -52,6 +53,7 @@ type ExecutionType string
 const (
 	ContainerExecutionTypeName ExecutionType = "system.ContainerExecution"
 	DagExecutionTypeName       ExecutionType = "system.DAGExecution"
+	ResolverExecutionTypeName  ExecutionType = "system.ResolverExecution"
 )
 
 var (
@@ -174,6 +176,15 @@ func (p *Pipeline) GetRunCtxID() int64 {
 	return p.pipelineRunCtx.GetId()
 }
 
+// GetRunID returns the KFP run ID, which is the name of the pipeline run
+// context.
+func (p *Pipeline) GetRunID() string {
+	if p == nil {
+		return ""
+	}
+	return p.pipelineRunCtx.GetName()
+}
+
 func (p *Pipeline) GetCtxID() int64 {
 	if p == nil {
 		return 0
@@ -234,6 +245,24 @@ func (e *Execution) TaskName() string {
 	return e.execution.GetCustomProperties()[keyTaskName].GetStringValue()
 }
 
+// PodUID returns the UID of the Pod of a container execution, empty until
+// the launcher runs.
+func (e *Execution) PodUID() string {
+	if e == nil {
+		return ""
+	}
+	return e.execution.GetCustomProperties()[keyPodUID].GetStringValue()
+}
+
+// Attempt returns the attempt of a retried execution, 0 for the first
+// attempt.
+func (e *Execution) Attempt() int64 {
+	if e == nil {
+		return 0
+	}
+	return e.execution.GetCustomProperties()[keyAttempt].GetIntValue()
+}
+
 func (e *Execution) FingerPrint() string {
 	if e == nil {
 		return ""
@@ -346,12 +375,18 @@ func doubleValue(f float64) *pb.Value {
 // "train" task, because artifact name is related to the context it's used.
 // Therefore, we should store artifact name as a property of the artifact's events
 // (connects artifact and execution) instead of the artifact's property.
-func eventPath(artifactName string) *pb.Event_Path {
+// The artifacts of an artifact list have the same name, the index of an
+// artifact in the list is the second step of the event path.
+func eventPath(artifactName string, index int) *pb.Event_Path {
 	return &pb.Event_Path{
 		Steps: []*pb.Event_Path_Step{{
 			Value: &pb.Event_Path_Step_Key{
 				Key: artifactName,
 			},
+		}, {
+			Value: &pb.Event_Path_Step_Index{
+				Index: int64(index),
+			},
 		}},
 	}
 }
@@ -363,6 +398,16 @@ func getArtifactName(eventPath *pb.Event_Path) (string, error) {
 	return eventPath.Steps[0].GetKey(), nil
 }
 
+// getArtifactIndex returns the index of an artifact in its artifact list.
+// Events recorded before artifact lists were supported do not have an index,
+// their artifacts are the only artifact of the list.
+func getArtifactIndex(eventPath *pb.Event_Path) int64 {
+	if len(eventPath.GetSteps()) < 2 {
+		return 0
+	}
+	return eventPath.GetSteps()[1].GetIndex()
+}
+
 // PublishExecution publishes the specified execution with the given output
 // parameters, artifacts and state.
 func (c *Client) PublishExecution(ctx context.Context, execution *Execution, outputParameters map[string]*structpb.Value, outputArtifacts []*OutputArtifact, state pb.Execution_State) error {
@@ -391,7 +436,11 @@ func (c *Client) PublishExecution(ctx context.Context, execution *Execution, out
 		Contexts:  contexts,
 	}
 
+	// index of the next artifact of each output artifact list
+	indexes := make(map[string]int)
 	for _, oa := range outputArtifacts {
+		index := indexes[oa.Name]
+		indexes[oa.Name]++
 		aePair := &pb.PutExecutionRequest_ArtifactAndEvent{}
 		if oa.Artifact.GetId() == 0 {
 			glog.Infof("the id of output artifact is not set, will create new artifact when publishing execution")
@@ -399,14 +448,14 @@ func (c *Client) PublishExecution(ctx context.Context, execution *Execution, out
 				Artifact: oa.Artifact,
 				Event: &pb.Event{
 					Type: pb.Event_OUTPUT.Enum(),
-					Path: eventPath(oa.Name),
+					Path: eventPath(oa.Name, index),
 				},
 			}
 		} else {
 			aePair = &pb.PutExecutionRequest_ArtifactAndEvent{
 				Event: &pb.Event{
 					Type:       pb.Event_OUTPUT.Enum(),
-					Path:       eventPath(oa.Name),
+					Path:       eventPath(oa.Name, index),
 					ArtifactId: oa.Artifact.Id,
 				},
 			}
@@ -435,6 +484,11 @@ const (
 	keyParentDagID       = "parent_dag_id" // Parent DAG Execution ID.
 	keyIterationIndex    = "iteration_index"
 	keyIterationCount    = "iteration_count"
+	// Attempt of a retried execution, the first attempt is 0 and does not
+	// have the key.
+	keyAttempt = "attempt"
+	// ID of the execution of the first attempt of a retried execution.
+	keyRetryOfExecutionID = "retry_of_execution_id"
 )
 
 // CreateExecution creates a new MLMD execution under the specified Pipeline.
@@ -502,12 +556,12 @@ func (c *Client) CreateExecution(ctx context.Context, pipeline *Pipeline, config
 	}
 
 	for name, ids := range config.InputArtifactIDs {
-		for _, id := range ids {
+		for index, id := range ids {
 			thisId := id // thisId will be referenced below, so we need a local immutable var
 			aePair := &pb.PutExecutionRequest_ArtifactAndEvent{
 				Event: &pb.Event{
 					ArtifactId: &thisId,
-					Path:       eventPath(name),
+					Path:       eventPath(name, index),
 					Type:       pb.Event_INPUT.Enum(),
 				},
 			}
@@ -559,6 +613,99 @@ func (c *Client) PrePublishExecution(ctx context.Context, execution *Execution,
 	return execution, nil
 }
 
+// CreateRetryExecution creates a new MLMD execution for another attempt of
+// the execution of a retried task, with the same inputs and the Pod info of
+// the attempt. Attempts that are still running are failed attempts whose Pod
+// was interrupted, so they are marked as failed.
+func (c *Client) CreateRetryExecution(ctx context.Context, original *Execution, config *ExecutionConfig) (execution *Execution, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("failed to create retry of execution ID=%v: %w", original.GetID(), err)
+		}
+	}()
+	pipeline := original.GetPipeline()
+	if pipeline.GetRunCtxID() == 0 {
+		return nil, fmt.Errorf("the execution is not in a pipeline run context")
+	}
+	retryFilter := fmt.Sprintf("custom_properties.%s.int_value = %v", keyRetryOfExecutionID, original.GetID())
+	res, err := c.svc.GetExecutionsByContext(ctx, &pb.GetExecutionsByContextRequest{
+		ContextId: pipeline.pipelineRunCtx.Id,
+		Options: &pb.ListOperationOptions{
+			FilterQuery: &retryFilter,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	attempts := append([]*pb.Execution{original.execution}, res.GetExecutions()...)
+	for _, attempt := range attempts {
+		if attempt.GetLastKnownState() != pb.Execution_RUNNING {
+			continue
+		}
+		attempt.LastKnownState = pb.Execution_FAILED.Enum()
+		if _, err := c.svc.PutExecution(ctx, &pb.PutExecutionRequest{Execution: attempt}); err != nil {
+			return nil, err
+		}
+	}
+
+	e := &pb.Execution{
+		TypeId:           original.execution.TypeId,
+		CustomProperties: make(map[string]*pb.Value),
+		LastKnownState:   pb.Execution_RUNNING.Enum(),
+	}
+	for key, value := range original.execution.GetCustomProperties() {
+		switch key {
+		case keyOutputs, keyPodName, keyPodUID, keyNamespace:
+		default:
+			e.CustomProperties[key] = value
+		}
+	}
+	attempt := int64(len(attempts))
+	e.CustomProperties[keyAttempt] = intValue(attempt)
+	e.CustomProperties[keyRetryOfExecutionID] = intValue(original.GetID())
+	e.CustomProperties[keyPodName] = stringValue(config.PodName)
+	e.CustomProperties[keyPodUID] = stringValue(config.PodUID)
+	e.CustomProperties[keyNamespace] = stringValue(config.Namespace)
+	if original.execution.GetName() != "" {
+		e.Name = proto.String(fmt.Sprintf("%s-attempt-%v", original.execution.GetName(), attempt))
+	}
+	req := &pb.PutExecutionRequest{
+		Execution: e,
+		Contexts:  []*pb.Context{pipeline.pipelineCtx, pipeline.pipelineRunCtx},
+	}
+	events, err := c.svc.GetEventsByExecutionIDs(ctx, &pb.GetEventsByExecutionIDsRequest{
+		ExecutionIds: []int64{original.GetID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events.GetEvents() {
+		if event.GetType() != pb.Event_INPUT {
+			continue
+		}
+		req.ArtifactEventPairs = append(req.ArtifactEventPairs, &pb.PutExecutionRequest_ArtifactAndEvent{
+			Event: &pb.Event{
+				ArtifactId: event.ArtifactId,
+				Path:       event.GetPath(),
+				Type:       pb.Event_INPUT.Enum(),
+			},
+		})
+	}
+	putRes, err := c.svc.PutExecution(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	retry, err := c.GetExecutions(ctx, []int64{putRes.GetExecutionId()})
+	if err != nil {
+		return nil, err
+	}
+	if len(retry) != 1 {
+		return nil, fmt.Errorf("Expected to get one Execution, got %d instead", len(retry))
+	}
+	glog.Infof("Created execution ID=%v for attempt %v of execution ID=%v", retry[0].GetId(), attempt, original.GetID())
+	return &Execution{pipeline: pipeline, execution: retry[0]}, nil
+}
+
 // GetExecutions ...
 func (c *Client) GetExecutions(ctx context.Context, ids []int64) ([]*pb.Execution, error) {
 	req := &pb.GetExecutionsByIDRequest{ExecutionIds: ids}
@@ -624,12 +771,37 @@ func (c *Client) GetPipelineFromExecution(ctx context.Context, id int64) (*Pipel
 // GetExecutionsInDAG gets all executions in the DAG, and organize them
 // into a map, keyed by task name.
 func (c *Client) GetExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline) (executionsMap map[string]*Execution, err error) {
+	executions, err := c.ListExecutionsInDAG(ctx, dag, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	executionsMap = make(map[string]*Execution)
+	for _, execution := range executions {
+		taskName := execution.TaskName()
+		if taskName == "" {
+			return nil, fmt.Errorf("failed to get executions in %s: empty task name for execution ID: %v", dag.Info(), execution.GetID())
+		}
+		existing, ok := executionsMap[taskName]
+		if ok {
+			// Retried tasks have an execution per attempt.
+			execution, err = preferredAttempt(existing, execution)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get executions in %s: %w", dag.Info(), err)
+			}
+		}
+		executionsMap[taskName] = execution
+	}
+	return executionsMap, nil
+}
+
+// ListExecutionsInDAG lists all executions in the DAG, including every
+// attempt of retried tasks and every iteration of iterators.
+func (c *Client) ListExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline) (executions []*Execution, err error) {
 	defer func() {
 		if err != nil {
-			err = fmt.Errorf("failed to get executions in %s: %w", dag.Info(), err)
+			err = fmt.Errorf("failed to list executions in %s: %w", dag.Info(), err)
 		}
 	}()
-	executionsMap = make(map[string]*Execution)
 	// Documentation on query syntax:
 	// https://github.com/google/ml-metadata/blob/839c3501a195d340d2855b6ffdb2c4b0b49862c9/ml_metadata/proto/metadata_store.proto#L831
 	parentDAGFilter := fmt.Sprintf("custom_properties.parent_dag_id.int_value = %v", dag.Execution.GetID())
@@ -645,21 +817,72 @@ func (c *Client) GetExecutionsInDAG(ctx context.Context, dag *DAG, pipeline *Pip
 	if err != nil {
 		return nil, err
 	}
-	execs := res.GetExecutions()
-	for _, e := range execs {
-		execution := &Execution{execution: e}
-		taskName := execution.TaskName()
-		if taskName == "" {
-			return nil, fmt.Errorf("empty task name for execution ID: %v", execution.GetID())
+	for _, e := range res.GetExecutions() {
+		executions = append(executions, &Execution{execution: e})
+	}
+	return executions, nil
+}
+
+// ListLastAttemptsInDAG lists the executions in the DAG, with only the
+// preferred attempt of retried tasks, see preferredAttempt. Unlike
+// GetExecutionsInDAG, every iteration of iterators is included.
+func (c *Client) ListLastAttemptsInDAG(ctx context.Context, dag *DAG, pipeline *Pipeline) ([]*Execution, error) {
+	executions, err := c.ListExecutionsInDAG(ctx, dag, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	// Attempts are grouped by the execution of their first attempt.
+	var originalIDs []int64
+	attempts := make(map[int64]*Execution)
+	for _, execution := range executions {
+		originalID := execution.GetExecution().GetCustomProperties()[keyRetryOfExecutionID].GetIntValue()
+		if originalID == 0 {
+			originalID = execution.GetID()
 		}
-		existing, ok := executionsMap[taskName]
-		if ok {
-			// TODO(Bobgy): to support retry, we need to handle multiple tasks with the same task name.
-			return nil, fmt.Errorf("two tasks have the same task name %q, id1=%v id2=%v", taskName, existing.GetID(), execution.GetID())
+		existing, ok := attempts[originalID]
+		if !ok {
+			originalIDs = append(originalIDs, originalID)
+		} else if execution, err = preferredAttempt(existing, execution); err != nil {
+			return nil, fmt.Errorf("failed to list last attempts in %s: %w", dag.Info(), err)
 		}
-		executionsMap[taskName] = execution
+		attempts[originalID] = execution
 	}
-	return executionsMap, nil
+	lastAttempts := make([]*Execution, 0, len(originalIDs))
+	for _, id := range originalIDs {
+		lastAttempts = append(lastAttempts, attempts[id])
+	}
+	return lastAttempts, nil
+}
+
+// IsDAGExecution returns whether an execution is the execution of a DAG.
+func (c *Client) IsDAGExecution(ctx context.Context, execution *Execution) (bool, error) {
+	typeID, err := c.getExecutionTypeID(ctx, dagExecutionType)
+	if err != nil {
+		return false, err
+	}
+	return execution.GetExecution().GetTypeId() == typeID, nil
+}
+
+// preferredAttempt returns which of two attempts of a task is used by
+// downstream tasks: the succeeded attempt if any, else the last attempt.
+func preferredAttempt(a, b *Execution) (*Execution, error) {
+	succeeded := func(e *Execution) bool {
+		state := e.GetExecution().GetLastKnownState()
+		return state == pb.Execution_COMPLETE || state == pb.Execution_CACHED
+	}
+	if succeeded(a) != succeeded(b) {
+		if succeeded(a) {
+			return a, nil
+		}
+		return b, nil
+	}
+	if a.Attempt() == b.Attempt() {
+		return nil, fmt.Errorf("two tasks have the same task name %q, id1=%v id2=%v", a.TaskName(), a.GetID(), b.GetID())
+	}
+	if a.Attempt() > b.Attempt() {
+		return a, nil
+	}
+	return b, nil
 }
 
 // GetEventsByArtifactIDs ...
@@ -695,40 +918,21 @@ func (c *Client) GetArtifacts(ctx context.Context, ids []int64) ([]*pb.Artifact,
 	return res.Artifacts, nil
 }
 
-// GetOutputArtifactsByExecutionId ...
-// TODO: Support multiple artifacts someday, probably through the v2 engine.
-func (c *Client) GetOutputArtifactsByExecutionId(ctx context.Context, executionId int64) (map[string]*OutputArtifact, error) {
-	getEventsByExecutionIDsReq := &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionId}}
-	getEventsByExecutionIDsRes, err := c.svc.GetEventsByExecutionIDs(ctx, getEventsByExecutionIDsReq)
+// GetOutputArtifactsByExecutionId returns the output artifact lists of an
+// execution by output name, in the order the artifacts were published.
+func (c *Client) GetOutputArtifactsByExecutionId(ctx context.Context, executionId int64) (map[string][]*OutputArtifact, error) {
+	artifactLists, err := c.getArtifactListsByExecutionID(ctx, executionId, pb.Event_OUTPUT)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get events with execution id %v: %w", executionId, err)
-	}
-	var outputArtifactsIDs []int64
-	outputArtifactNamesById := make(map[int64]string)
-	for _, event := range getEventsByExecutionIDsRes.Events {
-		if *event.Type == pb.Event_OUTPUT {
-			outputArtifactsIDs = append(outputArtifactsIDs, event.GetArtifactId())
-			artifactName, err := getArtifactName(event.Path)
-			if err != nil {
-				return nil, err
-			}
-			outputArtifactNamesById[event.GetArtifactId()] = artifactName
-		}
-	}
-	outputArtifacts, err := c.GetArtifacts(ctx, outputArtifactsIDs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get output artifacts: %w", err)
-	}
-	outputArtifactsByName := make(map[string]*OutputArtifact)
-	for _, outputArtifact := range outputArtifacts {
-		name, ok := outputArtifactNamesById[outputArtifact.GetId()]
-		if !ok {
-			return nil, fmt.Errorf("failed to get name of artifact with id %v", outputArtifact.GetId())
-		}
-		outputArtifactsByName[name] = &OutputArtifact{
-			Name:     name,
-			Artifact: outputArtifact,
-			Schema:   "", // TODO(Bobgy): figure out how to get schema
+		return nil, fmt.Errorf("failed to get output artifacts of execution id %v: %w", executionId, err)
+	}
+	outputArtifactsByName := make(map[string][]*OutputArtifact)
+	for name, artifacts := range artifactLists {
+		for _, outputArtifact := range artifacts {
+			outputArtifactsByName[name] = append(outputArtifactsByName[name], &OutputArtifact{
+				Name:     name,
+				Artifact: outputArtifact,
+				Schema:   "", // TODO(Bobgy): figure out how to get schema
+			})
 		}
 	}
 	return outputArtifactsByName, nil
@@ -740,42 +944,89 @@ func (c *Client) GetInputArtifactsByExecutionID(ctx context.Context, executionID
 			err = fmt.Errorf("GetInputArtifactsByExecution(id=%v) failed: %w", executionID, err)
 		}
 	}()
-	eventsReq := &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionID}}
-	eventsRes, err := c.svc.GetEventsByExecutionIDs(ctx, eventsReq)
+	artifactLists, err := c.getArtifactListsByExecutionID(ctx, executionID, pb.Event_INPUT)
 	if err != nil {
 		return nil, err
 	}
-	var artifactIDs []int64
-	nameByID := make(map[int64]string)
-	for _, event := range eventsRes.Events {
-		if *event.Type == pb.Event_INPUT {
-			artifactIDs = append(artifactIDs, event.GetArtifactId())
-			name, err := getArtifactName(event.Path)
+	inputs = make(map[string]*pipelinespec.ArtifactList)
+	for name, artifacts := range artifactLists {
+		artifactList := &pipelinespec.ArtifactList{}
+		for _, artifact := range artifacts {
+			runtimeArtifact, err := toRuntimeArtifact(artifact)
 			if err != nil {
 				return nil, err
 			}
-			nameByID[event.GetArtifactId()] = name
+			artifactList.Artifacts = append(artifactList.Artifacts, runtimeArtifact)
 		}
+		inputs[name] = artifactList
 	}
-	artifacts, err := c.GetArtifacts(ctx, artifactIDs)
+	return inputs, nil
+}
+
+// getArtifactListsByExecutionID returns the artifacts of the events of a type
+// of an execution by artifact name, ordered by their indexes in the lists.
+func (c *Client) getArtifactListsByExecutionID(ctx context.Context, executionID int64, eventType pb.Event_Type) (map[string][]*pb.Artifact, error) {
+	eventsRes, err := c.svc.GetEventsByExecutionIDs(ctx, &pb.GetEventsByExecutionIDsRequest{ExecutionIds: []int64{executionID}})
 	if err != nil {
 		return nil, err
 	}
-	inputs = make(map[string]*pipelinespec.ArtifactList)
-	for _, artifact := range artifacts {
-		name, ok := nameByID[artifact.GetId()]
-		if !ok {
-			return nil, fmt.Errorf("failed to get name of artifact with id %v", artifact.GetId())
+	type indexedArtifactID struct {
+		index, id int64
+	}
+	var artifactIDs []int64
+	idsByName := make(map[string][]indexedArtifactID)
+	for _, event := range eventsRes.Events {
+		if event.GetType() != eventType {
+			continue
 		}
-		runtimeArtifact, err := toRuntimeArtifact(artifact)
+		name, err := getArtifactName(event.Path)
 		if err != nil {
 			return nil, err
 		}
-		inputs[name] = &pipelinespec.ArtifactList{
-			Artifacts: []*pipelinespec.RuntimeArtifact{runtimeArtifact},
+		idsByName[name] = append(idsByName[name], indexedArtifactID{index: getArtifactIndex(event.Path), id: event.GetArtifactId()})
+		artifactIDs = append(artifactIDs, event.GetArtifactId())
+	}
+	artifacts, err := c.GetArtifacts(ctx, artifactIDs)
+	if err != nil {
+		return nil, err
+	}
+	artifactsByID := make(map[int64]*pb.Artifact)
+	for _, artifact := range artifacts {
+		artifactsByID[artifact.GetId()] = artifact
+	}
+	artifactLists := make(map[string][]*pb.Artifact)
+	for name, ids := range idsByName {
+		sort.SliceStable(ids, func(i, j int) bool { return ids[i].index < ids[j].index })
+		for _, id := range ids {
+			artifact, ok := artifactsByID[id.id]
+			if !ok {
+				return nil, fmt.Errorf("failed to get artifact with id %v of %q", id.id, name)
+			}
+			artifactLists[name] = append(artifactLists[name], artifact)
 		}
 	}
-	return inputs, nil
+	return artifactLists, nil
+}
+
+// QueryArtifacts returns the latest artifacts that match an MLMD filter
+// query, latest first. At most limit artifacts are returned.
+// Documentation on query syntax:
+// https://github.com/google/ml-metadata/blob/839c3501a195d340d2855b6ffdb2c4b0b49862c9/ml_metadata/proto/metadata_store.proto#L831
+func (c *Client) QueryArtifacts(ctx context.Context, filterQuery string, limit int32) ([]*pb.Artifact, error) {
+	res, err := c.svc.GetArtifacts(ctx, &pb.GetArtifactsRequest{
+		Options: &pb.ListOperationOptions{
+			MaxResultSize: proto.Int32(limit),
+			OrderByField: &pb.ListOperationOptions_OrderByField{
+				Field: pb.ListOperationOptions_OrderByField_CREATE_TIME.Enum(),
+				IsAsc: proto.Bool(false),
+			},
+			FilterQuery: proto.String(filterQuery),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query artifacts with filter %q: %w", filterQuery, err)
+	}
+	return res.GetArtifacts(), nil
 }
 
 // Only supports schema titles for now.
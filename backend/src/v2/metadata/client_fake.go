@@ -0,0 +1,630 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewFakeClient returns a client of an in-memory MLMD store, for tests and
+// local runs.
+// The store implements the subset of the MLMD API used by the client. Its
+// filter queries support conjunctions of equalities of the fields id, name,
+// type, uri, state, last_known_state, contexts_<alias>.id,
+// contexts_<alias>.name and custom_properties.<key>.<int|string>_value.
+func NewFakeClient() *Client {
+	return &Client{
+		svc:          newFakeMetadataStore(),
+		ctxTypeCache: sync.Map{},
+	}
+}
+
+// fakeMetadataStore is an in-memory MLMD store. Calling a method of the MLMD
+// API that it does not implement panics.
+type fakeMetadataStore struct {
+	pb.MetadataStoreServiceClient
+
+	mu             sync.Mutex
+	lastID         int64
+	clock          int64
+	artifactTypes  map[string]*pb.ArtifactType
+	executionTypes map[string]*pb.ExecutionType
+	contextTypes   map[string]*pb.ContextType
+	artifacts      map[int64]*pb.Artifact
+	executions     map[int64]*pb.Execution
+	contexts       map[int64]*pb.Context
+	events         []*pb.Event
+	// artifact and execution IDs of each context ID
+	attributions map[int64]map[int64]bool
+	associations map[int64]map[int64]bool
+	parents      map[int64]map[int64]bool
+}
+
+func newFakeMetadataStore() *fakeMetadataStore {
+	return &fakeMetadataStore{
+		artifactTypes:  make(map[string]*pb.ArtifactType),
+		executionTypes: make(map[string]*pb.ExecutionType),
+		contextTypes:   make(map[string]*pb.ContextType),
+		artifacts:      make(map[int64]*pb.Artifact),
+		executions:     make(map[int64]*pb.Execution),
+		contexts:       make(map[int64]*pb.Context),
+		attributions:   make(map[int64]map[int64]bool),
+		associations:   make(map[int64]map[int64]bool),
+		parents:        make(map[int64]map[int64]bool),
+	}
+}
+
+func (s *fakeMetadataStore) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+// now returns increasing timestamps, so that the order of the create times is
+// the order of the creations.
+func (s *fakeMetadataStore) now() int64 {
+	s.clock++
+	return s.clock
+}
+
+func (s *fakeMetadataStore) PutArtifactType(ctx context.Context, in *pb.PutArtifactTypeRequest, opts ...grpc.CallOption) (*pb.PutArtifactTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.artifactTypes[in.GetArtifactType().GetName()]; ok {
+		return &pb.PutArtifactTypeResponse{TypeId: t.Id}, nil
+	}
+	t := proto.Clone(in.GetArtifactType()).(*pb.ArtifactType)
+	t.Id = proto.Int64(s.nextID())
+	s.artifactTypes[t.GetName()] = t
+	return &pb.PutArtifactTypeResponse{TypeId: t.Id}, nil
+}
+
+func (s *fakeMetadataStore) GetArtifactType(ctx context.Context, in *pb.GetArtifactTypeRequest, opts ...grpc.CallOption) (*pb.GetArtifactTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetArtifactTypeResponse{ArtifactType: s.artifactTypes[in.GetTypeName()]}, nil
+}
+
+func (s *fakeMetadataStore) PutExecutionType(ctx context.Context, in *pb.PutExecutionTypeRequest, opts ...grpc.CallOption) (*pb.PutExecutionTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.executionTypes[in.GetExecutionType().GetName()]; ok {
+		return &pb.PutExecutionTypeResponse{TypeId: t.Id}, nil
+	}
+	t := proto.Clone(in.GetExecutionType()).(*pb.ExecutionType)
+	t.Id = proto.Int64(s.nextID())
+	s.executionTypes[t.GetName()] = t
+	return &pb.PutExecutionTypeResponse{TypeId: t.Id}, nil
+}
+
+func (s *fakeMetadataStore) PutContextType(ctx context.Context, in *pb.PutContextTypeRequest, opts ...grpc.CallOption) (*pb.PutContextTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.contextTypes[in.GetContextType().GetName()]; ok {
+		return &pb.PutContextTypeResponse{TypeId: t.Id}, nil
+	}
+	t := proto.Clone(in.GetContextType()).(*pb.ContextType)
+	t.Id = proto.Int64(s.nextID())
+	s.contextTypes[t.GetName()] = t
+	return &pb.PutContextTypeResponse{TypeId: t.Id}, nil
+}
+
+func (s *fakeMetadataStore) GetContextType(ctx context.Context, in *pb.GetContextTypeRequest, opts ...grpc.CallOption) (*pb.GetContextTypeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.contextTypes[in.GetTypeName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "context type %q not found", in.GetTypeName())
+	}
+	return &pb.GetContextTypeResponse{ContextType: t}, nil
+}
+
+func (s *fakeMetadataStore) PutContexts(ctx context.Context, in *pb.PutContextsRequest, opts ...grpc.CallOption) (*pb.PutContextsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.PutContextsResponse{}
+	for _, c := range in.GetContexts() {
+		id, err := s.putContext(c)
+		if err != nil {
+			return nil, err
+		}
+		res.ContextIds = append(res.ContextIds, id)
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) putContext(c *pb.Context) (int64, error) {
+	if c.GetId() != 0 {
+		if _, ok := s.contexts[c.GetId()]; !ok {
+			return 0, status.Errorf(codes.NotFound, "context %v not found", c.GetId())
+		}
+		s.contexts[c.GetId()] = proto.Clone(c).(*pb.Context)
+		return c.GetId(), nil
+	}
+	for _, existing := range s.contexts {
+		if existing.GetTypeId() == c.GetTypeId() && existing.GetName() == c.GetName() {
+			return 0, status.Errorf(codes.AlreadyExists, "context %q already exists", c.GetName())
+		}
+	}
+	c = proto.Clone(c).(*pb.Context)
+	c.Id = proto.Int64(s.nextID())
+	c.CreateTimeSinceEpoch = proto.Int64(s.now())
+	c.LastUpdateTimeSinceEpoch = c.CreateTimeSinceEpoch
+	s.contexts[c.GetId()] = c
+	return c.GetId(), nil
+}
+
+func (s *fakeMetadataStore) GetContextByTypeAndName(ctx context.Context, in *pb.GetContextByTypeAndNameRequest, opts ...grpc.CallOption) (*pb.GetContextByTypeAndNameResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.contextTypes[in.GetTypeName()]
+	if !ok {
+		return &pb.GetContextByTypeAndNameResponse{}, nil
+	}
+	for _, c := range s.contexts {
+		if c.GetTypeId() == t.GetId() && c.GetName() == in.GetContextName() {
+			return &pb.GetContextByTypeAndNameResponse{Context: proto.Clone(c).(*pb.Context)}, nil
+		}
+	}
+	return &pb.GetContextByTypeAndNameResponse{}, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByID(ctx context.Context, in *pb.GetContextsByIDRequest, opts ...grpc.CallOption) (*pb.GetContextsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetContextsByIDResponse{}
+	for _, id := range in.GetContextIds() {
+		if c, ok := s.contexts[id]; ok {
+			res.Contexts = append(res.Contexts, proto.Clone(c).(*pb.Context))
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByExecution(ctx context.Context, in *pb.GetContextsByExecutionRequest, opts ...grpc.CallOption) (*pb.GetContextsByExecutionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetContextsByExecutionResponse{Contexts: s.contextsOf(s.associations, in.GetExecutionId())}, nil
+}
+
+func (s *fakeMetadataStore) GetContextsByArtifact(ctx context.Context, in *pb.GetContextsByArtifactRequest, opts ...grpc.CallOption) (*pb.GetContextsByArtifactResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &pb.GetContextsByArtifactResponse{Contexts: s.contextsOf(s.attributions, in.GetArtifactId())}, nil
+}
+
+// contextsOf returns the contexts with which a node is associated or
+// attributed, sorted by ID.
+func (s *fakeMetadataStore) contextsOf(links map[int64]map[int64]bool, nodeID int64) []*pb.Context {
+	var contexts []*pb.Context
+	for contextID, nodes := range links {
+		if nodes[nodeID] {
+			contexts = append(contexts, proto.Clone(s.contexts[contextID]).(*pb.Context))
+		}
+	}
+	sort.Slice(contexts, func(i, j int) bool { return contexts[i].GetId() < contexts[j].GetId() })
+	return contexts
+}
+
+func (s *fakeMetadataStore) PutParentContexts(ctx context.Context, in *pb.PutParentContextsRequest, opts ...grpc.CallOption) (*pb.PutParentContextsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range in.GetParentContexts() {
+		if s.parents[p.GetChildId()] == nil {
+			s.parents[p.GetChildId()] = make(map[int64]bool)
+		}
+		s.parents[p.GetChildId()][p.GetParentId()] = true
+	}
+	return &pb.PutParentContextsResponse{}, nil
+}
+
+func (s *fakeMetadataStore) PutArtifacts(ctx context.Context, in *pb.PutArtifactsRequest, opts ...grpc.CallOption) (*pb.PutArtifactsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.PutArtifactsResponse{}
+	for _, a := range in.GetArtifacts() {
+		id, err := s.putArtifact(a)
+		if err != nil {
+			return nil, err
+		}
+		res.ArtifactIds = append(res.ArtifactIds, id)
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) putArtifact(a *pb.Artifact) (int64, error) {
+	a = proto.Clone(a).(*pb.Artifact)
+	if a.GetId() != 0 {
+		existing, ok := s.artifacts[a.GetId()]
+		if !ok {
+			return 0, status.Errorf(codes.NotFound, "artifact %v not found", a.GetId())
+		}
+		a.CreateTimeSinceEpoch = existing.CreateTimeSinceEpoch
+	} else {
+		a.Id = proto.Int64(s.nextID())
+		a.CreateTimeSinceEpoch = proto.Int64(s.now())
+	}
+	a.LastUpdateTimeSinceEpoch = proto.Int64(s.now())
+	s.artifacts[a.GetId()] = a
+	return a.GetId(), nil
+}
+
+func (s *fakeMetadataStore) PutExecution(ctx context.Context, in *pb.PutExecutionRequest, opts ...grpc.CallOption) (*pb.PutExecutionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := proto.Clone(in.GetExecution()).(*pb.Execution)
+	if e.GetId() != 0 {
+		existing, ok := s.executions[e.GetId()]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "execution %v not found", e.GetId())
+		}
+		e.CreateTimeSinceEpoch = existing.CreateTimeSinceEpoch
+	} else {
+		if e.GetName() != "" {
+			for _, existing := range s.executions {
+				if existing.GetTypeId() == e.GetTypeId() && existing.GetName() == e.GetName() {
+					return nil, status.Errorf(codes.AlreadyExists, "execution %q already exists", e.GetName())
+				}
+			}
+		}
+		e.Id = proto.Int64(s.nextID())
+		e.CreateTimeSinceEpoch = proto.Int64(s.now())
+	}
+	e.LastUpdateTimeSinceEpoch = proto.Int64(s.now())
+	s.executions[e.GetId()] = e
+	res := &pb.PutExecutionResponse{ExecutionId: e.Id}
+
+	var artifactIDs []int64
+	for _, pair := range in.GetArtifactEventPairs() {
+		artifactID := pair.GetEvent().GetArtifactId()
+		if pair.GetArtifact() != nil {
+			id, err := s.putArtifact(pair.GetArtifact())
+			if err != nil {
+				return nil, err
+			}
+			artifactID = id
+		}
+		if _, ok := s.artifacts[artifactID]; !ok {
+			return nil, status.Errorf(codes.NotFound, "artifact %v not found", artifactID)
+		}
+		artifactIDs = append(artifactIDs, artifactID)
+		res.ArtifactIds = append(res.ArtifactIds, artifactID)
+		if pair.GetEvent() != nil {
+			event := proto.Clone(pair.GetEvent()).(*pb.Event)
+			event.ArtifactId = proto.Int64(artifactID)
+			event.ExecutionId = e.Id
+			event.MillisecondsSinceEpoch = proto.Int64(s.now())
+			s.events = append(s.events, event)
+		}
+	}
+	for _, c := range in.GetContexts() {
+		contextID := c.GetId()
+		if contextID == 0 {
+			id, err := s.putContext(c)
+			if err != nil {
+				return nil, err
+			}
+			contextID = id
+		}
+		res.ContextIds = append(res.ContextIds, contextID)
+		link(s.associations, contextID, e.GetId())
+		for _, artifactID := range artifactIDs {
+			link(s.attributions, contextID, artifactID)
+		}
+	}
+	return res, nil
+}
+
+func link(links map[int64]map[int64]bool, contextID, nodeID int64) {
+	if links[contextID] == nil {
+		links[contextID] = make(map[int64]bool)
+	}
+	links[contextID][nodeID] = true
+}
+
+func (s *fakeMetadataStore) GetArtifactsByID(ctx context.Context, in *pb.GetArtifactsByIDRequest, opts ...grpc.CallOption) (*pb.GetArtifactsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetArtifactsByIDResponse{}
+	for _, id := range in.GetArtifactIds() {
+		if a, ok := s.artifacts[id]; ok {
+			res.Artifacts = append(res.Artifacts, proto.Clone(a).(*pb.Artifact))
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetArtifactsByURI(ctx context.Context, in *pb.GetArtifactsByURIRequest, opts ...grpc.CallOption) (*pb.GetArtifactsByURIResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetArtifactsByURIResponse{}
+	for _, a := range s.sortedArtifacts() {
+		for _, uri := range in.GetUris() {
+			if a.GetUri() == uri {
+				res.Artifacts = append(res.Artifacts, proto.Clone(a).(*pb.Artifact))
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetArtifacts(ctx context.Context, in *pb.GetArtifactsRequest, opts ...grpc.CallOption) (*pb.GetArtifactsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var nodes []fakeNode
+	for _, a := range s.sortedArtifacts() {
+		nodes = append(nodes, fakeNode{artifact: a})
+	}
+	nodes, err := s.list(nodes, in.GetOptions(), s.attributions)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.GetArtifactsResponse{}
+	for _, node := range nodes {
+		res.Artifacts = append(res.Artifacts, proto.Clone(node.artifact).(*pb.Artifact))
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetExecutionsByID(ctx context.Context, in *pb.GetExecutionsByIDRequest, opts ...grpc.CallOption) (*pb.GetExecutionsByIDResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetExecutionsByIDResponse{}
+	for _, id := range in.GetExecutionIds() {
+		if e, ok := s.executions[id]; ok {
+			res.Executions = append(res.Executions, proto.Clone(e).(*pb.Execution))
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetExecutionsByContext(ctx context.Context, in *pb.GetExecutionsByContextRequest, opts ...grpc.CallOption) (*pb.GetExecutionsByContextResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var nodes []fakeNode
+	for _, e := range s.sortedExecutions() {
+		if s.associations[in.GetContextId()][e.GetId()] {
+			nodes = append(nodes, fakeNode{execution: e})
+		}
+	}
+	nodes, err := s.list(nodes, in.GetOptions(), s.associations)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.GetExecutionsByContextResponse{}
+	for _, node := range nodes {
+		res.Executions = append(res.Executions, proto.Clone(node.execution).(*pb.Execution))
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetEventsByExecutionIDs(ctx context.Context, in *pb.GetEventsByExecutionIDsRequest, opts ...grpc.CallOption) (*pb.GetEventsByExecutionIDsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetEventsByExecutionIDsResponse{}
+	for _, event := range s.events {
+		for _, id := range in.GetExecutionIds() {
+			if event.GetExecutionId() == id {
+				res.Events = append(res.Events, proto.Clone(event).(*pb.Event))
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) GetEventsByArtifactIDs(ctx context.Context, in *pb.GetEventsByArtifactIDsRequest, opts ...grpc.CallOption) (*pb.GetEventsByArtifactIDsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &pb.GetEventsByArtifactIDsResponse{}
+	for _, event := range s.events {
+		for _, id := range in.GetArtifactIds() {
+			if event.GetArtifactId() == id {
+				res.Events = append(res.Events, proto.Clone(event).(*pb.Event))
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeMetadataStore) sortedArtifacts() []*pb.Artifact {
+	artifacts := make([]*pb.Artifact, 0, len(s.artifacts))
+	for _, a := range s.artifacts {
+		artifacts = append(artifacts, a)
+	}
+	sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].GetId() < artifacts[j].GetId() })
+	return artifacts
+}
+
+func (s *fakeMetadataStore) sortedExecutions() []*pb.Execution {
+	executions := make([]*pb.Execution, 0, len(s.executions))
+	for _, e := range s.executions {
+		executions = append(executions, e)
+	}
+	sort.Slice(executions, func(i, j int) bool { return executions[i].GetId() < executions[j].GetId() })
+	return executions
+}
+
+// fakeNode is either an artifact or an execution.
+type fakeNode struct {
+	artifact  *pb.Artifact
+	execution *pb.Execution
+}
+
+func (n fakeNode) id() int64 {
+	if n.artifact != nil {
+		return n.artifact.GetId()
+	}
+	return n.execution.GetId()
+}
+
+func (n fakeNode) createTime() int64 {
+	if n.artifact != nil {
+		return n.artifact.GetCreateTimeSinceEpoch()
+	}
+	return n.execution.GetCreateTimeSinceEpoch()
+}
+
+// list filters, orders and limits nodes sorted by ID according to list
+// options.
+func (s *fakeMetadataStore) list(nodes []fakeNode, options *pb.ListOperationOptions, links map[int64]map[int64]bool) ([]fakeNode, error) {
+	if options == nil {
+		return nodes, nil
+	}
+	if options.GetNextPageToken() != "" {
+		return nil, status.Errorf(codes.Unimplemented, "the fake MLMD store does not support pages")
+	}
+	var matched []fakeNode
+	for _, node := range nodes {
+		ok, err := s.match(node, options.GetFilterQuery(), links)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, node)
+		}
+	}
+	if options.GetOrderByField().GetField() == pb.ListOperationOptions_OrderByField_CREATE_TIME {
+		sort.SliceStable(matched, func(i, j int) bool { return matched[i].createTime() < matched[j].createTime() })
+	}
+	if !options.GetOrderByField().GetIsAsc() {
+		for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+			matched[i], matched[j] = matched[j], matched[i]
+		}
+	}
+	if size := int(options.GetMaxResultSize()); size > 0 && len(matched) > size {
+		matched = matched[:size]
+	}
+	return matched, nil
+}
+
+// match returns whether a node matches a filter query.
+func (s *fakeMetadataStore) match(node fakeNode, filter string, links map[int64]map[int64]bool) (bool, error) {
+	if strings.TrimSpace(filter) == "" {
+		return true, nil
+	}
+	for _, condition := range strings.Split(filter, " AND ") {
+		parts := strings.SplitN(condition, "=", 2)
+		if len(parts) != 2 {
+			return false, status.Errorf(codes.InvalidArgument, "unsupported filter condition %q", condition)
+		}
+		field := strings.TrimSpace(parts[0])
+		want := strings.Trim(strings.TrimSpace(parts[1]), `'"`)
+		got, err := s.fieldValues(node, field, links)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, value := range got {
+			if value == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// fieldValues returns the values of a field of a node as strings, there are
+// several values for the fields of the contexts of the node.
+func (s *fakeMetadataStore) fieldValues(node fakeNode, field string, links map[int64]map[int64]bool) ([]string, error) {
+	var name, uri, state string
+	var typeID int64
+	var customProperties map[string]*pb.Value
+	if node.artifact != nil {
+		name, uri, state = node.artifact.GetName(), node.artifact.GetUri(), node.artifact.GetState().String()
+		typeID = node.artifact.GetTypeId()
+		customProperties = node.artifact.GetCustomProperties()
+	} else {
+		name, state = node.execution.GetName(), node.execution.GetLastKnownState().String()
+		typeID = node.execution.GetTypeId()
+		customProperties = node.execution.GetCustomProperties()
+	}
+	switch {
+	case field == "id":
+		return []string{strconv.FormatInt(node.id(), 10)}, nil
+	case field == "name":
+		return []string{name}, nil
+	case field == "uri":
+		return []string{uri}, nil
+	case field == "state" || field == "last_known_state":
+		return []string{state}, nil
+	case field == "type":
+		return []string{s.typeName(node, typeID)}, nil
+	case strings.HasPrefix(field, "contexts_"):
+		var values []string
+		for contextID, nodes := range links {
+			if !nodes[node.id()] {
+				continue
+			}
+			switch {
+			case strings.HasSuffix(field, ".id"):
+				values = append(values, strconv.FormatInt(contextID, 10))
+			case strings.HasSuffix(field, ".name"):
+				values = append(values, s.contexts[contextID].GetName())
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "unsupported filter field %q", field)
+			}
+		}
+		return values, nil
+	case strings.HasPrefix(field, "custom_properties."):
+		parts := strings.Split(field, ".")
+		if len(parts) != 3 {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported filter field %q", field)
+		}
+		value, ok := customProperties[parts[1]]
+		if !ok {
+			return nil, nil
+		}
+		switch parts[2] {
+		case "int_value":
+			return []string{strconv.FormatInt(value.GetIntValue(), 10)}, nil
+		case "string_value":
+			return []string{value.GetStringValue()}, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "unsupported filter field %q", field)
+}
+
+func (s *fakeMetadataStore) typeName(node fakeNode, typeID int64) string {
+	if node.artifact != nil {
+		for name, t := range s.artifactTypes {
+			if t.GetId() == typeID {
+				return name
+			}
+		}
+		return ""
+	}
+	for name, t := range s.executionTypes {
+		if t.GetId() == typeID {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	pb "github.com/kubeflow/pipelines/third_party/ml-metadata/go/ml_metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func attemptExecution(id, attempt int64, state pb.Execution_State) *Execution {
+	e := &pb.Execution{
+		Id:             proto.Int64(id),
+		LastKnownState: state.Enum(),
+		CustomProperties: map[string]*pb.Value{
+			keyTaskName: stringValue("task"),
+		},
+	}
+	if attempt > 0 {
+		e.CustomProperties[keyAttempt] = intValue(attempt)
+		e.CustomProperties[keyRetryOfExecutionID] = intValue(1)
+	}
+	return &Execution{execution: e}
+}
+
+func Test_preferredAttempt(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    *Execution
+		wantID  int64
+		wantErr bool
+	}{{
+		name:   "succeeded attempt before a failed attempt",
+		a:      attemptExecution(1, 0, pb.Execution_COMPLETE),
+		b:      attemptExecution(2, 1, pb.Execution_FAILED),
+		wantID: 1,
+	}, {
+		name:   "succeeded attempt after failed attempts",
+		a:      attemptExecution(3, 2, pb.Execution_COMPLETE),
+		b:      attemptExecution(1, 0, pb.Execution_FAILED),
+		wantID: 3,
+	}, {
+		name:   "cached attempt",
+		a:      attemptExecution(1, 0, pb.Execution_FAILED),
+		b:      attemptExecution(2, 1, pb.Execution_CACHED),
+		wantID: 2,
+	}, {
+		name:   "last running attempt",
+		a:      attemptExecution(2, 1, pb.Execution_FAILED),
+		b:      attemptExecution(3, 2, pb.Execution_RUNNING),
+		wantID: 3,
+	}, {
+		name:    "same attempt",
+		a:       attemptExecution(1, 0, pb.Execution_COMPLETE),
+		b:       attemptExecution(2, 0, pb.Execution_COMPLETE),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := preferredAttempt(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("preferredAttempt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.GetID() != tt.wantID {
+				t.Errorf("preferredAttempt() = execution ID %v, want %v", got.GetID(), tt.wantID)
+			}
+		})
+	}
+}
@@ -31,12 +31,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/golang/glog"
 	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
 	_ "gocloud.dev/blob/gcsblob"
 	"gocloud.dev/blob/s3blob"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
+// The scheme of pipeline roots and artifact URIs on the local filesystem, e.g.
+// file:///mnt/pipeline-root. The artifacts are stored at the path of their
+// URI, so the directory must be shared by the pods of a run, e.g. a PVC
+// mounted at the same path in every pod.
+const fileScheme = "file:///"
+
 type Config struct {
 	Scheme      string
 	BucketName  string
@@ -75,9 +82,39 @@ func OpenBucket(ctx context.Context, k8sClient kubernetes.Interface, namespace s
 		return blob.PrefixedBucket(minioBucket, config.Prefix), nil
 
 	}
+	if config.IsFile() {
+		return openFileBucket(config)
+	}
 	return blob.OpenBucket(ctx, config.bucketURL())
 }
 
+// openFileBucket opens a bucket on the local filesystem, the directory of the
+// bucket is created when it does not exist.
+func openFileBucket(config *Config) (*blob.Bucket, error) {
+	dir := "/" + config.BucketName
+	if err := os.MkdirAll(filepath.Join(dir, config.Prefix), 0755); err != nil {
+		return nil, fmt.Errorf("Failed to create directory of the bucket: %w", err)
+	}
+	// fileblob.OpenBucket does not allow overriding prefix via bucketConfig.BucketURL() either.
+	fileBucket, err := fileblob.OpenBucket(dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return blob.PrefixedBucket(fileBucket, config.Prefix), nil
+}
+
+// IsFile returns whether the bucket is a directory on the local filesystem.
+func (b *Config) IsFile() bool {
+	return b.Scheme == fileScheme
+}
+
+// IsFileURI returns whether an artifact URI is a path on the local filesystem.
+// Such artifacts are written and read in place, there is nothing to upload or
+// download.
+func IsFileURI(uri string) bool {
+	return strings.HasPrefix(uri, fileScheme)
+}
+
 func (b *Config) bucketURL() string {
 	u := b.Scheme + b.BucketName
 
@@ -187,9 +224,8 @@ func ParseBucketConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized pipeline root format: %q", path)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" {
-		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", path)
+	if err := validateScheme(ms[1], path); err != nil {
+		return nil, err
 	}
 
 	prefix := strings.TrimPrefix(ms[3], "/")
@@ -211,9 +247,8 @@ func ParseBucketConfigForArtifactURI(uri string) (*Config, error) {
 		return nil, fmt.Errorf("parse bucket config failed: unrecognized uri format: %q", uri)
 	}
 
-	// TODO: Verify/add support for file:///.
-	if ms[1] != "gs://" && ms[1] != "s3://" && ms[1] != "minio://" {
-		return nil, fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", uri)
+	if err := validateScheme(ms[1], uri); err != nil {
+		return nil, err
 	}
 
 	return &Config{
@@ -222,6 +257,17 @@ func ParseBucketConfigForArtifactURI(uri string) (*Config, error) {
 	}, nil
 }
 
+func validateScheme(scheme string, path string) error {
+	switch scheme {
+	case "gs://", "s3://", "minio://", fileScheme:
+		return nil
+	case "file://":
+		return fmt.Errorf("parse bucket config failed: file path must be absolute, e.g. file:///mnt/pipeline-root: %q", path)
+	default:
+		return fmt.Errorf("parse bucket config failed: unsupported Cloud bucket: %q", path)
+	}
+}
+
 // TODO(neuromage): Move these helper functions to a storage package and add tests.
 func uploadFile(ctx context.Context, bucket *blob.Bucket, localFilePath, blobFilePath string) error {
 	errorF := func(err error) error {
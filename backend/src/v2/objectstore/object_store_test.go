@@ -15,7 +15,10 @@
 package objectstore_test
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -120,6 +123,23 @@ func Test_parseCloudBucket(t *testing.T) {
 				QueryString: "",
 			},
 			wantErr: false,
+		}, {
+			name: "Parses local file path",
+			path: "file:///mnt/pipeline-root",
+			want: &objectstore.Config{
+				Scheme:     "file:///",
+				BucketName: "mnt",
+				Prefix:     "pipeline-root/",
+			},
+			wantErr: false,
+		}, {
+			name:    "Rejects relative file path",
+			path:    "file://pipeline-root",
+			wantErr: true,
+		}, {
+			name:    "Rejects unsupported scheme",
+			path:    "ftp://my-bucket/my-path",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -164,6 +184,13 @@ func Test_bucketConfig_KeyFromURI(t *testing.T) {
 			want:         "path1/path2",
 			wantErr:      false,
 		},
+		{
+			name:         "Local file bucket",
+			bucketConfig: &objectstore.Config{Scheme: "file:///", BucketName: "mnt", Prefix: "pipeline-root/"},
+			uri:          "file:///mnt/pipeline-root/path1/path2",
+			want:         "path1/path2",
+			wantErr:      false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -225,3 +252,51 @@ func Test_GetMinioDefaultEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_fileBucket(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "pipeline-root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	pipelineRoot := "file://" + filepath.Join(root, "artifacts")
+	config, err := objectstore.ParseBucketConfig(pipelineRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The k8s client is not used by local file buckets.
+	bucket, err := objectstore.OpenBucket(ctx, nil, "", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bucket.Close()
+
+	local := filepath.Join(root, "local", "model")
+	if err := os.MkdirAll(filepath.Join(local, "weights"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(local, "weights", "layer1"), []byte("0.5"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := objectstore.UploadBlob(ctx, bucket, local, "run/task/model"); err != nil {
+		t.Fatal(err)
+	}
+	uri := config.UriFromKey("run/task/model")
+	if !objectstore.IsFileURI(uri) || uri != pipelineRoot+"/run/task/model" {
+		t.Errorf("unexpected URI %q of the uploaded artifact", uri)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(root, "artifacts", "run", "task", "model", "weights", "layer1"))
+	if err != nil || string(content) != "0.5" {
+		t.Errorf("expect the artifact stored at the path of its URI, got %q, %v", content, err)
+	}
+
+	downloaded := filepath.Join(root, "downloaded")
+	if err := objectstore.DownloadBlob(ctx, bucket, downloaded, "run/task/model"); err != nil {
+		t.Fatal(err)
+	}
+	content, err = ioutil.ReadFile(filepath.Join(downloaded, "weights", "layer1"))
+	if err != nil || string(content) != "0.5" {
+		t.Errorf("failed to download the artifact, got %q, %v", content, err)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parameter validates input parameter values against the input
+// definitions of components, and converts them to the parameter types.
+package parameter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/kubeflow/pipelines/backend/src/v2/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CoercionPolicy decides which values of other types are converted to the
+// types of input parameters.
+type CoercionPolicy string
+
+const (
+	// Values must have the types of the parameters, except that STRING
+	// parameters consume values of any type as text, e.g. JSON text for
+	// lists and structs. This is the default policy.
+	CoercionToString CoercionPolicy = "TO_STRING"
+	// Values must have the types of the parameters.
+	CoercionNone CoercionPolicy = "NONE"
+	// Like CoercionToString, and strings are also parsed as values of the
+	// parameter types, e.g. "3" for NUMBER_INTEGER, "true" for BOOLEAN and
+	// JSON arrays and objects for LIST and STRUCT.
+	CoercionParse CoercionPolicy = "PARSE"
+)
+
+// ParseCoercionPolicy parses a coercion policy, empty means the default
+// policy.
+func ParseCoercionPolicy(policy string) (CoercionPolicy, error) {
+	switch p := CoercionPolicy(strings.ToUpper(policy)); p {
+	case "":
+		return CoercionToString, nil
+	case CoercionToString, CoercionNone, CoercionParse:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown parameter coercion policy %q, must be one of %s, %s or %s", policy, CoercionToString, CoercionNone, CoercionParse)
+	}
+}
+
+// Type returns the type of an input parameter. The deprecated primitive type
+// is converted for specs that do not have a parameter type.
+func Type(spec *pipelinespec.ComponentInputsSpec_ParameterSpec) pipelinespec.ParameterType_ParameterTypeEnum {
+	if t := spec.GetParameterType(); t != pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED {
+		return t
+	}
+	switch spec.GetType() {
+	case pipelinespec.PrimitiveType_INT:
+		return pipelinespec.ParameterType_NUMBER_INTEGER
+	case pipelinespec.PrimitiveType_DOUBLE:
+		return pipelinespec.ParameterType_NUMBER_DOUBLE
+	case pipelinespec.PrimitiveType_STRING:
+		return pipelinespec.ParameterType_STRING
+	default:
+		return pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED
+	}
+}
+
+// Coerce validates the value of an input parameter against its type, and
+// converts the value to the type when the policy allows. Values of
+// parameters without a type are not validated.
+func Coerce(name string, value *structpb.Value, spec *pipelinespec.ComponentInputsSpec_ParameterSpec, policy CoercionPolicy) (*structpb.Value, error) {
+	parameterType := Type(spec)
+	typeMismatch := func(actual string) error {
+		return fmt.Errorf("input parameter %q type mismatch: expect %s, got %s", name, parameterType, actual)
+	}
+	if parameterType == pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED {
+		return value, nil
+	}
+	switch v := value.GetKind().(type) {
+	case nil, *structpb.Value_NullValue:
+		return nil, fmt.Errorf("got null for input parameter %q", name)
+	case *structpb.Value_StringValue:
+		if parameterType == pipelinespec.ParameterType_STRING {
+			return value, nil
+		}
+		if policy != CoercionParse {
+			return nil, typeMismatch("string")
+		}
+		parsed, err := parse(v.StringValue, parameterType)
+		if err != nil {
+			return nil, fmt.Errorf("input parameter %q: cannot parse %q as %s: %w", name, v.StringValue, parameterType, err)
+		}
+		return parsed, nil
+	case *structpb.Value_NumberValue:
+		switch parameterType {
+		case pipelinespec.ParameterType_NUMBER_DOUBLE:
+			return value, nil
+		case pipelinespec.ParameterType_NUMBER_INTEGER:
+			if !isInteger(v.NumberValue) {
+				return nil, typeMismatch(fmt.Sprintf("non-integer number %v", v.NumberValue))
+			}
+			return value, nil
+		}
+		return toString(name, value, parameterType, policy, typeMismatch("number"))
+	case *structpb.Value_BoolValue:
+		if parameterType == pipelinespec.ParameterType_BOOLEAN {
+			return value, nil
+		}
+		return toString(name, value, parameterType, policy, typeMismatch("bool"))
+	case *structpb.Value_ListValue:
+		if parameterType == pipelinespec.ParameterType_LIST {
+			return value, nil
+		}
+		return toString(name, value, parameterType, policy, typeMismatch("list"))
+	case *structpb.Value_StructValue:
+		if parameterType == pipelinespec.ParameterType_STRUCT {
+			return value, nil
+		}
+		return toString(name, value, parameterType, policy, typeMismatch("struct"))
+	default:
+		return nil, fmt.Errorf("unknown protobuf.Value type: %T", v)
+	}
+}
+
+// Resolve validates the values of input parameters against their specs. It
+// fills in default values of missing parameters and converts values to the
+// parameter types, see Coerce. Missing parameters without a default value
+// must be optional. Values without a spec are kept as they are, see Unknown.
+// All invalid parameters are reported in the error, in the order of names.
+func Resolve(values map[string]*structpb.Value, specs map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec, policy CoercionPolicy) (map[string]*structpb.Value, error) {
+	resolved := make(map[string]*structpb.Value, len(values))
+	for name, value := range values {
+		resolved[name] = value
+	}
+	var errs []string
+	for _, name := range sortedNames(specs) {
+		spec := specs[name]
+		value, ok := resolved[name]
+		if !ok {
+			if spec.GetDefaultValue() == nil {
+				if !spec.GetIsOptional() {
+					errs = append(errs, fmt.Sprintf("input parameter %q is required", name))
+				}
+				continue
+			}
+			value = spec.GetDefaultValue()
+		}
+		value, err := Coerce(name, value, spec, policy)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		resolved[name] = value
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return resolved, nil
+}
+
+// Unknown returns the sorted names of values that do not have a spec.
+func Unknown(values map[string]*structpb.Value, specs map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec) []string {
+	var names []string
+	for name := range values {
+		if _, ok := specs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toString(name string, value *structpb.Value, parameterType pipelinespec.ParameterType_ParameterTypeEnum, policy CoercionPolicy, typeMismatch error) (*structpb.Value, error) {
+	if parameterType != pipelinespec.ParameterType_STRING || policy == CoercionNone {
+		return nil, typeMismatch
+	}
+	text, err := metadata.PbValueToText(value)
+	if err != nil {
+		return nil, fmt.Errorf("converting input parameter %q to string: %w", name, err)
+	}
+	return structpb.NewStringValue(text), nil
+}
+
+func parse(text string, parameterType pipelinespec.ParameterType_ParameterTypeEnum) (*structpb.Value, error) {
+	text = strings.TrimSpace(text)
+	switch parameterType {
+	case pipelinespec.ParameterType_NUMBER_INTEGER:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewNumberValue(float64(n)), nil
+	case pipelinespec.ParameterType_NUMBER_DOUBLE:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewNumberValue(n), nil
+	case pipelinespec.ParameterType_BOOLEAN:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewBoolValue(b), nil
+	case pipelinespec.ParameterType_LIST, pipelinespec.ParameterType_STRUCT:
+		value := &structpb.Value{}
+		if err := protojson.Unmarshal([]byte(text), value); err != nil {
+			return nil, err
+		}
+		if _, isList := value.GetKind().(*structpb.Value_ListValue); parameterType == pipelinespec.ParameterType_LIST && !isList {
+			return nil, fmt.Errorf("not a JSON array")
+		}
+		if _, isStruct := value.GetKind().(*structpb.Value_StructValue); parameterType == pipelinespec.ParameterType_STRUCT && !isStruct {
+			return nil, fmt.Errorf("not a JSON object")
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported parameter type")
+	}
+}
+
+func isInteger(n float64) bool {
+	return n == math.Trunc(n) && !math.IsInf(n, 0)
+}
+
+func sortedNames(specs map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec) []string {
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parameter
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/api/v2alpha1/go/pipelinespec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestParseCoercionPolicy(t *testing.T) {
+	policy, err := ParseCoercionPolicy("")
+	require.Nil(t, err)
+	assert.Equal(t, CoercionToString, policy)
+	policy, err = ParseCoercionPolicy("parse")
+	require.Nil(t, err)
+	assert.Equal(t, CoercionParse, policy)
+	_, err = ParseCoercionPolicy("ALWAYS")
+	assert.NotNil(t, err)
+}
+
+func TestType(t *testing.T) {
+	assert.Equal(t, pipelinespec.ParameterType_LIST, Type(&pipelinespec.ComponentInputsSpec_ParameterSpec{
+		ParameterType: pipelinespec.ParameterType_LIST,
+	}))
+	assert.Equal(t, pipelinespec.ParameterType_NUMBER_INTEGER, Type(&pipelinespec.ComponentInputsSpec_ParameterSpec{
+		Type: pipelinespec.PrimitiveType_INT,
+	}))
+	assert.Equal(t, pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED, Type(&pipelinespec.ComponentInputsSpec_ParameterSpec{}))
+}
+
+func TestCoerce(t *testing.T) {
+	list, _ := structpb.NewList([]interface{}{"a", 1})
+	object, _ := structpb.NewStruct(map[string]interface{}{"a": 1})
+	tests := []struct {
+		name          string
+		value         *structpb.Value
+		parameterType pipelinespec.ParameterType_ParameterTypeEnum
+		policy        CoercionPolicy
+		expected      *structpb.Value
+		errorMsg      string
+	}{
+		{"string", structpb.NewStringValue("a"), pipelinespec.ParameterType_STRING, CoercionNone, structpb.NewStringValue("a"), ""},
+		{"integer", structpb.NewNumberValue(3), pipelinespec.ParameterType_NUMBER_INTEGER, CoercionNone, structpb.NewNumberValue(3), ""},
+		{"non-integer", structpb.NewNumberValue(3.5), pipelinespec.ParameterType_NUMBER_INTEGER, CoercionParse, nil, "expect NUMBER_INTEGER, got non-integer number 3.5"},
+		{"integer as double", structpb.NewNumberValue(3), pipelinespec.ParameterType_NUMBER_DOUBLE, CoercionNone, structpb.NewNumberValue(3), ""},
+		{"bool", structpb.NewBoolValue(true), pipelinespec.ParameterType_BOOLEAN, CoercionNone, structpb.NewBoolValue(true), ""},
+		{"list", structpb.NewListValue(list), pipelinespec.ParameterType_LIST, CoercionNone, structpb.NewListValue(list), ""},
+		{"struct", structpb.NewStructValue(object), pipelinespec.ParameterType_STRUCT, CoercionNone, structpb.NewStructValue(object), ""},
+		{"null", structpb.NewNullValue(), pipelinespec.ParameterType_STRING, CoercionParse, nil, `got null for input parameter "p"`},
+		{"any type without a type", structpb.NewBoolValue(true), pipelinespec.ParameterType_PARAMETER_TYPE_ENUM_UNSPECIFIED, CoercionNone, structpb.NewBoolValue(true), ""},
+
+		{"number to string", structpb.NewNumberValue(1.5), pipelinespec.ParameterType_STRING, CoercionToString, structpb.NewStringValue("1.5"), ""},
+		{"list to string", structpb.NewListValue(list), pipelinespec.ParameterType_STRING, CoercionToString, structpb.NewStringValue(`["a",1]`), ""},
+		{"no conversion to string", structpb.NewBoolValue(true), pipelinespec.ParameterType_STRING, CoercionNone, nil, "expect STRING, got bool"},
+		{"no parsing by default", structpb.NewStringValue("3"), pipelinespec.ParameterType_NUMBER_INTEGER, CoercionToString, nil, "expect NUMBER_INTEGER, got string"},
+		{"bool to number", structpb.NewBoolValue(true), pipelinespec.ParameterType_NUMBER_DOUBLE, CoercionParse, nil, "expect NUMBER_DOUBLE, got bool"},
+
+		{"parse integer", structpb.NewStringValue(" 3 "), pipelinespec.ParameterType_NUMBER_INTEGER, CoercionParse, structpb.NewNumberValue(3), ""},
+		{"parse non-integer", structpb.NewStringValue("3.5"), pipelinespec.ParameterType_NUMBER_INTEGER, CoercionParse, nil, `cannot parse "3.5" as NUMBER_INTEGER`},
+		{"parse double", structpb.NewStringValue("3.5"), pipelinespec.ParameterType_NUMBER_DOUBLE, CoercionParse, structpb.NewNumberValue(3.5), ""},
+		{"parse bool", structpb.NewStringValue("true"), pipelinespec.ParameterType_BOOLEAN, CoercionParse, structpb.NewBoolValue(true), ""},
+		{"parse list", structpb.NewStringValue(`["a", 1]`), pipelinespec.ParameterType_LIST, CoercionParse, structpb.NewListValue(list), ""},
+		{"parse struct", structpb.NewStringValue(`{"a": 1}`), pipelinespec.ParameterType_STRUCT, CoercionParse, structpb.NewStructValue(object), ""},
+		{"parse struct as list", structpb.NewStringValue(`{"a": 1}`), pipelinespec.ParameterType_LIST, CoercionParse, nil, "not a JSON array"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := Coerce("p", tt.value, &pipelinespec.ComponentInputsSpec_ParameterSpec{ParameterType: tt.parameterType}, tt.policy)
+			if tt.errorMsg != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, proto.Equal(tt.expected, value), "expected %v, got %v", tt.expected, value)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	specs := map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec{
+		"required": {ParameterType: pipelinespec.ParameterType_NUMBER_INTEGER},
+		"optional": {ParameterType: pipelinespec.ParameterType_STRING, IsOptional: true},
+		"default":  {ParameterType: pipelinespec.ParameterType_BOOLEAN, DefaultValue: structpb.NewBoolValue(true)},
+	}
+	resolved, err := Resolve(map[string]*structpb.Value{
+		"required": structpb.NewStringValue("3"),
+		"other":    structpb.NewStringValue("kept"),
+	}, specs, CoercionParse)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]*structpb.Value{
+		"required": structpb.NewNumberValue(3),
+		"default":  structpb.NewBoolValue(true),
+		"other":    structpb.NewStringValue("kept"),
+	}, resolved)
+
+	_, err = Resolve(map[string]*structpb.Value{
+		"default": structpb.NewStringValue("yes"),
+	}, specs, CoercionParse)
+	require.NotNil(t, err)
+	assert.Equal(t, `input parameter "default": cannot parse "yes" as BOOLEAN: strconv.ParseBool: parsing "yes": invalid syntax; input parameter "required" is required`, err.Error())
+}
+
+func TestUnknown(t *testing.T) {
+	specs := map[string]*pipelinespec.ComponentInputsSpec_ParameterSpec{"a": {}}
+	assert.Equal(t, []string{"b", "c"}, Unknown(map[string]*structpb.Value{
+		"c": structpb.NewStringValue(""),
+		"a": structpb.NewStringValue(""),
+		"b": structpb.NewStringValue(""),
+	}, specs))
+	assert.Nil(t, Unknown(nil, specs))
+}